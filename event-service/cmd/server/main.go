@@ -18,8 +18,10 @@ import (
 	"github.com/doniiel/event-ticketing-platform/event-service/internal/config"
 	"github.com/doniiel/event-ticketing-platform/event-service/internal/database"
 	"github.com/doniiel/event-ticketing-platform/event-service/internal/handler"
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/outbox"
 	"github.com/doniiel/event-ticketing-platform/event-service/internal/repository"
 	"github.com/doniiel/event-ticketing-platform/event-service/internal/server"
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
 	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.opentelemetry.io/otel"
@@ -44,7 +46,21 @@ func main() {
 	}(db)
 
 	eventRepo := repository.NewEventRepository(db)
-	eventHandler := handler.NewEventHandler(eventRepo)
+	eventBus, err := bus.Open(cfg.NatsURL, handler.EventsStreamConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to message bus: %v", err)
+	}
+	defer func() {
+		if err := eventBus.Close(); err != nil {
+			log.Printf("Failed to close message bus: %v", err)
+		}
+	}()
+
+	eventHandler := handler.NewEventHandler(eventRepo, eventBus)
+
+	relay := outbox.NewRelay(repository.NewOutboxRepository(db), eventBus, cfg.OutboxPollInterval)
+	relay.Start()
+	defer relay.Stop()
 
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(server.UnaryLoggerInterceptor),
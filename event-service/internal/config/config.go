@@ -3,12 +3,15 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 )
 
 type Config struct {
-	GRPCPort    int
-	HTTPPort    int
-	DatabaseURL string
+	GRPCPort           int
+	HTTPPort           int
+	DatabaseURL        string
+	NatsURL            string
+	OutboxPollInterval time.Duration
 }
 
 func LoadConfig() *Config {
@@ -23,9 +26,11 @@ func LoadConfig() *Config {
 	}
 
 	return &Config{
-		GRPCPort:    grpcPort,
-		HTTPPort:    httpPort,
-		DatabaseURL: getEnv("DATABASE_URL", "root:password@tcp(mysql:3306)/events?parseTime=true"),
+		GRPCPort:           grpcPort,
+		HTTPPort:           httpPort,
+		DatabaseURL:        getEnv("DATABASE_URL", "root:password@tcp(mysql:3306)/events?parseTime=true"),
+		NatsURL:            os.Getenv("NATS_URL"),
+		OutboxPollInterval: getDuration("OUTBOX_POLL_INTERVAL", time.Second),
 	}
 }
 
@@ -36,3 +41,11 @@ func getEnv(key, defaultValue string) string {
 	}
 	return value
 }
+
+func getDuration(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
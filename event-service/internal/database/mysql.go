@@ -32,18 +32,152 @@ func NewMySQLConnection(dsn string) (*sql.DB, error) {
 }
 
 func createTables(db *sql.DB) error {
-	query := `
+	queries := []string{
+		`
 	CREATE TABLE IF NOT EXISTS events (
 		id VARCHAR(36) PRIMARY KEY,
 		name VARCHAR(255) NOT NULL,
 		date DATETIME NOT NULL,
 		location VARCHAR(255) NOT NULL,
 		ticket_stock INT NOT NULL,
+		status VARCHAR(16) NOT NULL,
+		status_reason VARCHAR(255) NOT NULL DEFAULT '',
+		sales_start DATETIME NULL,
+		sales_end DATETIME NULL,
+		presale_start DATETIME NULL,
+		max_per_order INT NOT NULL DEFAULT 0,
+		max_per_user INT NOT NULL DEFAULT 0,
+		non_transferable BOOLEAN NOT NULL DEFAULT FALSE,
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
 	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
-	`
+	`,
+		`
+	CREATE TABLE IF NOT EXISTS stock_reservations (
+		id VARCHAR(64) PRIMARY KEY,
+		event_id VARCHAR(36) NOT NULL,
+		quantity INT NOT NULL,
+		status VARCHAR(16) NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		INDEX (event_id)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+	`,
+		`
+	CREATE TABLE IF NOT EXISTS ticket_types (
+		id VARCHAR(36) PRIMARY KEY,
+		event_id VARCHAR(36) NOT NULL,
+		name VARCHAR(255) NOT NULL,
+		price BIGINT NOT NULL,
+		currency VARCHAR(3) NOT NULL,
+		capacity INT NOT NULL,
+		stock INT NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		INDEX (event_id),
+		UNIQUE KEY (event_id, name)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+	`,
+		`
+	CREATE TABLE IF NOT EXISTS seats (
+		id VARCHAR(36) PRIMARY KEY,
+		event_id VARCHAR(36) NOT NULL,
+		section VARCHAR(64) NOT NULL,
+		row_label VARCHAR(16) NOT NULL,
+		number INT NOT NULL,
+		ticket_type_id VARCHAR(36) NOT NULL DEFAULT '',
+		accessible BOOLEAN NOT NULL DEFAULT FALSE,
+		obstructed BOOLEAN NOT NULL DEFAULT FALSE,
+		status VARCHAR(16) NOT NULL,
+		reservation_id VARCHAR(64) NOT NULL DEFAULT '',
+		position INT NOT NULL,
+		INDEX (event_id, position),
+		INDEX (reservation_id),
+		UNIQUE KEY (event_id, section, row_label, number)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+	`,
+		`
+	CREATE TABLE IF NOT EXISTS presale_codes (
+		code VARCHAR(32) PRIMARY KEY,
+		event_id VARCHAR(36) NOT NULL,
+		max_uses INT NOT NULL,
+		uses INT NOT NULL DEFAULT 0,
+		revoked BOOLEAN NOT NULL DEFAULT FALSE,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		INDEX (event_id)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+	`,
+		`
+	CREATE TABLE IF NOT EXISTS outbox (
+		id VARCHAR(36) PRIMARY KEY,
+		subject VARCHAR(128) NOT NULL,
+		envelope BLOB NOT NULL,
+		attempts INT NOT NULL DEFAULT 0,
+		last_error VARCHAR(255) NOT NULL DEFAULT '',
+		next_attempt_at DATETIME(3) NOT NULL,
+		published_at DATETIME(3) NULL,
+		created_at DATETIME(3) NOT NULL,
+		INDEX (published_at, next_attempt_at)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+	`,
+		`
+	CREATE TABLE IF NOT EXISTS reservation_seats (
+		reservation_id VARCHAR(64) NOT NULL,
+		seat_id VARCHAR(36) NOT NULL,
+		PRIMARY KEY (reservation_id, seat_id)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+	`,
+	}
+
+	for _, query := range queries {
+		if _, err := db.Exec(query); err != nil {
+			return err
+		}
+	}
+
+	columns := []struct {
+		table, name, definition string
+	}{
+		// Events created before statuses existed were already selling.
+		{"events", "status", "VARCHAR(16) NOT NULL DEFAULT 'ON_SALE'"},
+		{"events", "status_reason", "VARCHAR(255) NOT NULL DEFAULT ''"},
+		{"events", "sales_start", "DATETIME NULL"},
+		{"events", "sales_end", "DATETIME NULL"},
+		{"events", "presale_start", "DATETIME NULL"},
+		{"events", "max_per_order", "INT NOT NULL DEFAULT 0"},
+		{"events", "max_per_user", "INT NOT NULL DEFAULT 0"},
+		{"events", "non_transferable", "BOOLEAN NOT NULL DEFAULT FALSE"},
+		{"stock_reservations", "ticket_type_id", "VARCHAR(36) NOT NULL DEFAULT ''"},
+		{"stock_reservations", "unit_price", "BIGINT NOT NULL DEFAULT 0"},
+		{"stock_reservations", "currency", "VARCHAR(3) NOT NULL DEFAULT ''"},
+		{"stock_reservations", "access_code", "VARCHAR(32) NOT NULL DEFAULT ''"},
+		{"stock_reservations", "access_group", "VARCHAR(64) NOT NULL DEFAULT ''"},
+	}
+
+	for _, column := range columns {
+		if err := addColumn(db, column.table, column.name, column.definition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addColumn adds a column to a table created by an earlier version of the
+// service. MySQL has no ADD COLUMN IF NOT EXISTS, so the schema is checked
+// first.
+func addColumn(db *sql.DB, table, column, definition string) error {
+	var count int
+	err := db.QueryRow(`
+		SELECT COUNT(*) FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?
+	`, table, column).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
 
-	_, err := db.Exec(query)
+	_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
 	return err
 }
@@ -5,18 +5,33 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
+	buspb "github.com/doniiel/event-ticketing-platform/proto/bus"
 	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type mockEventRepository struct {
-	events map[string]*model.Event
+	events       map[string]*model.Event
+	reservations map[string]*model.StockReservation
+	seats        map[string][]*model.Seat
+	presaleCodes map[string]*model.PresaleCode
+	outbox       []*model.OutboxMessage
 }
 
 func (m *mockEventRepository) Create(ctx context.Context, event *model.Event) (*model.Event, error) {
+	if len(event.TicketTypes) > 0 {
+		event.TicketStock = 0
+		for _, ticketType := range event.TicketTypes {
+			event.TicketStock += ticketType.Stock
+		}
+	}
 	m.events[event.ID] = event
 	return event, nil
 }
@@ -29,12 +44,18 @@ func (m *mockEventRepository) GetByID(ctx context.Context, id string) (*model.Ev
 	return event, nil
 }
 
-func (m *mockEventRepository) Update(ctx context.Context, event *model.Event) (*model.Event, error) {
-	if _, exists := m.events[event.ID]; !exists {
+func (m *mockEventRepository) Update(ctx context.Context, event *model.Event, ticketStock *int32) (*model.Event, error) {
+	current, exists := m.events[event.ID]
+	if !exists {
 		return nil, sql.ErrNoRows
 	}
-	m.events[event.ID] = event
-	return event, nil
+	updated := *event
+	updated.TicketStock = current.TicketStock
+	if ticketStock != nil {
+		updated.TicketStock = *ticketStock
+	}
+	m.events[event.ID] = &updated
+	return &updated, nil
 }
 
 func (m *mockEventRepository) Delete(ctx context.Context, id string) error {
@@ -45,19 +66,50 @@ func (m *mockEventRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (m *mockEventRepository) List(ctx context.Context, page, pageSize int32) ([]*model.Event, int32, error) {
+func (m *mockEventRepository) List(ctx context.Context, page, pageSize int32, statuses []model.EventStatus) ([]*model.Event, int32, error) {
 	var events []*model.Event
 	for _, event := range m.events {
-		events = append(events, event)
+		for _, status := range statuses {
+			if event.Status == status {
+				events = append(events, event)
+			}
+		}
 	}
 	return events, int32(len(events)), nil
 }
 
-func (m *mockEventRepository) CheckAvailability(ctx context.Context, eventID string, quantity int32) (bool, error) {
+func (m *mockEventRepository) ChangeStatus(ctx context.Context, id string, status model.EventStatus, reason string, date time.Time, announce repository.Announce) (*model.Event, error) {
+	event, exists := m.events[id]
+	if !exists {
+		return nil, sql.ErrNoRows
+	}
+	if !event.Status.CanTransitionTo(status) {
+		return nil, repository.ErrInvalidEventTransition
+	}
+	event.Status = status
+	event.StatusReason = reason
+	if !date.IsZero() {
+		event.Date = date
+	}
+	msg, err := announce(event)
+	if err != nil {
+		return nil, err
+	}
+	m.outbox = append(m.outbox, msg)
+	return event, nil
+}
+
+func (m *mockEventRepository) CheckAvailability(ctx context.Context, eventID string, quantity int32, accessCode string) (bool, error) {
 	event, exists := m.events[eventID]
 	if !exists {
 		return false, nil
 	}
+	if event.Status != model.EventStatusOnSale && event.Status != model.EventStatusSoldOut {
+		return false, repository.ErrEventNotOnSale
+	}
+	if _, err := m.checkSalesWindow(event, accessCode); err != nil {
+		return false, err
+	}
 	return event.TicketStock >= quantity, nil
 }
 
@@ -73,9 +125,289 @@ func (m *mockEventRepository) UpdateTicketStock(ctx context.Context, eventID str
 	return nil
 }
 
+func (m *mockEventRepository) ReserveStock(ctx context.Context, reservationID, eventID, ticketTypeID string, seatIDs []string, quantity int32, accessCode, accessGroup string) (*model.StockReservation, error) {
+	if m.reservations == nil {
+		m.reservations = make(map[string]*model.StockReservation)
+	}
+	if existing, exists := m.reservations[reservationID]; exists {
+		if existing.EventID != eventID || existing.TicketTypeID != ticketTypeID || existing.Quantity != quantity {
+			return nil, repository.ErrReservationConflict
+		}
+		return existing, nil
+	}
+	event, exists := m.events[eventID]
+	if !exists {
+		return nil, sql.ErrNoRows
+	}
+	if event.Status != model.EventStatusOnSale {
+		return nil, repository.ErrEventNotOnSale
+	}
+	code, err := m.checkSalesWindow(event, accessCode)
+	if err != nil {
+		return nil, err
+	}
+	reservation := &model.StockReservation{
+		ID:           reservationID,
+		EventID:      eventID,
+		TicketTypeID: ticketTypeID,
+		Quantity:     quantity,
+		SeatIDs:      seatIDs,
+		Status:       model.ReservationStatusReserved,
+	}
+	if err := m.holdSeats(reservationID, eventID, ticketTypeID, seatIDs); err != nil {
+		return nil, err
+	}
+	if ticketTypeID == "" && len(event.TicketTypes) > 0 {
+		return nil, repository.ErrTicketTypeRequired
+	}
+	if ticketTypeID != "" {
+		ticketType, err := m.GetTicketType(ctx, ticketTypeID)
+		if err != nil || ticketType.EventID != eventID {
+			return nil, repository.ErrTicketTypeNotFound
+		}
+		if ticketType.Stock < quantity {
+			return nil, repository.ErrInsufficientStock
+		}
+		ticketType.Stock -= quantity
+		reservation.UnitPrice = ticketType.Price
+		reservation.Currency = ticketType.Currency
+	}
+	if event.TicketStock < quantity {
+		return nil, repository.ErrInsufficientStock
+	}
+	event.TicketStock -= quantity
+	if code != nil {
+		code.Uses++
+		reservation.AccessCode = code.Code
+	}
+	m.reservations[reservationID] = reservation
+	return reservation, nil
+}
+
+func (m *mockEventRepository) ReleaseStock(ctx context.Context, reservationID string) (*model.StockReservation, error) {
+	reservation, exists := m.reservations[reservationID]
+	if !exists {
+		return nil, repository.ErrReservationNotFound
+	}
+	if reservation.Status == model.ReservationStatusReleased {
+		return reservation, nil
+	}
+	m.events[reservation.EventID].TicketStock += reservation.Quantity
+	if ticketType, err := m.GetTicketType(ctx, reservation.TicketTypeID); err == nil {
+		ticketType.Stock += reservation.Quantity
+	}
+	m.setSeatStatus(reservation, model.SeatStatusAvailable)
+	if code, exists := m.presaleCodes[reservation.AccessCode]; exists {
+		code.Uses--
+	}
+	reservation.Status = model.ReservationStatusReleased
+	return reservation, nil
+}
+
+func (m *mockEventRepository) CommitStock(ctx context.Context, reservationID string) (*model.StockReservation, error) {
+	reservation, exists := m.reservations[reservationID]
+	if !exists {
+		return nil, repository.ErrReservationNotFound
+	}
+	if reservation.Status == model.ReservationStatusReleased {
+		return nil, repository.ErrInvalidReservationState
+	}
+	m.setSeatStatus(reservation, model.SeatStatusSold)
+	reservation.Status = model.ReservationStatusCommitted
+	return reservation, nil
+}
+
+func (m *mockEventRepository) CreateTicketType(ctx context.Context, ticketType *model.TicketType) (*model.TicketType, error) {
+	event, exists := m.events[ticketType.EventID]
+	if !exists {
+		return nil, sql.ErrNoRows
+	}
+	if len(event.TicketTypes) == 0 {
+		event.TicketStock = 0
+	}
+	event.TicketTypes = append(event.TicketTypes, ticketType)
+	event.TicketStock += ticketType.Stock
+	return ticketType, nil
+}
+
+func (m *mockEventRepository) GetTicketType(ctx context.Context, id string) (*model.TicketType, error) {
+	for _, event := range m.events {
+		for _, ticketType := range event.TicketTypes {
+			if ticketType.ID == id {
+				return ticketType, nil
+			}
+		}
+	}
+	return nil, repository.ErrTicketTypeNotFound
+}
+
+func (m *mockEventRepository) ListTicketTypes(ctx context.Context, eventID string) ([]*model.TicketType, error) {
+	event, exists := m.events[eventID]
+	if !exists {
+		return nil, nil
+	}
+	return event.TicketTypes, nil
+}
+
+func (m *mockEventRepository) UpdateTicketType(ctx context.Context, ticketType *model.TicketType) (*model.TicketType, error) {
+	current, err := m.GetTicketType(ctx, ticketType.ID)
+	if err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func (m *mockEventRepository) DeleteTicketType(ctx context.Context, id string) error {
+	ticketType, err := m.GetTicketType(ctx, id)
+	if err != nil {
+		return err
+	}
+	if ticketType.Sold() > 0 {
+		return repository.ErrTicketTypeInUse
+	}
+	event := m.events[ticketType.EventID]
+	for i, existing := range event.TicketTypes {
+		if existing.ID == id {
+			event.TicketTypes = append(event.TicketTypes[:i], event.TicketTypes[i+1:]...)
+			break
+		}
+	}
+	event.TicketStock -= ticketType.Stock
+	return nil
+}
+
+func (m *mockEventRepository) CheckTicketTypeAvailability(ctx context.Context, ticketTypeID string, quantity int32, accessCode string) (bool, error) {
+	ticketType, err := m.GetTicketType(ctx, ticketTypeID)
+	if err != nil {
+		return false, err
+	}
+	if _, err := m.checkSalesWindow(m.events[ticketType.EventID], accessCode); err != nil {
+		return false, err
+	}
+	return ticketType.Stock >= quantity, nil
+}
+
+func (m *mockEventRepository) SaveSeatMap(ctx context.Context, eventID string, seats []*model.Seat) ([]*model.Seat, error) {
+	if _, exists := m.events[eventID]; !exists {
+		return nil, sql.ErrNoRows
+	}
+	for _, seat := range m.seats[eventID] {
+		if seat.Status != model.SeatStatusAvailable {
+			return nil, repository.ErrSeatMapInUse
+		}
+	}
+	if m.seats == nil {
+		m.seats = make(map[string][]*model.Seat)
+	}
+	m.seats[eventID] = seats
+	return seats, nil
+}
+
+func (m *mockEventRepository) ListSeats(ctx context.Context, eventID string) ([]*model.Seat, error) {
+	return m.seats[eventID], nil
+}
+
+func (m *mockEventRepository) CreatePresaleCodes(ctx context.Context, eventID string, codes []*model.PresaleCode) ([]*model.PresaleCode, error) {
+	if _, exists := m.events[eventID]; !exists {
+		return nil, sql.ErrNoRows
+	}
+	if m.presaleCodes == nil {
+		m.presaleCodes = make(map[string]*model.PresaleCode)
+	}
+	for _, code := range codes {
+		m.presaleCodes[code.Code] = code
+	}
+	return codes, nil
+}
+
+func (m *mockEventRepository) ListPresaleCodes(ctx context.Context, eventID string) ([]*model.PresaleCode, error) {
+	var codes []*model.PresaleCode
+	for _, code := range m.presaleCodes {
+		if code.EventID == eventID {
+			codes = append(codes, code)
+		}
+	}
+	return codes, nil
+}
+
+func (m *mockEventRepository) RevokePresaleCode(ctx context.Context, eventID, code string) (*model.PresaleCode, error) {
+	presaleCode, exists := m.presaleCodes[code]
+	if !exists || presaleCode.EventID != eventID {
+		return nil, repository.ErrPresaleCodeNotFound
+	}
+	presaleCode.Revoked = true
+	return presaleCode, nil
+}
+
+func (m *mockEventRepository) checkSalesWindow(event *model.Event, accessCode string) (*model.PresaleCode, error) {
+	switch event.SalesWindow.Phase(time.Now()) {
+	case model.SalesPhaseNotStarted:
+		return nil, repository.ErrSalesNotStarted
+	case model.SalesPhaseEnded:
+		return nil, repository.ErrSalesEnded
+	case model.SalesPhaseOpen:
+		return nil, nil
+	}
+	if accessCode == "" {
+		return nil, repository.ErrAccessCodeRequired
+	}
+	code, exists := m.presaleCodes[accessCode]
+	if !exists || code.EventID != event.ID || code.Revoked {
+		return nil, repository.ErrInvalidAccessCode
+	}
+	if code.Uses >= code.MaxUses {
+		return nil, repository.ErrAccessCodeUsedUp
+	}
+	return code, nil
+}
+
+func (m *mockEventRepository) holdSeats(reservationID, eventID, ticketTypeID string, seatIDs []string) error {
+	if len(seatIDs) == 0 {
+		for _, seat := range m.seats[eventID] {
+			if seat.SellableAs(ticketTypeID) {
+				return repository.ErrSeatsRequired
+			}
+		}
+		return nil
+	}
+
+	var held []*model.Seat
+	for _, seatID := range seatIDs {
+		var found *model.Seat
+		for _, seat := range m.seats[eventID] {
+			if seat.ID == seatID {
+				found = seat
+			}
+		}
+		if found == nil {
+			return repository.ErrSeatNotFound
+		}
+		if found.Status != model.SeatStatusAvailable {
+			return repository.ErrSeatUnavailable
+		}
+		held = append(held, found)
+	}
+	for _, seat := range held {
+		seat.Status = model.SeatStatusHeld
+		seat.ReservationID = reservationID
+	}
+	return nil
+}
+
+func (m *mockEventRepository) setSeatStatus(reservation *model.StockReservation, seatStatus model.SeatStatus) {
+	for _, seat := range m.seats[reservation.EventID] {
+		if seat.ReservationID == reservation.ID {
+			seat.Status = seatStatus
+			if seatStatus == model.SeatStatusAvailable {
+				seat.ReservationID = ""
+			}
+		}
+	}
+}
+
 func TestEventHandler_CreateEvent(t *testing.T) {
 	repo := &mockEventRepository{events: make(map[string]*model.Event)}
-	handler := NewEventHandler(repo)
+	handler := NewEventHandler(repo, bus.NewMemory())
 
 	tests := []struct {
 		name    string
@@ -145,7 +477,7 @@ func TestEventHandler_CreateEvent(t *testing.T) {
 
 func TestEventHandler_GetEvent(t *testing.T) {
 	repo := &mockEventRepository{events: make(map[string]*model.Event)}
-	handler := NewEventHandler(repo)
+	handler := NewEventHandler(repo, bus.NewMemory())
 
 	event, _ := model.NewEvent("Test Concert", "2025-06-01T19:00:00Z", "Test Arena", 100)
 	repo.events[event.ID] = event
@@ -194,12 +526,16 @@ func TestEventHandler_GetEvent(t *testing.T) {
 
 func TestEventHandler_ListEvents(t *testing.T) {
 	repo := &mockEventRepository{events: make(map[string]*model.Event)}
-	handler := NewEventHandler(repo)
+	handler := NewEventHandler(repo, bus.NewMemory())
 
 	event1, _ := model.NewEvent("Concert 1", "2025-06-01T19:00:00Z", "Arena 1", 100)
 	event2, _ := model.NewEvent("Concert 2", "2025-06-02T19:00:00Z", "Arena 2", 200)
+	draft, _ := model.NewEvent("Concert 3", "2025-06-03T19:00:00Z", "Arena 3", 300)
+	event1.Status = model.EventStatusOnSale
+	event2.Status = model.EventStatusPublished
 	repo.events[event1.ID] = event1
 	repo.events[event2.ID] = event2
+	repo.events[draft.ID] = draft
 
 	tests := []struct {
 		name    string
@@ -225,6 +561,17 @@ func TestEventHandler_ListEvents(t *testing.T) {
 			wantLen: 2,
 			wantErr: codes.OK,
 		},
+		{
+			name:    "drafts",
+			req:     &eventpb.ListEventsRequest{Page: 1, PageSize: 10, Statuses: []string{"DRAFT"}},
+			wantLen: 1,
+			wantErr: codes.OK,
+		},
+		{
+			name:    "invalid status",
+			req:     &eventpb.ListEventsRequest{Page: 1, PageSize: 10, Statuses: []string{"OPEN"}},
+			wantErr: codes.InvalidArgument,
+		},
 	}
 
 	for _, tt := range tests {
@@ -252,7 +599,7 @@ func TestEventHandler_ListEvents(t *testing.T) {
 
 func TestEventHandler_UpdateEvent(t *testing.T) {
 	repo := &mockEventRepository{events: make(map[string]*model.Event)}
-	handler := NewEventHandler(repo)
+	handler := NewEventHandler(repo, bus.NewMemory())
 
 	event, _ := model.NewEvent("Test Concert", "2025-06-01T19:00:00Z", "Test Arena", 100)
 	repo.events[event.ID] = event
@@ -329,7 +676,7 @@ func TestEventHandler_UpdateEvent(t *testing.T) {
 
 func TestEventHandler_DeleteEvent(t *testing.T) {
 	repo := &mockEventRepository{events: make(map[string]*model.Event)}
-	handler := NewEventHandler(repo)
+	handler := NewEventHandler(repo, bus.NewMemory())
 
 	event, _ := model.NewEvent("Test Concert", "2025-06-01T19:00:00Z", "Test Arena", 100)
 	repo.events[event.ID] = event
@@ -378,9 +725,10 @@ func TestEventHandler_DeleteEvent(t *testing.T) {
 
 func TestEventHandler_CheckAvailability(t *testing.T) {
 	repo := &mockEventRepository{events: make(map[string]*model.Event)}
-	handler := NewEventHandler(repo)
+	handler := NewEventHandler(repo, bus.NewMemory())
 
 	event, _ := model.NewEvent("Test Concert", "2025-06-01T19:00:00Z", "Test Arena", 100)
+	event.Status = model.EventStatusOnSale
 	repo.events[event.ID] = event
 
 	tests := []struct {
@@ -454,10 +802,128 @@ func TestEventHandler_CheckAvailability(t *testing.T) {
 	}
 }
 
+func TestEventHandler_ReserveStock(t *testing.T) {
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	handler := NewEventHandler(repo, bus.NewMemory())
+
+	event, _ := model.NewEvent("Test Concert", "2025-06-01T19:00:00Z", "Test Arena", 10)
+	event.Status = model.EventStatusOnSale
+	repo.events[event.ID] = event
+
+	tests := []struct {
+		name      string
+		req       *eventpb.ReserveStockRequest
+		wantStock int32
+		wantErr   codes.Code
+	}{
+		{
+			name:      "valid request",
+			req:       &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "res-1", Quantity: 4},
+			wantStock: 6,
+			wantErr:   codes.OK,
+		},
+		{
+			name:      "retry is idempotent",
+			req:       &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "res-1", Quantity: 4},
+			wantStock: 6,
+			wantErr:   codes.OK,
+		},
+		{
+			name:      "retry with different quantity",
+			req:       &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "res-1", Quantity: 2},
+			wantStock: 6,
+			wantErr:   codes.AlreadyExists,
+		},
+		{
+			name:      "not enough stock",
+			req:       &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "res-2", Quantity: 7},
+			wantStock: 6,
+			wantErr:   codes.ResourceExhausted,
+		},
+		{
+			name:      "missing reservation ID",
+			req:       &eventpb.ReserveStockRequest{EventId: event.ID, Quantity: 1},
+			wantStock: 6,
+			wantErr:   codes.InvalidArgument,
+		},
+		{
+			name:      "event not found",
+			req:       &eventpb.ReserveStockRequest{EventId: "non-existent", ReservationId: "res-3", Quantity: 1},
+			wantStock: 6,
+			wantErr:   codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := handler.ReserveStock(context.Background(), tt.req)
+			if tt.wantErr == codes.OK {
+				if err != nil {
+					t.Errorf("ReserveStock() error = %v, want nil", err)
+					return
+				}
+				if resp.Reservation.Status != string(model.ReservationStatusReserved) {
+					t.Errorf("ReserveStock() status = %s, want %s", resp.Reservation.Status, model.ReservationStatusReserved)
+				}
+			} else {
+				if status.Code(err) != tt.wantErr {
+					t.Errorf("ReserveStock() error code = %v, want %v", status.Code(err), tt.wantErr)
+				}
+			}
+			if event.TicketStock != tt.wantStock {
+				t.Errorf("ReserveStock() ticket stock = %d, want %d", event.TicketStock, tt.wantStock)
+			}
+		})
+	}
+}
+
+func TestEventHandler_ReleaseAndCommitStock(t *testing.T) {
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	handler := NewEventHandler(repo, bus.NewMemory())
+	ctx := context.Background()
+
+	event, _ := model.NewEvent("Test Concert", "2025-06-01T19:00:00Z", "Test Arena", 10)
+	event.Status = model.EventStatusOnSale
+	repo.events[event.ID] = event
+
+	if _, err := handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "held", Quantity: 3}); err != nil {
+		t.Fatalf("ReserveStock() error = %v", err)
+	}
+	if _, err := handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "sold", Quantity: 2}); err != nil {
+		t.Fatalf("ReserveStock() error = %v", err)
+	}
+
+	if _, err := handler.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{ReservationId: "held"}); err != nil {
+		t.Errorf("ReleaseStock() error = %v, want nil", err)
+	}
+	if _, err := handler.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{ReservationId: "held"}); err != nil {
+		t.Errorf("ReleaseStock() repeated error = %v, want nil", err)
+	}
+	if event.TicketStock != 8 {
+		t.Errorf("ticket stock after release = %d, want 8", event.TicketStock)
+	}
+
+	if _, err := handler.CommitStock(ctx, &eventpb.CommitStockRequest{ReservationId: "sold"}); err != nil {
+		t.Errorf("CommitStock() error = %v, want nil", err)
+	}
+	if _, err := handler.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{ReservationId: "sold"}); err != nil {
+		t.Errorf("ReleaseStock() committed error = %v, want nil", err)
+	}
+	if event.TicketStock != 10 {
+		t.Errorf("ticket stock after releasing committed reservation = %d, want 10", event.TicketStock)
+	}
+	if _, err := handler.CommitStock(ctx, &eventpb.CommitStockRequest{ReservationId: "held"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CommitStock() released error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+	if _, err := handler.CommitStock(ctx, &eventpb.CommitStockRequest{ReservationId: "unknown"}); status.Code(err) != codes.NotFound {
+		t.Errorf("CommitStock() unknown error code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
+
 // Benchmarking tests
 func BenchmarkEventHandler_CreateEvent(b *testing.B) {
 	repo := &mockEventRepository{events: make(map[string]*model.Event)}
-	handler := NewEventHandler(repo)
+	handler := NewEventHandler(repo, bus.NewMemory())
 	req := &eventpb.CreateEventRequest{
 		Name:        "Test Concert",
 		Date:        "2025-06-01T19:00:00Z",
@@ -477,7 +943,7 @@ func BenchmarkEventHandler_CreateEvent(b *testing.B) {
 
 func BenchmarkEventHandler_GetEvent(b *testing.B) {
 	repo := &mockEventRepository{events: make(map[string]*model.Event)}
-	handler := NewEventHandler(repo)
+	handler := NewEventHandler(repo, bus.NewMemory())
 	event, _ := model.NewEvent("Test Concert", "2025-06-01T19:00:00Z", "Test Arena", 100)
 	repo.events[event.ID] = event
 	req := &eventpb.GetEventRequest{Id: event.ID}
@@ -491,3 +957,543 @@ func BenchmarkEventHandler_GetEvent(b *testing.B) {
 		}
 	}
 }
+
+func TestEventHandler_TicketTypes(t *testing.T) {
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	handler := NewEventHandler(repo, bus.NewMemory())
+	ctx := context.Background()
+
+	created, err := handler.CreateEvent(ctx, &eventpb.CreateEventRequest{
+		Name:     "Test Concert",
+		Date:     "2025-06-01T19:00:00Z",
+		Location: "Test Arena",
+		TicketTypes: []*eventpb.TicketType{
+			{Name: "General", Price: 5000, Currency: "usd", Capacity: 100},
+			{Name: "VIP", Price: 15000, Currency: "USD", Capacity: 10},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	published, err := handler.PublishEvent(ctx, &eventpb.PublishEventRequest{Id: created.Event.Id, OnSale: true})
+	if err != nil {
+		t.Fatalf("PublishEvent() error = %v", err)
+	}
+	event := published.Event
+	if event.TicketStock != 110 {
+		t.Errorf("CreateEvent() ticket stock = %d, want 110", event.TicketStock)
+	}
+	if len(event.TicketTypes) != 2 || event.TicketTypes[0].Currency != "USD" {
+		t.Fatalf("CreateEvent() ticket types = %v", event.TicketTypes)
+	}
+	vip := event.TicketTypes[1]
+
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: event.Id, ReservationId: "res-1", Quantity: 1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReserveStock() without ticket type error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: event.Id, ReservationId: "res-1", TicketTypeId: vip.Id, Quantity: 11})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("ReserveStock() over tier capacity error code = %v, want %v", status.Code(err), codes.ResourceExhausted)
+	}
+
+	reserved, err := handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: event.Id, ReservationId: "res-1", TicketTypeId: vip.Id, Quantity: 2})
+	if err != nil {
+		t.Fatalf("ReserveStock() error = %v", err)
+	}
+	if reserved.Reservation.UnitPrice != 15000 || reserved.Reservation.Currency != "USD" {
+		t.Errorf("ReserveStock() price = %d %s, want 15000 USD", reserved.Reservation.UnitPrice, reserved.Reservation.Currency)
+	}
+
+	availability, err := handler.CheckAvailability(ctx, &eventpb.CheckAvailabilityRequest{EventId: event.Id, TicketTypeId: vip.Id, Quantity: 9})
+	if err != nil || availability.Available {
+		t.Errorf("CheckAvailability() = %v, %v, want unavailable", availability, err)
+	}
+
+	listed, err := handler.ListTicketTypes(ctx, &eventpb.ListTicketTypesRequest{EventId: event.Id})
+	if err != nil {
+		t.Fatalf("ListTicketTypes() error = %v", err)
+	}
+	if listed.TicketTypes[1].Available != 8 {
+		t.Errorf("ListTicketTypes() VIP available = %d, want 8", listed.TicketTypes[1].Available)
+	}
+
+	_, err = handler.DeleteTicketType(ctx, &eventpb.DeleteTicketTypeRequest{Id: vip.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DeleteTicketType() with sales error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	_, err = handler.UpdateEvent(ctx, &eventpb.UpdateEventRequest{Id: event.Id, TicketStock: 500})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateEvent() ticket stock error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	_, err = handler.CreateTicketType(ctx, &eventpb.CreateTicketTypeRequest{EventId: event.Id, Name: "Balcony", Price: 2500, Capacity: 20})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateTicketType() without currency error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	_, err = handler.CreateTicketType(ctx, &eventpb.CreateTicketTypeRequest{EventId: event.Id, Name: "Balcony", Price: 2500, Currency: "USD", Capacity: 20})
+	if err != nil {
+		t.Fatalf("CreateTicketType() error = %v", err)
+	}
+	if got := repo.events[event.Id].TicketStock; got != 128 {
+		t.Errorf("CreateTicketType() ticket stock = %d, want 128", got)
+	}
+}
+
+func TestEventHandler_SeatMap(t *testing.T) {
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	handler := NewEventHandler(repo, bus.NewMemory())
+	ctx := context.Background()
+
+	event, _ := model.NewEvent("Test Concert", "2025-06-01T19:00:00Z", "Test Arena", 10)
+	event.Status = model.EventStatusOnSale
+	repo.events[event.ID] = event
+
+	_, err := handler.SaveSeatMap(ctx, &eventpb.SaveSeatMapRequest{
+		EventId: event.ID,
+		Sections: []*eventpb.SeatMapSection{{
+			Name: "Stalls",
+			Rows: []*eventpb.SeatMapRow{{Label: "A", Seats: []*eventpb.Seat{{Number: 1}, {Number: 1}}}},
+		}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SaveSeatMap() with duplicate seat error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	saved, err := handler.SaveSeatMap(ctx, &eventpb.SaveSeatMapRequest{
+		EventId: event.ID,
+		Sections: []*eventpb.SeatMapSection{
+			{Name: "Stalls", Rows: []*eventpb.SeatMapRow{
+				{Label: "A", Seats: []*eventpb.Seat{{Number: 1, Accessible: true}, {Number: 2}}},
+				{Label: "B", Seats: []*eventpb.Seat{{Number: 1, Obstructed: true}}},
+			}},
+			{Name: "Balcony", Rows: []*eventpb.SeatMapRow{
+				{Label: "A", Seats: []*eventpb.Seat{{Number: 1}}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SaveSeatMap() error = %v", err)
+	}
+	seatMap := saved.SeatMap
+	if len(seatMap.Sections) != 2 || len(seatMap.Sections[0].Rows) != 2 || seatMap.Available != 4 {
+		t.Fatalf("SaveSeatMap() seat map = %v", seatMap)
+	}
+	a1 := seatMap.Sections[0].Rows[0].Seats[0]
+	a2 := seatMap.Sections[0].Rows[0].Seats[1]
+	if !a1.Accessible || a1.Section != "Stalls" || a1.Row != "A" {
+		t.Errorf("SaveSeatMap() seat = %v", a1)
+	}
+
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "res-1", Quantity: 1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReserveStock() without seats error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "res-1", Quantity: 1, SeatIds: []string{a1.Id, a2.Id}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReserveStock() with extra seats error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "res-1", Quantity: 2, SeatIds: []string{a1.Id, a2.Id}})
+	if err != nil {
+		t.Fatalf("ReserveStock() error = %v", err)
+	}
+
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: event.ID, ReservationId: "res-2", Quantity: 1, SeatIds: []string{a2.Id}})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("ReserveStock() of a held seat error code = %v, want %v", status.Code(err), codes.Aborted)
+	}
+
+	if _, err := handler.CommitStock(ctx, &eventpb.CommitStockRequest{ReservationId: "res-1"}); err != nil {
+		t.Fatalf("CommitStock() error = %v", err)
+	}
+
+	got, err := handler.GetSeatMap(ctx, &eventpb.GetSeatMapRequest{EventId: event.ID})
+	if err != nil {
+		t.Fatalf("GetSeatMap() error = %v", err)
+	}
+	if got.SeatMap.Available != 2 || got.SeatMap.Sections[0].Rows[0].Seats[1].Status != string(model.SeatStatusSold) {
+		t.Errorf("GetSeatMap() seat map = %v", got.SeatMap)
+	}
+
+	_, err = handler.SaveSeatMap(ctx, &eventpb.SaveSeatMapRequest{EventId: event.ID})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SaveSeatMap() with sold seats error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	if _, err := handler.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{ReservationId: "res-1"}); err != nil {
+		t.Fatalf("ReleaseStock() error = %v", err)
+	}
+
+	got, _ = handler.GetSeatMap(ctx, &eventpb.GetSeatMapRequest{EventId: event.ID})
+	if got.SeatMap.Available != 4 {
+		t.Errorf("GetSeatMap() available = %d, want 4", got.SeatMap.Available)
+	}
+}
+
+func TestEventHandler_Lifecycle(t *testing.T) {
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	handler := NewEventHandler(repo, bus.NewMemory())
+	ctx := context.Background()
+
+	created, err := handler.CreateEvent(ctx, &eventpb.CreateEventRequest{
+		Name:        "Test Concert",
+		Date:        "2025-06-01T19:00:00Z",
+		Location:    "Test Arena",
+		TicketStock: 10,
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	id := created.Event.Id
+	if created.Event.Status != string(model.EventStatusDraft) {
+		t.Errorf("CreateEvent() status = %s, want %s", created.Event.Status, model.EventStatusDraft)
+	}
+
+	_, err = handler.CheckAvailability(ctx, &eventpb.CheckAvailabilityRequest{EventId: id, Quantity: 1})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CheckAvailability() on draft error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	if _, err := handler.PublishEvent(ctx, &eventpb.PublishEventRequest{Id: id}); err != nil {
+		t.Fatalf("PublishEvent() error = %v", err)
+	}
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: id, ReservationId: "res-1", Quantity: 1})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ReserveStock() on published event error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	if _, err := handler.PublishEvent(ctx, &eventpb.PublishEventRequest{Id: id, OnSale: true}); err != nil {
+		t.Fatalf("PublishEvent() on sale error = %v", err)
+	}
+	if _, err := handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: id, ReservationId: "res-1", Quantity: 1}); err != nil {
+		t.Fatalf("ReserveStock() error = %v", err)
+	}
+
+	postponed, err := handler.PostponeEvent(ctx, &eventpb.PostponeEventRequest{Id: id, Date: "2025-07-01T19:00:00Z", Reason: "artist illness"})
+	if err != nil {
+		t.Fatalf("PostponeEvent() error = %v", err)
+	}
+	if postponed.Event.Date != "2025-07-01T19:00:00Z" || postponed.Event.StatusReason != "artist illness" {
+		t.Errorf("PostponeEvent() event = %v", postponed.Event)
+	}
+
+	if _, err := handler.CancelEvent(ctx, &eventpb.CancelEventRequest{Id: id, Reason: "venue closed"}); err != nil {
+		t.Fatalf("CancelEvent() error = %v", err)
+	}
+
+	_, err = handler.PublishEvent(ctx, &eventpb.PublishEventRequest{Id: id, OnSale: true})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("PublishEvent() on cancelled event error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	_, err = handler.UpdateEvent(ctx, &eventpb.UpdateEventRequest{Id: id, Name: "Renamed"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpdateEvent() on cancelled event error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	_, err = handler.CancelEvent(ctx, &eventpb.CancelEventRequest{Id: "non-existent"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("CancelEvent() on missing event error code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+}
+
+func TestEventHandler_SalesWindows(t *testing.T) {
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	handler := NewEventHandler(repo, bus.NewMemory())
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := handler.CreateEvent(ctx, &eventpb.CreateEventRequest{
+		Name:         "Test Concert",
+		Date:         "2030-06-01T19:00:00Z",
+		Location:     "Test Arena",
+		TicketStock:  10,
+		PresaleStart: now.Add(time.Hour).Format(time.RFC3339),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateEvent() with a presale but no sales start error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	created, err := handler.CreateEvent(ctx, &eventpb.CreateEventRequest{
+		Name:        "Test Concert",
+		Date:        "2030-06-01T19:00:00Z",
+		Location:    "Test Arena",
+		TicketStock: 10,
+		SalesStart:  now.Add(time.Hour).Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	id := created.Event.Id
+	if _, err := handler.PublishEvent(ctx, &eventpb.PublishEventRequest{Id: id, OnSale: true}); err != nil {
+		t.Fatalf("PublishEvent() error = %v", err)
+	}
+
+	_, err = handler.CheckAvailability(ctx, &eventpb.CheckAvailabilityRequest{EventId: id, Quantity: 1})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CheckAvailability() before sales start error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+
+	updated, err := handler.UpdateEvent(ctx, &eventpb.UpdateEventRequest{Id: id, PresaleStart: now.Add(-time.Hour).Format(time.RFC3339)})
+	if err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+	if updated.Event.PresaleStart == "" || updated.Event.SalesStart == "" || updated.Event.SalesEnd != "" {
+		t.Errorf("UpdateEvent() event = %v", updated.Event)
+	}
+
+	generated, err := handler.GeneratePresaleCodes(ctx, &eventpb.GeneratePresaleCodesRequest{EventId: id, Count: 2, MaxUses: 1})
+	if err != nil {
+		t.Fatalf("GeneratePresaleCodes() error = %v", err)
+	}
+	if len(generated.Codes) != 2 || generated.Codes[0].Code == generated.Codes[1].Code {
+		t.Fatalf("GeneratePresaleCodes() codes = %v", generated.Codes)
+	}
+	code, other := generated.Codes[0].Code, generated.Codes[1].Code
+
+	_, err = handler.GeneratePresaleCodes(ctx, &eventpb.GeneratePresaleCodesRequest{EventId: id, Count: 1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GeneratePresaleCodes() without max uses error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: id, ReservationId: "res-1", Quantity: 1})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ReserveStock() in presale without code error code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+
+	reserved, err := handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: id, ReservationId: "res-1", Quantity: 1, AccessCode: code})
+	if err != nil {
+		t.Fatalf("ReserveStock() with code error = %v", err)
+	}
+	if reserved.Reservation.Quantity != 1 {
+		t.Errorf("ReserveStock() reservation = %v", reserved.Reservation)
+	}
+
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: id, ReservationId: "res-2", Quantity: 1, AccessCode: code})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ReserveStock() with a used up code error code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+
+	if _, err := handler.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{ReservationId: "res-1"}); err != nil {
+		t.Fatalf("ReleaseStock() error = %v", err)
+	}
+	available, err := handler.CheckAvailability(ctx, &eventpb.CheckAvailabilityRequest{EventId: id, Quantity: 1, AccessCode: code})
+	if err != nil || !available.Available {
+		t.Errorf("CheckAvailability() with a returned code = %v, %v", available, err)
+	}
+
+	if _, err := handler.RevokePresaleCode(ctx, &eventpb.RevokePresaleCodeRequest{EventId: id, Code: other}); err != nil {
+		t.Fatalf("RevokePresaleCode() error = %v", err)
+	}
+	_, err = handler.CheckAvailability(ctx, &eventpb.CheckAvailabilityRequest{EventId: id, Quantity: 1, AccessCode: other})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CheckAvailability() with a revoked code error code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+
+	_, err = handler.RevokePresaleCode(ctx, &eventpb.RevokePresaleCodeRequest{EventId: id, Code: "UNKNOWN"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("RevokePresaleCode() of an unknown code error code = %v, want %v", status.Code(err), codes.NotFound)
+	}
+
+	listed, err := handler.ListPresaleCodes(ctx, &eventpb.ListPresaleCodesRequest{EventId: id})
+	if err != nil || len(listed.Codes) != 2 {
+		t.Errorf("ListPresaleCodes() = %v, %v", listed, err)
+	}
+
+	_, err = handler.UpdateEvent(ctx, &eventpb.UpdateEventRequest{
+		Id:         id,
+		SalesStart: now.Add(-2 * time.Hour).Format(time.RFC3339),
+		SalesEnd:   now.Add(-time.Minute).Format(time.RFC3339),
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateEvent() with a presale after sales start error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	repo.events[id].PresaleStart = time.Time{}
+	if _, err := handler.UpdateEvent(ctx, &eventpb.UpdateEventRequest{
+		Id:         id,
+		SalesStart: now.Add(-2 * time.Hour).Format(time.RFC3339),
+		SalesEnd:   now.Add(-time.Minute).Format(time.RFC3339),
+	}); err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+	_, err = handler.ReserveStock(ctx, &eventpb.ReserveStockRequest{EventId: id, ReservationId: "res-3", Quantity: 1})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ReserveStock() after sales end error code = %v, want %v", status.Code(err), codes.FailedPrecondition)
+	}
+}
+
+func TestEventHandler_PurchaseLimits(t *testing.T) {
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	handler := NewEventHandler(repo, bus.NewMemory())
+	ctx := context.Background()
+
+	_, err := handler.CreateEvent(ctx, &eventpb.CreateEventRequest{
+		Name:        "Test Concert",
+		Date:        "2030-06-01T19:00:00Z",
+		Location:    "Test Arena",
+		TicketStock: 10,
+		MaxPerOrder: 6,
+		MaxPerUser:  4,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateEvent() with max per order above max per user error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	created, err := handler.CreateEvent(ctx, &eventpb.CreateEventRequest{
+		Name:        "Test Concert",
+		Date:        "2030-06-01T19:00:00Z",
+		Location:    "Test Arena",
+		TicketStock: 10,
+		MaxPerOrder: 2,
+		MaxPerUser:  4,
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	if created.Event.MaxPerOrder != 2 || created.Event.MaxPerUser != 4 {
+		t.Errorf("CreateEvent() limits = %d/%d, want 2/4", created.Event.MaxPerOrder, created.Event.MaxPerUser)
+	}
+
+	updated, err := handler.UpdateEvent(ctx, &eventpb.UpdateEventRequest{Id: created.Event.Id, MaxPerOrder: -1})
+	if err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+	if updated.Event.MaxPerOrder != 0 || updated.Event.MaxPerUser != 4 {
+		t.Errorf("UpdateEvent() limits = %d/%d, want 0/4", updated.Event.MaxPerOrder, updated.Event.MaxPerUser)
+	}
+
+	_, err = handler.UpdateEvent(ctx, &eventpb.UpdateEventRequest{Id: created.Event.Id, MaxPerOrder: 5})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateEvent() with max per order above max per user error code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestEventHandler_NonTransferable(t *testing.T) {
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	handler := NewEventHandler(repo, bus.NewMemory())
+	ctx := context.Background()
+
+	created, err := handler.CreateEvent(ctx, &eventpb.CreateEventRequest{
+		Name:            "Test Concert",
+		Date:            "2030-06-01T19:00:00Z",
+		Location:        "Test Arena",
+		TicketStock:     10,
+		NonTransferable: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	if !created.Event.NonTransferable {
+		t.Errorf("CreateEvent() NonTransferable = false, want true")
+	}
+
+	updated, err := handler.UpdateEvent(ctx, &eventpb.UpdateEventRequest{Id: created.Event.Id, Name: "Renamed Concert"})
+	if err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+	if !updated.Event.NonTransferable {
+		t.Errorf("UpdateEvent() without non_transferable cleared it")
+	}
+
+	transferable := false
+	updated, err = handler.UpdateEvent(ctx, &eventpb.UpdateEventRequest{Id: created.Event.Id, NonTransferable: &transferable})
+	if err != nil {
+		t.Fatalf("UpdateEvent() error = %v", err)
+	}
+	if updated.Event.NonTransferable {
+		t.Errorf("UpdateEvent() NonTransferable = true, want false")
+	}
+}
+
+func TestEventHandler_PublishesDomainEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	eventBus := bus.NewMemory()
+	handler := NewEventHandler(repo, eventBus)
+
+	created, err := handler.CreateEvent(ctx, &eventpb.CreateEventRequest{
+		Name:        "Test Concert",
+		Date:        "2025-06-01T19:00:00Z",
+		Location:    "Test Arena",
+		TicketStock: 100,
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	if _, err := handler.DeleteEvent(ctx, &eventpb.DeleteEventRequest{Id: created.Event.Id}); err != nil {
+		t.Fatalf("DeleteEvent() error = %v", err)
+	}
+
+	received := make(chan *bus.Message, 2)
+	if err := eventBus.Subscribe(ctx, "events.>", 0, func(ctx context.Context, msg *bus.Message) error {
+		received <- msg
+		return nil
+	}); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	for _, want := range []string{"EventCreated", "EventDeleted"} {
+		select {
+		case msg := <-received:
+			if msg.Envelope.Type != want || msg.Subject != "events."+want {
+				t.Errorf("got %s on %s, want %s", msg.Envelope.Type, msg.Subject, want)
+			}
+			var event eventpb.Event
+			if err := msg.Envelope.Payload.UnmarshalTo(&event); err != nil {
+				t.Fatalf("UnmarshalTo() error = %v", err)
+			}
+			if event.Id != created.Event.Id {
+				t.Errorf("payload event ID = %s, want %s", event.Id, created.Event.Id)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %s", want)
+		}
+	}
+}
+
+func TestEventHandler_CancelEventUsesOutbox(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockEventRepository{events: make(map[string]*model.Event)}
+	handler := NewEventHandler(repo, bus.NewMemory())
+
+	created, err := handler.CreateEvent(ctx, &eventpb.CreateEventRequest{
+		Name:        "Test Concert",
+		Date:        "2025-06-01T19:00:00Z",
+		Location:    "Test Arena",
+		TicketStock: 100,
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent() error = %v", err)
+	}
+	if _, err := handler.CancelEvent(ctx, &eventpb.CancelEventRequest{Id: created.Event.Id, Reason: "venue closed"}); err != nil {
+		t.Fatalf("CancelEvent() error = %v", err)
+	}
+
+	if len(repo.outbox) != 1 {
+		t.Fatalf("CancelEvent() added %d outbox messages, want 1", len(repo.outbox))
+	}
+	msg := repo.outbox[0]
+	if msg.Subject != "events.EventCancelled" {
+		t.Errorf("outbox message subject = %s, want events.EventCancelled", msg.Subject)
+	}
+
+	var env buspb.Envelope
+	if err := proto.Unmarshal(msg.Envelope, &env); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	var event eventpb.Event
+	if err := env.Payload.UnmarshalTo(&event); err != nil {
+		t.Fatalf("UnmarshalTo() error = %v", err)
+	}
+	if env.Id != msg.ID || env.Type != "EventCancelled" || event.Id != created.Event.Id || event.StatusReason != "venue closed" {
+		t.Errorf("outbox envelope = %v with event %v", &env, &event)
+	}
+}
@@ -2,22 +2,42 @@ package handler
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"log"
 	"time"
 
 	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
 	"github.com/doniiel/event-ticketing-platform/event-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
 	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+const (
+	EventsStream        = "EVENTS"
+	EventsSubjectPrefix = "events."
+
+	source = "event-service"
+)
+
+// EventsStreamConfig is the bus stream event domain events are published to.
+var EventsStreamConfig = bus.Stream{
+	Name:     EventsStream,
+	Subjects: []string{EventsSubjectPrefix + ">"},
+}
+
 type EventHandler struct {
 	eventpb.UnimplementedEventServiceServer
-	repo repository.EventRepository
+	repo      repository.EventRepository
+	publisher bus.Publisher
 }
 
-func NewEventHandler(repo repository.EventRepository) *EventHandler {
-	return &EventHandler{repo: repo}
+func NewEventHandler(repo repository.EventRepository, publisher bus.Publisher) *EventHandler {
+	return &EventHandler{repo: repo, publisher: publisher}
 }
 
 func (h *EventHandler) GetEvent(ctx context.Context, req *eventpb.GetEventRequest) (*eventpb.GetEventResponse, error) {
@@ -44,7 +64,19 @@ func (h *EventHandler) ListEvents(ctx context.Context, req *eventpb.ListEventsRe
 		pageSize = 10
 	}
 
-	events, total, err := h.repo.List(ctx, page, pageSize)
+	statuses := model.ListedEventStatuses
+	if len(req.Statuses) > 0 {
+		statuses = nil
+		for _, s := range req.Statuses {
+			eventStatus := model.EventStatus(s)
+			if !eventStatus.Valid() {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid status %q", s)
+			}
+			statuses = append(statuses, eventStatus)
+		}
+	}
+
+	events, total, err := h.repo.List(ctx, page, pageSize, statuses)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list events: %v", err)
 	}
@@ -65,20 +97,45 @@ func (h *EventHandler) CreateEvent(ctx context.Context, req *eventpb.CreateEvent
 		return nil, status.Error(codes.InvalidArgument, "name, location, and date are required")
 	}
 
-	if req.TicketStock <= 0 {
+	if req.TicketStock <= 0 && len(req.TicketTypes) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "ticket stock must be greater than 0")
 	}
 
+	for _, ticketType := range req.TicketTypes {
+		if err := validateTicketType(ticketType.Name, ticketType.Price, ticketType.Currency, ticketType.Capacity); err != nil {
+			return nil, err
+		}
+	}
+
 	event, err := model.NewEvent(req.Name, req.Date, req.Location, req.TicketStock)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
 	}
 
+	event.SalesWindow, err = salesWindowFromRequest(event.SalesWindow, req.PresaleStart, req.SalesStart, req.SalesEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	event.PurchaseLimits = model.PurchaseLimits{MaxPerOrder: req.MaxPerOrder, MaxPerUser: req.MaxPerUser}
+	if err := event.PurchaseLimits.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid purchase limits: %v", err)
+	}
+	event.NonTransferable = req.NonTransferable
+
+	for _, ticketType := range req.TicketTypes {
+		event.TicketTypes = append(event.TicketTypes, model.NewTicketType(
+			event.ID, ticketType.Name, ticketType.Price, ticketType.Currency, ticketType.Capacity,
+		))
+	}
+
 	createdEvent, err := h.repo.Create(ctx, event)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create event: %v", err)
+		return nil, ticketTypeError("failed to create event", err)
 	}
 
+	h.publish(ctx, "EventCreated", createdEvent.ToProto())
+
 	return &eventpb.CreateEventResponse{Event: createdEvent.ToProto()}, nil
 }
 
@@ -92,6 +149,10 @@ func (h *EventHandler) UpdateEvent(ctx context.Context, req *eventpb.UpdateEvent
 		return nil, status.Errorf(codes.NotFound, "event not found: %v", err)
 	}
 
+	if existingEvent.Status == model.EventStatusCancelled {
+		return nil, status.Error(codes.FailedPrecondition, "cancelled events cannot be updated")
+	}
+
 	if req.Name != "" {
 		existingEvent.Name = req.Name
 	}
@@ -108,18 +169,51 @@ func (h *EventHandler) UpdateEvent(ctx context.Context, req *eventpb.UpdateEvent
 		existingEvent.Date = newDate
 	}
 
+	existingEvent.SalesWindow, err = salesWindowFromRequest(existingEvent.SalesWindow, req.PresaleStart, req.SalesStart, req.SalesEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	existingEvent.PurchaseLimits.MaxPerOrder = updateLimit(existingEvent.MaxPerOrder, req.MaxPerOrder)
+	existingEvent.PurchaseLimits.MaxPerUser = updateLimit(existingEvent.MaxPerUser, req.MaxPerUser)
+	if err := existingEvent.PurchaseLimits.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid purchase limits: %v", err)
+	}
+
+	if req.NonTransferable != nil {
+		existingEvent.NonTransferable = *req.NonTransferable
+	}
+
+	var ticketStock *int32
 	if req.TicketStock > 0 {
-		existingEvent.TicketStock = req.TicketStock
+		if len(existingEvent.TicketTypes) > 0 {
+			return nil, status.Error(codes.FailedPrecondition, "stock of an event with ticket types is set per ticket type")
+		}
+		ticketStock = &req.TicketStock
 	}
 
-	updatedEvent, err := h.repo.Update(ctx, existingEvent)
+	updatedEvent, err := h.repo.Update(ctx, existingEvent, ticketStock)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update event: %v", err)
 	}
 
+	h.publish(ctx, "EventUpdated", updatedEvent.ToProto())
+
 	return &eventpb.UpdateEventResponse{Event: updatedEvent.ToProto()}, nil
 }
 
+// updateLimit applies an update request's purchase limit to current: 0 keeps
+// it and a negative value removes it.
+func updateLimit(current, requested int32) int32 {
+	switch {
+	case requested < 0:
+		return 0
+	case requested > 0:
+		return requested
+	}
+	return current
+}
+
 func (h *EventHandler) DeleteEvent(ctx context.Context, req *eventpb.DeleteEventRequest) (*eventpb.DeleteEventResponse, error) {
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "event ID is required")
@@ -129,9 +223,70 @@ func (h *EventHandler) DeleteEvent(ctx context.Context, req *eventpb.DeleteEvent
 		return nil, status.Errorf(codes.Internal, "failed to delete event: %v", err)
 	}
 
+	h.publish(ctx, "EventDeleted", &eventpb.Event{Id: req.Id})
+
 	return &eventpb.DeleteEventResponse{}, nil
 }
 
+// PublishEvent makes a draft or postponed event visible and, if asked, opens
+// ticket sales.
+func (h *EventHandler) PublishEvent(ctx context.Context, req *eventpb.PublishEventRequest) (*eventpb.PublishEventResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	next := model.EventStatusPublished
+	if req.OnSale {
+		next = model.EventStatusOnSale
+	}
+
+	event, err := h.repo.ChangeStatus(ctx, req.Id, next, "", time.Time{}, h.announce(ctx, "EventPublished"))
+	if err != nil {
+		return nil, eventStatusError("failed to publish event", err)
+	}
+
+	return &eventpb.PublishEventResponse{Event: event.ToProto()}, nil
+}
+
+// CancelEvent stops an event for good. Tickets already sold are left to the
+// ticket service, which learns of the cancellation through the outbox.
+func (h *EventHandler) CancelEvent(ctx context.Context, req *eventpb.CancelEventRequest) (*eventpb.CancelEventResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	event, err := h.repo.ChangeStatus(ctx, req.Id, model.EventStatusCancelled, req.Reason, time.Time{}, h.announce(ctx, "EventCancelled"))
+	if err != nil {
+		return nil, eventStatusError("failed to cancel event", err)
+	}
+
+	return &eventpb.CancelEventResponse{Event: event.ToProto()}, nil
+}
+
+// PostponeEvent suspends sales until the event is published again, optionally
+// moving it to a new date.
+func (h *EventHandler) PostponeEvent(ctx context.Context, req *eventpb.PostponeEventRequest) (*eventpb.PostponeEventResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	var date time.Time
+	if req.Date != "" {
+		var err error
+		date, err = time.Parse(time.RFC3339, req.Date)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+		}
+	}
+
+	event, err := h.repo.ChangeStatus(ctx, req.Id, model.EventStatusPostponed, req.Reason, date, h.announce(ctx, "EventPostponed"))
+	if err != nil {
+		return nil, eventStatusError("failed to postpone event", err)
+	}
+
+	return &eventpb.PostponeEventResponse{Event: event.ToProto()}, nil
+}
+
 func (h *EventHandler) CheckAvailability(ctx context.Context, req *eventpb.CheckAvailabilityRequest) (*eventpb.CheckAvailabilityResponse, error) {
 	if req.EventId == "" {
 		return nil, status.Error(codes.InvalidArgument, "event ID is required")
@@ -141,10 +296,139 @@ func (h *EventHandler) CheckAvailability(ctx context.Context, req *eventpb.Check
 		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than 0")
 	}
 
-	available, err := h.repo.CheckAvailability(ctx, req.EventId, req.Quantity)
+	if req.TicketTypeId != "" {
+		available, err := h.repo.CheckTicketTypeAvailability(ctx, req.TicketTypeId, req.Quantity, req.AccessCode)
+		if err != nil {
+			return nil, reservationError("failed to check availability", err)
+		}
+		return &eventpb.CheckAvailabilityResponse{Available: available}, nil
+	}
+
+	available, err := h.repo.CheckAvailability(ctx, req.EventId, req.Quantity, req.AccessCode)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check availability: %v", err)
+		return nil, reservationError("failed to check availability", err)
 	}
 
 	return &eventpb.CheckAvailabilityResponse{Available: available}, nil
 }
+
+func (h *EventHandler) ReserveStock(ctx context.Context, req *eventpb.ReserveStockRequest) (*eventpb.ReserveStockResponse, error) {
+	if req.EventId == "" || req.ReservationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID and reservation ID are required")
+	}
+
+	if req.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than 0")
+	}
+
+	if len(req.SeatIds) > 0 {
+		if int32(len(req.SeatIds)) != req.Quantity {
+			return nil, status.Error(codes.InvalidArgument, "one seat is required per ticket")
+		}
+		seen := make(map[string]bool, len(req.SeatIds))
+		for _, seatID := range req.SeatIds {
+			if seen[seatID] {
+				return nil, status.Errorf(codes.InvalidArgument, "seat %s is given more than once", seatID)
+			}
+			seen[seatID] = true
+		}
+	}
+
+	reservation, err := h.repo.ReserveStock(ctx, req.ReservationId, req.EventId, req.TicketTypeId, req.SeatIds, req.Quantity, req.AccessCode, req.AccessGroup)
+	if err != nil {
+		return nil, reservationError("failed to reserve stock", err)
+	}
+
+	return &eventpb.ReserveStockResponse{Reservation: reservation.ToProto()}, nil
+}
+
+func (h *EventHandler) ReleaseStock(ctx context.Context, req *eventpb.ReleaseStockRequest) (*eventpb.ReleaseStockResponse, error) {
+	if req.ReservationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reservation ID is required")
+	}
+
+	reservation, err := h.repo.ReleaseStock(ctx, req.ReservationId)
+	if err != nil {
+		return nil, reservationError("failed to release stock", err)
+	}
+
+	return &eventpb.ReleaseStockResponse{Reservation: reservation.ToProto()}, nil
+}
+
+func (h *EventHandler) CommitStock(ctx context.Context, req *eventpb.CommitStockRequest) (*eventpb.CommitStockResponse, error) {
+	if req.ReservationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reservation ID is required")
+	}
+
+	reservation, err := h.repo.CommitStock(ctx, req.ReservationId)
+	if err != nil {
+		return nil, reservationError("failed to commit stock", err)
+	}
+
+	return &eventpb.CommitStockResponse{Reservation: reservation.ToProto()}, nil
+}
+
+func reservationError(msg string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrInsufficientStock):
+		return status.Errorf(codes.ResourceExhausted, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrReservationNotFound), errors.Is(err, repository.ErrTicketTypeNotFound),
+		errors.Is(err, repository.ErrSeatNotFound), errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrReservationConflict):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrInvalidReservationState), errors.Is(err, repository.ErrEventNotOnSale),
+		errors.Is(err, repository.ErrSalesNotStarted), errors.Is(err, repository.ErrSalesEnded):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrAccessCodeRequired), errors.Is(err, repository.ErrInvalidAccessCode),
+		errors.Is(err, repository.ErrAccessCodeUsedUp):
+		return status.Errorf(codes.PermissionDenied, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrSeatUnavailable):
+		return status.Errorf(codes.Aborted, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTicketTypeRequired), errors.Is(err, repository.ErrSeatsRequired),
+		errors.Is(err, repository.ErrSeatTicketType):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+func eventStatusError(msg string, err error) error {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrInvalidEventTransition):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+// publish emits an event domain event on the bus. Publishing is best effort:
+// the change is already stored, so a failure is only logged.
+func (h *EventHandler) publish(ctx context.Context, eventType string, event *eventpb.Event) {
+	env, err := bus.NewEnvelope(ctx, uuid.NewString(), eventType, source, time.Now(), event)
+	if err == nil {
+		err = h.publisher.Publish(ctx, EventsSubjectPrefix+eventType, env)
+	}
+	if err != nil {
+		log.Printf("Failed to publish %s for event %s: %v", eventType, event.Id, err)
+	}
+}
+
+// announce returns the outbox message for a status change of an event, so
+// that eventType is published even if the bus is down when the change is
+// made.
+func (h *EventHandler) announce(ctx context.Context, eventType string) repository.Announce {
+	return func(event *model.Event) (*model.OutboxMessage, error) {
+		env, err := bus.NewEnvelope(ctx, uuid.NewString(), eventType, source, time.Now(), event.ToProto())
+		if err != nil {
+			return nil, err
+		}
+		envelope, err := proto.Marshal(env)
+		if err != nil {
+			return nil, err
+		}
+		return model.NewOutboxMessage(env.Id, EventsSubjectPrefix+eventType, envelope), nil
+	}
+}
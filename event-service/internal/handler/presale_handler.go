@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/repository"
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxPresaleCodes = 1000
+
+func (h *EventHandler) GeneratePresaleCodes(ctx context.Context, req *eventpb.GeneratePresaleCodesRequest) (*eventpb.GeneratePresaleCodesResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	if req.Count <= 0 || req.Count > maxPresaleCodes {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be between 1 and %d", maxPresaleCodes)
+	}
+
+	if req.MaxUses <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "max uses must be greater than 0")
+	}
+
+	presaleCodes := make([]*model.PresaleCode, 0, req.Count)
+	for i := int32(0); i < req.Count; i++ {
+		code, err := model.NewPresaleCode(req.EventId, req.MaxUses)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate presale code: %v", err)
+		}
+		presaleCodes = append(presaleCodes, code)
+	}
+
+	created, err := h.repo.CreatePresaleCodes(ctx, req.EventId, presaleCodes)
+	if err != nil {
+		return nil, presaleCodeError("failed to generate presale codes", err)
+	}
+
+	return &eventpb.GeneratePresaleCodesResponse{Codes: presaleCodesToProto(created)}, nil
+}
+
+func (h *EventHandler) ListPresaleCodes(ctx context.Context, req *eventpb.ListPresaleCodesRequest) (*eventpb.ListPresaleCodesResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	presaleCodes, err := h.repo.ListPresaleCodes(ctx, req.EventId)
+	if err != nil {
+		return nil, presaleCodeError("failed to list presale codes", err)
+	}
+
+	return &eventpb.ListPresaleCodesResponse{Codes: presaleCodesToProto(presaleCodes)}, nil
+}
+
+func (h *EventHandler) RevokePresaleCode(ctx context.Context, req *eventpb.RevokePresaleCodeRequest) (*eventpb.RevokePresaleCodeResponse, error) {
+	if req.EventId == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID and code are required")
+	}
+
+	code, err := h.repo.RevokePresaleCode(ctx, req.EventId, strings.ToUpper(req.Code))
+	if err != nil {
+		return nil, presaleCodeError("failed to revoke presale code", err)
+	}
+
+	return &eventpb.RevokePresaleCodeResponse{Code: code.ToProto()}, nil
+}
+
+func presaleCodesToProto(presaleCodes []*model.PresaleCode) []*eventpb.PresaleCode {
+	protoCodes := make([]*eventpb.PresaleCode, 0, len(presaleCodes))
+	for _, code := range presaleCodes {
+		protoCodes = append(protoCodes, code.ToProto())
+	}
+	return protoCodes
+}
+
+// salesWindowFromRequest applies the sales window fields of a create or update
+// request to window. Empty fields leave the window as it is.
+func salesWindowFromRequest(window model.SalesWindow, presaleStart, salesStart, salesEnd string) (model.SalesWindow, error) {
+	fields := []struct {
+		name  string
+		value string
+		dest  *time.Time
+	}{
+		{"presale start", presaleStart, &window.PresaleStart},
+		{"sales start", salesStart, &window.SalesStart},
+		{"sales end", salesEnd, &window.SalesEnd},
+	}
+
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, field.value)
+		if err != nil {
+			return window, status.Errorf(codes.InvalidArgument, "invalid %s format: %v", field.name, err)
+		}
+		*field.dest = t
+	}
+
+	if err := window.Validate(); err != nil {
+		return window, status.Errorf(codes.InvalidArgument, "invalid sales window: %v", err)
+	}
+	return window, nil
+}
+
+func presaleCodeError(msg string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrPresaleCodeNotFound), errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
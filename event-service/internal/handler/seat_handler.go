@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/repository"
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *EventHandler) SaveSeatMap(ctx context.Context, req *eventpb.SaveSeatMapRequest) (*eventpb.SaveSeatMapResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	if err := validateSeatMap(req.Sections); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	seats, err := h.repo.SaveSeatMap(ctx, req.EventId, model.SeatsFromProto(req.EventId, req.Sections))
+	if err != nil {
+		return nil, seatMapError("failed to save seat map", err)
+	}
+
+	return &eventpb.SaveSeatMapResponse{SeatMap: model.SeatMapToProto(req.EventId, seats)}, nil
+}
+
+func (h *EventHandler) GetSeatMap(ctx context.Context, req *eventpb.GetSeatMapRequest) (*eventpb.GetSeatMapResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	if _, err := h.repo.GetByID(ctx, req.EventId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to find event: %v", err)
+	}
+
+	seats, err := h.repo.ListSeats(ctx, req.EventId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get seat map: %v", err)
+	}
+
+	return &eventpb.GetSeatMapResponse{SeatMap: model.SeatMapToProto(req.EventId, seats)}, nil
+}
+
+func validateSeatMap(sections []*eventpb.SeatMapSection) error {
+	seen := make(map[string]bool)
+	for _, section := range sections {
+		if section.Name == "" {
+			return errors.New("section name is required")
+		}
+		for _, row := range section.Rows {
+			if row.Label == "" {
+				return fmt.Errorf("row label is required in section %s", section.Name)
+			}
+			for _, seat := range row.Seats {
+				if seat.Number <= 0 {
+					return fmt.Errorf("seat number must be greater than 0 in section %s row %s", section.Name, row.Label)
+				}
+				key := fmt.Sprintf("%s/%s/%d", section.Name, row.Label, seat.Number)
+				if seen[key] {
+					return fmt.Errorf("seat %d appears twice in section %s row %s", seat.Number, section.Name, row.Label)
+				}
+				seen[key] = true
+			}
+		}
+	}
+	return nil
+}
+
+func seatMapError(msg string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrTicketTypeNotFound), errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrSeatMapInUse):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/repository"
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *EventHandler) CreateTicketType(ctx context.Context, req *eventpb.CreateTicketTypeRequest) (*eventpb.CreateTicketTypeResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	if err := validateTicketType(req.Name, req.Price, req.Currency, req.Capacity); err != nil {
+		return nil, err
+	}
+
+	ticketType, err := h.repo.CreateTicketType(ctx, model.NewTicketType(req.EventId, req.Name, req.Price, req.Currency, req.Capacity))
+	if err != nil {
+		return nil, ticketTypeError("failed to create ticket type", err)
+	}
+
+	return &eventpb.CreateTicketTypeResponse{TicketType: ticketType.ToProto()}, nil
+}
+
+func (h *EventHandler) ListTicketTypes(ctx context.Context, req *eventpb.ListTicketTypesRequest) (*eventpb.ListTicketTypesResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	ticketTypes, err := h.repo.ListTicketTypes(ctx, req.EventId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list ticket types: %v", err)
+	}
+
+	protoTicketTypes := make([]*eventpb.TicketType, 0, len(ticketTypes))
+	for _, ticketType := range ticketTypes {
+		protoTicketTypes = append(protoTicketTypes, ticketType.ToProto())
+	}
+
+	return &eventpb.ListTicketTypesResponse{TicketTypes: protoTicketTypes}, nil
+}
+
+func (h *EventHandler) UpdateTicketType(ctx context.Context, req *eventpb.UpdateTicketTypeRequest) (*eventpb.UpdateTicketTypeResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticket type ID is required")
+	}
+
+	ticketType, err := h.repo.GetTicketType(ctx, req.Id)
+	if err != nil {
+		return nil, ticketTypeError("failed to find ticket type", err)
+	}
+
+	if req.Name != "" {
+		ticketType.Name = req.Name
+	}
+
+	if req.Price > 0 {
+		ticketType.Price = req.Price
+	}
+
+	if req.Currency != "" {
+		ticketType.Currency = strings.ToUpper(req.Currency)
+	}
+
+	if req.Capacity > 0 {
+		ticketType.Capacity = req.Capacity
+	}
+
+	if err := validateTicketType(ticketType.Name, ticketType.Price, ticketType.Currency, ticketType.Capacity); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.repo.UpdateTicketType(ctx, ticketType)
+	if err != nil {
+		return nil, ticketTypeError("failed to update ticket type", err)
+	}
+
+	return &eventpb.UpdateTicketTypeResponse{TicketType: updated.ToProto()}, nil
+}
+
+func (h *EventHandler) DeleteTicketType(ctx context.Context, req *eventpb.DeleteTicketTypeRequest) (*eventpb.DeleteTicketTypeResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticket type ID is required")
+	}
+
+	if err := h.repo.DeleteTicketType(ctx, req.Id); err != nil {
+		return nil, ticketTypeError("failed to delete ticket type", err)
+	}
+
+	return &eventpb.DeleteTicketTypeResponse{}, nil
+}
+
+func validateTicketType(name string, price int64, currency string, capacity int32) error {
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "ticket type name is required")
+	}
+	if price < 0 {
+		return status.Error(codes.InvalidArgument, "ticket type price cannot be negative")
+	}
+	if len(currency) != 3 && (price > 0 || currency != "") {
+		return status.Error(codes.InvalidArgument, "ticket type currency must be a 3-letter ISO 4217 code")
+	}
+	if capacity <= 0 {
+		return status.Error(codes.InvalidArgument, "ticket type capacity must be greater than 0")
+	}
+	return nil
+}
+
+func ticketTypeError(msg string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrTicketTypeNotFound), errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTicketTypeExists):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTicketTypeInUse), errors.Is(err, repository.ErrUntypedStockSold),
+		errors.Is(err, repository.ErrEventNotOnSale):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
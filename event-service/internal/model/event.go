@@ -1,30 +1,130 @@
 package model
 
 import (
+	"errors"
 	"time"
 
 	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
 	"github.com/google/uuid"
 )
 
+type EventStatus string
+
+const (
+	EventStatusDraft     EventStatus = "DRAFT"
+	EventStatusPublished EventStatus = "PUBLISHED"
+	EventStatusOnSale    EventStatus = "ON_SALE"
+	EventStatusSoldOut   EventStatus = "SOLD_OUT"
+	EventStatusCancelled EventStatus = "CANCELLED"
+	EventStatusPostponed EventStatus = "POSTPONED"
+)
+
+// Valid reports whether s is one of the known event statuses.
+func (s EventStatus) Valid() bool {
+	switch s {
+	case EventStatusDraft, EventStatusPublished, EventStatusOnSale, EventStatusSoldOut,
+		EventStatusCancelled, EventStatusPostponed:
+		return true
+	}
+	return false
+}
+
+var eventTransitions = map[EventStatus][]EventStatus{
+	EventStatusDraft:     {EventStatusPublished, EventStatusOnSale, EventStatusCancelled},
+	EventStatusPublished: {EventStatusOnSale, EventStatusPostponed, EventStatusCancelled},
+	EventStatusOnSale:    {EventStatusSoldOut, EventStatusPostponed, EventStatusCancelled},
+	EventStatusSoldOut:   {EventStatusOnSale, EventStatusPostponed, EventStatusCancelled},
+	EventStatusPostponed: {EventStatusPublished, EventStatusOnSale, EventStatusCancelled},
+}
+
+// CanTransitionTo reports whether an event in status s may move to next.
+// CANCELLED is terminal. SOLD_OUT is entered and left as stock runs out and
+// comes back.
+func (s EventStatus) CanTransitionTo(next EventStatus) bool {
+	for _, allowed := range eventTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ListedEventStatuses are the statuses ListEvents returns unless asked for
+// others.
+var ListedEventStatuses = []EventStatus{
+	EventStatusPublished,
+	EventStatusOnSale,
+	EventStatusSoldOut,
+	EventStatusPostponed,
+}
+
 type Event struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Date        time.Time `json:"date"`
-	Location    string    `json:"location"`
-	TicketStock int32     `json:"ticket_stock"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Date        time.Time   `json:"date"`
+	Location    string      `json:"location"`
+	TicketStock int32       `json:"ticket_stock"`
+	Status      EventStatus `json:"status"`
+	// StatusReason explains the last cancellation or postponement.
+	StatusReason string `json:"status_reason,omitempty"`
+	SalesWindow
+	PurchaseLimits
+	// NonTransferable forbids ticket holders from transferring their tickets.
+	NonTransferable bool `json:"non_transferable,omitempty"`
+	// TicketTypes are the event's priced tiers. When an event has any, its
+	// TicketStock is the sum of their stock.
+	TicketTypes []*TicketType `json:"ticket_types,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
 }
 
 func (e *Event) ToProto() *eventpb.Event {
+	ticketTypes := make([]*eventpb.TicketType, 0, len(e.TicketTypes))
+	for _, ticketType := range e.TicketTypes {
+		ticketTypes = append(ticketTypes, ticketType.ToProto())
+	}
+
 	return &eventpb.Event{
-		Id:          e.ID,
-		Name:        e.Name,
-		Date:        e.Date.Format(time.RFC3339),
-		Location:    e.Location,
-		TicketStock: e.TicketStock,
+		Id:              e.ID,
+		Name:            e.Name,
+		Date:            e.Date.Format(time.RFC3339),
+		Location:        e.Location,
+		TicketStock:     e.TicketStock,
+		TicketTypes:     ticketTypes,
+		Status:          string(e.Status),
+		StatusReason:    e.StatusReason,
+		SalesStart:      formatTime(e.SalesStart),
+		SalesEnd:        formatTime(e.SalesEnd),
+		PresaleStart:    formatTime(e.PresaleStart),
+		MaxPerOrder:     e.MaxPerOrder,
+		MaxPerUser:      e.MaxPerUser,
+		NonTransferable: e.NonTransferable,
+	}
+}
+
+// PurchaseLimits caps how many of an event's tickets a single order and a
+// single user may take. Zero means no limit. Ticket-service enforces them.
+type PurchaseLimits struct {
+	MaxPerOrder int32 `json:"max_per_order,omitempty"`
+	MaxPerUser  int32 `json:"max_per_user,omitempty"`
+}
+
+func (l PurchaseLimits) Validate() error {
+	if l.MaxPerOrder < 0 || l.MaxPerUser < 0 {
+		return errors.New("limits cannot be negative")
+	}
+	if l.MaxPerOrder > 0 && l.MaxPerUser > 0 && l.MaxPerOrder > l.MaxPerUser {
+		return errors.New("max per order cannot exceed max per user")
+	}
+	return nil
+}
+
+// formatTime formats t as RFC 3339, or as "" when it is unset.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
 	}
+	return t.Format(time.RFC3339)
 }
 
 func EventFromProto(e *eventpb.Event) (*Event, error) {
@@ -34,11 +134,13 @@ func EventFromProto(e *eventpb.Event) (*Event, error) {
 	}
 
 	return &Event{
-		ID:          e.Id,
-		Name:        e.Name,
-		Date:        date,
-		Location:    e.Location,
-		TicketStock: e.TicketStock,
+		ID:              e.Id,
+		Name:            e.Name,
+		Date:            date,
+		Location:        e.Location,
+		TicketStock:     e.TicketStock,
+		Status:          EventStatus(e.Status),
+		NonTransferable: e.NonTransferable,
 	}, nil
 }
 
@@ -54,5 +156,6 @@ func NewEvent(name, dateStr, location string, ticketStock int32) (*Event, error)
 		Date:        date,
 		Location:    location,
 		TicketStock: ticketStock,
+		Status:      EventStatusDraft,
 	}, nil
 }
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// OutboxMessage is a domain event stored in the same transaction as the
+// change it announces, so that it reaches the bus even if the bus is down
+// when the change is made. Envelope is the marshalled bus envelope, published
+// on Subject.
+type OutboxMessage struct {
+	ID            string    `json:"id"`
+	Subject       string    `json:"subject"`
+	Envelope      []byte    `json:"-"`
+	Attempts      int32     `json:"attempts"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func NewOutboxMessage(id, subject string, envelope []byte) *OutboxMessage {
+	now := time.Now()
+	return &OutboxMessage{
+		ID:            id,
+		Subject:       subject,
+		Envelope:      envelope,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
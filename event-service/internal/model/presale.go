@@ -0,0 +1,102 @@
+package model
+
+import (
+	"crypto/rand"
+	"errors"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+)
+
+type SalesPhase string
+
+const (
+	SalesPhaseNotStarted SalesPhase = "NOT_STARTED"
+	SalesPhasePresale    SalesPhase = "PRESALE"
+	SalesPhaseOpen       SalesPhase = "OPEN"
+	SalesPhaseEnded      SalesPhase = "ENDED"
+)
+
+// SalesWindow limits when an event's tickets can be bought. A zero time is
+// unset: without SalesStart sales are open as soon as the event is on sale,
+// and without SalesEnd they stay open. From PresaleStart until SalesStart only
+// buyers with a presale code can buy.
+type SalesWindow struct {
+	PresaleStart time.Time `json:"presale_start,omitempty"`
+	SalesStart   time.Time `json:"sales_start,omitempty"`
+	SalesEnd     time.Time `json:"sales_end,omitempty"`
+}
+
+func (w SalesWindow) Validate() error {
+	if !w.SalesStart.IsZero() && !w.SalesEnd.IsZero() && !w.SalesEnd.After(w.SalesStart) {
+		return errors.New("sales must end after they start")
+	}
+	if w.PresaleStart.IsZero() {
+		return nil
+	}
+	if w.SalesStart.IsZero() {
+		return errors.New("a presale needs a sales start")
+	}
+	if !w.PresaleStart.Before(w.SalesStart) {
+		return errors.New("the presale must start before general sales")
+	}
+	return nil
+}
+
+func (w SalesWindow) Phase(now time.Time) SalesPhase {
+	switch {
+	case !w.SalesEnd.IsZero() && !now.Before(w.SalesEnd):
+		return SalesPhaseEnded
+	case w.SalesStart.IsZero() || !now.Before(w.SalesStart):
+		return SalesPhaseOpen
+	case !w.PresaleStart.IsZero() && !now.Before(w.PresaleStart):
+		return SalesPhasePresale
+	}
+	return SalesPhaseNotStarted
+}
+
+// presaleCodeAlphabet leaves out characters that are easily mistaken for one
+// another. Its 32 letters divide 256, so every byte maps to one uniformly.
+const (
+	presaleCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	presaleCodeLength   = 10
+)
+
+// PresaleCode lets a buyer purchase during an event's presale, up to MaxUses
+// times.
+type PresaleCode struct {
+	Code      string    `json:"code"`
+	EventID   string    `json:"event_id"`
+	MaxUses   int32     `json:"max_uses"`
+	Uses      int32     `json:"uses"`
+	Revoked   bool      `json:"revoked"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewPresaleCode(eventID string, maxUses int32) (*PresaleCode, error) {
+	b := make([]byte, presaleCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	for i := range b {
+		b[i] = presaleCodeAlphabet[int(b[i])%len(presaleCodeAlphabet)]
+	}
+
+	return &PresaleCode{
+		Code:      string(b),
+		EventID:   eventID,
+		MaxUses:   maxUses,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (c *PresaleCode) ToProto() *eventpb.PresaleCode {
+	return &eventpb.PresaleCode{
+		Code:      c.Code,
+		EventId:   c.EventID,
+		MaxUses:   c.MaxUses,
+		Uses:      c.Uses,
+		Revoked:   c.Revoked,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationStatusReserved  ReservationStatus = "RESERVED"
+	ReservationStatusCommitted ReservationStatus = "COMMITTED"
+	ReservationStatusReleased  ReservationStatus = "RELEASED"
+)
+
+// StockReservation is a hold on an event's stock. Reservations against a
+// ticket type carry the tier's price at the time of reserving; those for
+// reserved seating name the seats they hold. AccessCode is the presale code a
+// reservation was made with, if it was made during a presale; reservations
+// with the same AccessGroup share a single use of it.
+type StockReservation struct {
+	ID           string            `json:"id"`
+	EventID      string            `json:"event_id"`
+	TicketTypeID string            `json:"ticket_type_id,omitempty"`
+	Quantity     int32             `json:"quantity"`
+	UnitPrice    int64             `json:"unit_price"`
+	Currency     string            `json:"currency,omitempty"`
+	SeatIDs      []string          `json:"seat_ids,omitempty"`
+	AccessCode   string            `json:"access_code,omitempty"`
+	AccessGroup  string            `json:"access_group,omitempty"`
+	Status       ReservationStatus `json:"status"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+func (r *StockReservation) ToProto() *eventpb.StockReservation {
+	return &eventpb.StockReservation{
+		ReservationId: r.ID,
+		EventId:       r.EventID,
+		Quantity:      r.Quantity,
+		Status:        string(r.Status),
+		TicketTypeId:  r.TicketTypeID,
+		UnitPrice:     r.UnitPrice,
+		Currency:      r.Currency,
+		SeatIds:       r.SeatIDs,
+	}
+}
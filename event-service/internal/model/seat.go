@@ -0,0 +1,108 @@
+package model
+
+import (
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/google/uuid"
+)
+
+type SeatStatus string
+
+const (
+	SeatStatusAvailable SeatStatus = "AVAILABLE"
+	SeatStatusHeld      SeatStatus = "HELD"
+	SeatStatusSold      SeatStatus = "SOLD"
+)
+
+// Seat is a numbered place in an event's venue layout. A seat with a
+// TicketTypeID can only be sold as that ticket type; one without can be sold
+// as any. ReservationID is the stock reservation holding or owning the seat.
+type Seat struct {
+	ID            string     `json:"id"`
+	EventID       string     `json:"event_id"`
+	Section       string     `json:"section"`
+	Row           string     `json:"row"`
+	Number        int32      `json:"number"`
+	TicketTypeID  string     `json:"ticket_type_id,omitempty"`
+	Accessible    bool       `json:"accessible"`
+	Obstructed    bool       `json:"obstructed"`
+	Status        SeatStatus `json:"status"`
+	ReservationID string     `json:"reservation_id,omitempty"`
+	// Position keeps seats in the order the layout was given in.
+	Position int32 `json:"-"`
+}
+
+func NewSeat(eventID, section, row string, number int32, position int32) *Seat {
+	return &Seat{
+		ID:       uuid.New().String(),
+		EventID:  eventID,
+		Section:  section,
+		Row:      row,
+		Number:   number,
+		Status:   SeatStatusAvailable,
+		Position: position,
+	}
+}
+
+// SellableAs reports whether the seat may be sold as ticketTypeID.
+func (s *Seat) SellableAs(ticketTypeID string) bool {
+	return s.TicketTypeID == "" || s.TicketTypeID == ticketTypeID
+}
+
+func (s *Seat) ToProto() *eventpb.Seat {
+	return &eventpb.Seat{
+		Id:           s.ID,
+		Section:      s.Section,
+		Row:          s.Row,
+		Number:       s.Number,
+		TicketTypeId: s.TicketTypeID,
+		Accessible:   s.Accessible,
+		Obstructed:   s.Obstructed,
+		Status:       string(s.Status),
+	}
+}
+
+// SeatsFromProto flattens a layout of sections and rows into seats, in the
+// order given.
+func SeatsFromProto(eventID string, sections []*eventpb.SeatMapSection) []*Seat {
+	var seats []*Seat
+	for _, section := range sections {
+		for _, row := range section.Rows {
+			for _, s := range row.Seats {
+				seat := NewSeat(eventID, section.Name, row.Label, s.Number, int32(len(seats)))
+				seat.TicketTypeID = s.TicketTypeId
+				seat.Accessible = s.Accessible
+				seat.Obstructed = s.Obstructed
+				seats = append(seats, seat)
+			}
+		}
+	}
+	return seats
+}
+
+// SeatMapToProto groups seats, which must be in layout order, back into
+// sections and rows.
+func SeatMapToProto(eventID string, seats []*Seat) *eventpb.SeatMap {
+	seatMap := &eventpb.SeatMap{EventId: eventID}
+
+	var (
+		section *eventpb.SeatMapSection
+		row     *eventpb.SeatMapRow
+	)
+	for _, seat := range seats {
+		if section == nil || section.Name != seat.Section {
+			section = &eventpb.SeatMapSection{Name: seat.Section}
+			seatMap.Sections = append(seatMap.Sections, section)
+			row = nil
+		}
+		if row == nil || row.Label != seat.Row {
+			row = &eventpb.SeatMapRow{Label: seat.Row}
+			section.Rows = append(section.Rows, row)
+		}
+		row.Seats = append(row.Seats, seat.ToProto())
+
+		if seat.Status == SeatStatusAvailable {
+			seatMap.Available++
+		}
+	}
+	return seatMap
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/google/uuid"
+)
+
+// TicketType is a priced tier of an event's tickets with its own capacity.
+// Prices are in minor units of Currency. Stock is what is left to sell.
+type TicketType struct {
+	ID        string    `json:"id"`
+	EventID   string    `json:"event_id"`
+	Name      string    `json:"name"`
+	Price     int64     `json:"price"`
+	Currency  string    `json:"currency"`
+	Capacity  int32     `json:"capacity"`
+	Stock     int32     `json:"stock"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTicketType(eventID, name string, price int64, currency string, capacity int32) *TicketType {
+	return &TicketType{
+		ID:       uuid.New().String(),
+		EventID:  eventID,
+		Name:     name,
+		Price:    price,
+		Currency: strings.ToUpper(currency),
+		Capacity: capacity,
+		Stock:    capacity,
+	}
+}
+
+// Sold is the number of tickets of this type that are held or sold.
+func (t *TicketType) Sold() int32 {
+	return t.Capacity - t.Stock
+}
+
+func (t *TicketType) ToProto() *eventpb.TicketType {
+	return &eventpb.TicketType{
+		Id:        t.ID,
+		EventId:   t.EventID,
+		Name:      t.Name,
+		Price:     t.Price,
+		Currency:  t.Currency,
+		Capacity:  t.Capacity,
+		Available: t.Stock,
+	}
+}
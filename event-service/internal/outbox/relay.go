@@ -0,0 +1,125 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
+	buspb "github.com/doniiel/event-ticketing-platform/proto/bus"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// claimLease is how long a claimed message stays hidden from other
+	// relays while it is being published.
+	claimLease = 30 * time.Second
+	maxBackoff = 5 * time.Minute
+)
+
+// Relay polls the outbox and publishes pending messages on the bus, retrying
+// failures with exponential backoff until they succeed. The bus drops
+// envelopes it has already seen, so a message published twice is delivered
+// once.
+type Relay struct {
+	repo      repository.OutboxRepository
+	publisher bus.Publisher
+	interval  time.Duration
+	stopCh    chan struct{}
+}
+
+func NewRelay(repo repository.OutboxRepository, publisher bus.Publisher, interval time.Duration) *Relay {
+	return &Relay{
+		repo:      repo,
+		publisher: publisher,
+		interval:  interval,
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (r *Relay) Start() {
+	log.Printf("Starting outbox relay, polling every %v", r.interval)
+	go r.run()
+}
+
+func (r *Relay) Stop() {
+	close(r.stopCh)
+}
+
+func (r *Relay) run() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.Drain(context.Background())
+		case <-r.stopCh:
+			log.Println("Outbox relay stopped")
+			return
+		}
+	}
+}
+
+// Drain publishes due messages until the outbox has none left or the relay is
+// stopped.
+func (r *Relay) Drain(ctx context.Context) {
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		default:
+		}
+
+		now := time.Now()
+		msg, err := r.repo.ClaimNext(ctx, now, claimLease)
+		if err != nil {
+			log.Printf("Failed to claim outbox message: %v", err)
+			return
+		}
+		if msg == nil {
+			return
+		}
+
+		publishCtx, cancel := context.WithTimeout(ctx, claimLease)
+		err = r.publish(publishCtx, msg.Subject, msg.Envelope)
+		cancel()
+
+		if err != nil {
+			next := now.Add(backoff(msg.Attempts))
+			log.Printf("Failed to publish outbox message %s on %s (attempt %d), retrying at %v: %v",
+				msg.ID, msg.Subject, msg.Attempts, next.Format(time.RFC3339), err)
+			if err := r.repo.MarkFailed(ctx, msg.ID, next, err); err != nil {
+				log.Printf("Failed to record outbox failure for %s: %v", msg.ID, err)
+			}
+			continue
+		}
+
+		if err := r.repo.MarkPublished(ctx, msg.ID, time.Now()); err != nil {
+			log.Printf("Failed to mark outbox message %s published: %v", msg.ID, err)
+		}
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, subject string, envelope []byte) error {
+	var env buspb.Envelope
+	if err := proto.Unmarshal(envelope, &env); err != nil {
+		return fmt.Errorf("invalid envelope: %w", err)
+	}
+
+	ctx = bus.ContextFromEnvelope(ctx, &env)
+	return r.publisher.Publish(ctx, subject, &env)
+}
+
+func backoff(attempts int32) time.Duration {
+	delay := time.Second
+	for i := int32(1); i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,139 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
+	buspb "github.com/doniiel/event-ticketing-platform/proto/bus"
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"google.golang.org/protobuf/proto"
+)
+
+// memoryOutbox is an outbox kept in memory.
+type memoryOutbox struct {
+	messages  []*model.OutboxMessage
+	published map[string]bool
+	failures  map[string]error
+}
+
+func (o *memoryOutbox) ClaimNext(ctx context.Context, now time.Time, lease time.Duration) (*model.OutboxMessage, error) {
+	for _, msg := range o.messages {
+		if o.published[msg.ID] || msg.NextAttemptAt.After(now) {
+			continue
+		}
+		msg.Attempts++
+		msg.NextAttemptAt = now.Add(lease)
+		return msg, nil
+	}
+	return nil, nil
+}
+
+func (o *memoryOutbox) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	o.published[id] = true
+	return nil
+}
+
+func (o *memoryOutbox) MarkFailed(ctx context.Context, id string, next time.Time, cause error) error {
+	for _, msg := range o.messages {
+		if msg.ID == id {
+			msg.NextAttemptAt = next
+		}
+	}
+	o.failures[id] = cause
+	return nil
+}
+
+// failingPublisher fails every publish until it is healed.
+type failingPublisher struct {
+	bus.Publisher
+	failing bool
+}
+
+func (p *failingPublisher) Publish(ctx context.Context, subject string, env *buspb.Envelope) error {
+	if p.failing {
+		return errors.New("bus is down")
+	}
+	return p.Publisher.Publish(ctx, subject, env)
+}
+
+func newMessage(t *testing.T, eventType string) *model.OutboxMessage {
+	env, err := bus.NewEnvelope(context.Background(), "evt-"+eventType, eventType, "event-service", time.Now(), &eventpb.Event{Id: "event1"})
+	if err != nil {
+		t.Fatalf("NewEnvelope() error = %v", err)
+	}
+	envelope, err := proto.Marshal(env)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	return model.NewOutboxMessage(env.Id, "events."+eventType, envelope)
+}
+
+func TestRelay_Drain(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := &memoryOutbox{
+		messages:  []*model.OutboxMessage{newMessage(t, "EventCancelled")},
+		published: make(map[string]bool),
+		failures:  make(map[string]error),
+	}
+	eventBus := bus.NewMemory()
+	publisher := &failingPublisher{Publisher: eventBus, failing: true}
+	relay := NewRelay(repo, publisher, time.Second)
+
+	// While the bus is down the message waits for its next attempt.
+	relay.Drain(ctx)
+	msg := repo.messages[0]
+	if repo.published[msg.ID] || repo.failures[msg.ID] == nil {
+		t.Fatalf("Drain() with the bus down published = %v, failure = %v", repo.published[msg.ID], repo.failures[msg.ID])
+	}
+	if wait := time.Until(msg.NextAttemptAt); wait <= 0 || wait > time.Second {
+		t.Errorf("Drain() retries in %v, want within a second", wait)
+	}
+
+	publisher.failing = false
+	msg.NextAttemptAt = time.Now()
+	relay.Drain(ctx)
+	if !repo.published[msg.ID] {
+		t.Fatal("Drain() did not publish the message once the bus was back")
+	}
+
+	received := make(chan *bus.Message, 1)
+	if err := eventBus.Subscribe(ctx, "events.>", 0, func(ctx context.Context, msg *bus.Message) error {
+		received <- msg
+		return nil
+	}); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	select {
+	case got := <-received:
+		if got.Subject != "events.EventCancelled" || got.Envelope.Id != msg.ID || got.Envelope.Type != "EventCancelled" {
+			t.Errorf("got %s %s on %s", got.Envelope.Type, got.Envelope.Id, got.Subject)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for EventCancelled")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{4, 8 * time.Second},
+		{20, maxBackoff},
+	}
+
+	for _, tt := range tests {
+		if got := backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -5,17 +5,47 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
+
 	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+	"github.com/go-sql-driver/mysql"
+)
+
+var (
+	ErrInsufficientStock       = errors.New("not enough tickets available")
+	ErrReservationNotFound     = errors.New("reservation not found")
+	ErrReservationConflict     = errors.New("reservation ID already used for a different request")
+	ErrInvalidReservationState = errors.New("reservation is not in a valid state for this operation")
+	ErrEventNotOnSale          = errors.New("event is not on sale")
+	ErrInvalidEventTransition  = errors.New("event status transition not allowed")
 )
 
+const eventColumns = `id, name, date, location, ticket_stock, status, status_reason, sales_start, sales_end, presale_start, max_per_order, max_per_user, non_transferable, created_at, updated_at`
+
 type EventRepository interface {
 	Create(ctx context.Context, event *model.Event) (*model.Event, error)
 	GetByID(ctx context.Context, id string) (*model.Event, error)
-	Update(ctx context.Context, event *model.Event) (*model.Event, error)
+	Update(ctx context.Context, event *model.Event, ticketStock *int32) (*model.Event, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, page, pageSize int32) ([]*model.Event, int32, error)
-	CheckAvailability(ctx context.Context, eventID string, quantity int32) (bool, error)
+	List(ctx context.Context, page, pageSize int32, statuses []model.EventStatus) ([]*model.Event, int32, error)
+	ChangeStatus(ctx context.Context, id string, status model.EventStatus, reason string, date time.Time, announce Announce) (*model.Event, error)
+	CheckAvailability(ctx context.Context, eventID string, quantity int32, accessCode string) (bool, error)
 	UpdateTicketStock(ctx context.Context, eventID string, quantity int32) error
+	ReserveStock(ctx context.Context, reservationID, eventID, ticketTypeID string, seatIDs []string, quantity int32, accessCode, accessGroup string) (*model.StockReservation, error)
+	ReleaseStock(ctx context.Context, reservationID string) (*model.StockReservation, error)
+	CommitStock(ctx context.Context, reservationID string) (*model.StockReservation, error)
+	CreateTicketType(ctx context.Context, ticketType *model.TicketType) (*model.TicketType, error)
+	GetTicketType(ctx context.Context, id string) (*model.TicketType, error)
+	ListTicketTypes(ctx context.Context, eventID string) ([]*model.TicketType, error)
+	UpdateTicketType(ctx context.Context, ticketType *model.TicketType) (*model.TicketType, error)
+	DeleteTicketType(ctx context.Context, id string) error
+	CheckTicketTypeAvailability(ctx context.Context, ticketTypeID string, quantity int32, accessCode string) (bool, error)
+	SaveSeatMap(ctx context.Context, eventID string, seats []*model.Seat) ([]*model.Seat, error)
+	ListSeats(ctx context.Context, eventID string) ([]*model.Seat, error)
+	CreatePresaleCodes(ctx context.Context, eventID string, codes []*model.PresaleCode) ([]*model.PresaleCode, error)
+	ListPresaleCodes(ctx context.Context, eventID string) ([]*model.PresaleCode, error)
+	RevokePresaleCode(ctx context.Context, eventID, code string) (*model.PresaleCode, error)
 }
 
 type EventRepositoryImpl struct {
@@ -26,13 +56,29 @@ func NewEventRepository(db *sql.DB) EventRepository {
 	return &EventRepositoryImpl{db: db}
 }
 
+// Create stores the event together with its ticket types. An event with
+// ticket types starts with the sum of their capacities as its stock.
 func (r *EventRepositoryImpl) Create(ctx context.Context, event *model.Event) (*model.Event, error) {
 	query := `
-		INSERT INTO events (id, name, date, location, ticket_stock)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO events (id, name, date, location, ticket_stock, status, sales_start, sales_end, presale_start, max_per_order, max_per_user, non_transferable)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	_, err := r.db.ExecContext(
+	if len(event.TicketTypes) > 0 {
+		event.TicketStock = 0
+		for _, ticketType := range event.TicketTypes {
+			ticketType.EventID = event.ID
+			event.TicketStock += ticketType.Stock
+		}
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(
 		ctx,
 		query,
 		event.ID,
@@ -40,12 +86,29 @@ func (r *EventRepositoryImpl) Create(ctx context.Context, event *model.Event) (*
 		event.Date,
 		event.Location,
 		event.TicketStock,
+		event.Status,
+		nullTime(event.SalesStart),
+		nullTime(event.SalesEnd),
+		nullTime(event.PresaleStart),
+		event.MaxPerOrder,
+		event.MaxPerUser,
+		event.NonTransferable,
 	)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create event: %w", err)
 	}
 
+	for _, ticketType := range event.TicketTypes {
+		if err := insertTicketType(ctx, tx, ticketType); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit event: %w", err)
+	}
+
 	createdEvent, err := r.GetByID(ctx, event.ID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve created event: %w", err)
@@ -56,22 +119,12 @@ func (r *EventRepositoryImpl) Create(ctx context.Context, event *model.Event) (*
 
 func (r *EventRepositoryImpl) GetByID(ctx context.Context, id string) (*model.Event, error) {
 	query := `
-		SELECT id, name, date, location, ticket_stock, created_at, updated_at
+		SELECT ` + eventColumns + `
 		FROM events
 		WHERE id = ?
 	`
 
-	var event model.Event
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&event.ID,
-		&event.Name,
-		&event.Date,
-		&event.Location,
-		&event.TicketStock,
-		&event.CreatedAt,
-		&event.UpdatedAt,
-	)
-
+	event, err := scanEvent(r.db.QueryRowContext(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("event not found: %w", err)
@@ -79,37 +132,67 @@ func (r *EventRepositoryImpl) GetByID(ctx context.Context, id string) (*model.Ev
 		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	return &event, nil
+	event.TicketTypes, err = r.ListTicketTypes(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
 }
 
-func (r *EventRepositoryImpl) Update(ctx context.Context, event *model.Event) (*model.Event, error) {
+// Update stores the event's details. Its stock is only written when
+// ticketStock is set, in which case the event's row is locked first so the
+// new stock cannot overwrite a reservation that is taking stock at the same
+// time; a nil ticketStock leaves the stock as the reservations left it.
+func (r *EventRepositoryImpl) Update(ctx context.Context, event *model.Event, ticketStock *int32) (*model.Event, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, _, err := lockEvent(ctx, tx, event.ID); err != nil {
+		return nil, err
+	}
+
 	query := `
 		UPDATE events
-		SET name = ?, date = ?, location = ?, ticket_stock = ?
+		SET name = ?, date = ?, location = ?,
+			sales_start = ?, sales_end = ?, presale_start = ?,
+			max_per_order = ?, max_per_user = ?, non_transferable = ?
 		WHERE id = ?
 	`
 
-	result, err := r.db.ExecContext(
+	_, err = tx.ExecContext(
 		ctx,
 		query,
 		event.Name,
 		event.Date,
 		event.Location,
-		event.TicketStock,
+		nullTime(event.SalesStart),
+		nullTime(event.SalesEnd),
+		nullTime(event.PresaleStart),
+		event.MaxPerOrder,
+		event.MaxPerUser,
+		event.NonTransferable,
 		event.ID,
 	)
-
 	if err != nil {
 		return nil, fmt.Errorf("failed to update event: %w", err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	if ticketStock != nil {
+		_, err := tx.ExecContext(ctx, `UPDATE events SET ticket_stock = ? WHERE id = ?`, *ticketStock, event.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update ticket stock: %w", err)
+		}
+		if err := syncSoldOut(ctx, tx, event.ID); err != nil {
+			return nil, err
+		}
 	}
 
-	if rowsAffected == 0 {
-		return nil, fmt.Errorf("event not found")
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit event update: %w", err)
 	}
 
 	updatedEvent, err := r.GetByID(ctx, event.ID)
@@ -140,23 +223,35 @@ func (r *EventRepositoryImpl) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *EventRepositoryImpl) List(ctx context.Context, page, pageSize int32) ([]*model.Event, int32, error) {
+// List returns a page of events in date order. Only events in one of
+// statuses are returned; an empty statuses returns events in any status.
+func (r *EventRepositoryImpl) List(ctx context.Context, page, pageSize int32, statuses []model.EventStatus) ([]*model.Event, int32, error) {
+	where := ""
+	var args []interface{}
+	if len(statuses) > 0 {
+		where = "WHERE status IN (?" + strings.Repeat(", ?", len(statuses)-1) + ")"
+		for _, status := range statuses {
+			args = append(args, status)
+		}
+	}
+
 	var total int32
-	countQuery := `SELECT COUNT(*) FROM events`
-	err := r.db.QueryRowContext(ctx, countQuery).Scan(&total)
+	countQuery := `SELECT COUNT(*) FROM events ` + where
+	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count events: %w", err)
 	}
 
 	query := `
-		SELECT id, name, date, location, ticket_stock, created_at, updated_at
+		SELECT ` + eventColumns + `
 		FROM events
+		` + where + `
 		ORDER BY date ASC
 		LIMIT ? OFFSET ?
 	`
 
 	offset := (page - 1) * pageSize
-	rows, err := r.db.QueryContext(ctx, query, pageSize, offset)
+	rows, err := r.db.QueryContext(ctx, query, append(args, pageSize, offset)...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list events: %w", err)
 	}
@@ -169,37 +264,97 @@ func (r *EventRepositoryImpl) List(ctx context.Context, page, pageSize int32) ([
 
 	var events []*model.Event
 	for rows.Next() {
-		var event model.Event
-		if err := rows.Scan(
-			&event.ID,
-			&event.Name,
-			&event.Date,
-			&event.Location,
-			&event.TicketStock,
-			&event.CreatedAt,
-			&event.UpdatedAt,
-		); err != nil {
+		event, err := scanEvent(rows)
+		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan event: %w", err)
 		}
-		events = append(events, &event)
+		events = append(events, event)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, 0, fmt.Errorf("rows error: %w", err)
 	}
 
+	if err := r.attachTicketTypes(ctx, events); err != nil {
+		return nil, 0, err
+	}
+
 	return events, total, nil
 }
 
-func (r *EventRepositoryImpl) CheckAvailability(ctx context.Context, eventID string, quantity int32) (bool, error) {
-	query := `SELECT ticket_stock FROM events WHERE id = ?`
+// ChangeStatus moves an event to status if its current status allows it,
+// recording reason. A non-zero date reschedules the event at the same time.
+// The message built by announce is added to the outbox with the change.
+func (r *EventRepositoryImpl) ChangeStatus(ctx context.Context, id string, status model.EventStatus, reason string, date time.Time, announce Announce) (*model.Event, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	current, stock, err := lockEvent(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !current.CanTransitionTo(status) {
+		return nil, fmt.Errorf("%w: %s to %s", ErrInvalidEventTransition, current, status)
+	}
+
+	// An event opened for sale with nothing left to sell is sold out.
+	if status == model.EventStatusOnSale && stock == 0 {
+		status = model.EventStatusSoldOut
+	}
+
+	query := `UPDATE events SET status = ?, status_reason = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`
+	args := []interface{}{status, reason, id}
+	if !date.IsZero() {
+		query = `UPDATE events SET status = ?, status_reason = ?, date = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?`
+		args = []interface{}{status, reason, date, id}
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to update event status: %w", err)
+	}
+
+	event, err := scanEvent(tx.QueryRowContext(ctx, `SELECT `+eventColumns+` FROM events WHERE id = ?`, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+	event.TicketTypes, err = r.ListTicketTypes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := announce(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to announce event status: %w", err)
+	}
+	if err := addOutboxMessage(ctx, tx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit event status: %w", err)
+	}
+
+	return event, nil
+}
+
+// CheckAvailability reports whether quantity tickets are left for an event
+// whose sales are open, or open to accessCode during its presale.
+func (r *EventRepositoryImpl) CheckAvailability(ctx context.Context, eventID string, quantity int32, accessCode string) (bool, error) {
+	query := `SELECT ticket_stock, status FROM events WHERE id = ?`
 
 	if quantity <= 0 {
 		return false, fmt.Errorf("invalid quantity: must be greater than 0")
 	}
 
-	var ticketStock int32
-	err := r.db.QueryRowContext(ctx, query, eventID).Scan(&ticketStock)
+	var (
+		ticketStock int32
+		status      model.EventStatus
+	)
+	err := r.db.QueryRowContext(ctx, query, eventID).Scan(&ticketStock, &status)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return false, fmt.Errorf("event not found: %w", err)
@@ -207,6 +362,16 @@ func (r *EventRepositoryImpl) CheckAvailability(ctx context.Context, eventID str
 		return false, fmt.Errorf("failed to check availability: %w", err)
 	}
 
+	if status != model.EventStatusSoldOut {
+		if err := checkOnSale(status); err != nil {
+			return false, err
+		}
+	}
+
+	if _, _, err := checkSalesWindow(ctx, r.db, eventID, accessCode, "", time.Now(), false); err != nil {
+		return false, err
+	}
+
 	return ticketStock >= quantity, nil
 }
 
@@ -235,5 +400,398 @@ func (r *EventRepositoryImpl) UpdateTicketStock(ctx context.Context, eventID str
 		return fmt.Errorf("not enough tickets available or event not found")
 	}
 
+	return syncSoldOut(ctx, r.db, eventID)
+}
+
+// ReserveStock takes quantity tickets out of the event's stock and records the
+// hold under reservationID. Events with ticket types must be reserved against
+// one of them; its stock is taken as well and its price recorded on the
+// reservation. Events with reserved seating must be reserved with one seat per
+// ticket, all of which are held. Repeating the call with the same reservation
+// ID and payload returns the existing reservation without touching stock
+// again. During a presale the reservation uses up one use of accessCode,
+// shared with the other reservations of accessGroup.
+func (r *EventRepositoryImpl) ReserveStock(ctx context.Context, reservationID, eventID, ticketTypeID string, seatIDs []string, quantity int32, accessCode, accessGroup string) (*model.StockReservation, error) {
+	if quantity <= 0 {
+		return nil, fmt.Errorf("invalid quantity: must be greater than 0")
+	}
+
+	if len(seatIDs) > 0 && int32(len(seatIDs)) != quantity {
+		return nil, fmt.Errorf("invalid seats: %d seats for %d tickets", len(seatIDs), quantity)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var (
+		unitPrice int64
+		currency  string
+	)
+	if ticketTypeID != "" {
+		err := tx.QueryRowContext(ctx, `
+			SELECT price, currency FROM ticket_types
+			WHERE id = ? AND event_id = ?
+		`, ticketTypeID, eventID).Scan(&unitPrice, &currency)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTicketTypeNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ticket type: %w", err)
+		}
+	} else {
+		var tiers int
+		err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM ticket_types WHERE event_id = ?`, eventID).Scan(&tiers)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count ticket types: %w", err)
+		}
+		if tiers > 0 {
+			return nil, ErrTicketTypeRequired
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO stock_reservations (id, event_id, ticket_type_id, quantity, unit_price, currency, status)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, reservationID, eventID, ticketTypeID, quantity, unitPrice, currency, model.ReservationStatusReserved)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if !errors.As(err, &mysqlErr) || mysqlErr.Number != 1062 {
+			return nil, fmt.Errorf("failed to create reservation: %w", err)
+		}
+
+		existing, err := getReservation(ctx, tx, reservationID, false)
+		if err != nil {
+			return nil, err
+		}
+		if existing.EventID != eventID || existing.TicketTypeID != ticketTypeID || existing.Quantity != quantity ||
+			!sameSeats(existing.SeatIDs, seatIDs) {
+			return nil, ErrReservationConflict
+		}
+		return existing, nil
+	}
+
+	status, _, err := lockEvent(ctx, tx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOnSale(status); err != nil {
+		return nil, err
+	}
+
+	usedCode, held, err := checkSalesWindow(ctx, tx, eventID, accessCode, accessGroup, time.Now(), true)
+	if err != nil {
+		return nil, err
+	}
+	if usedCode != "" {
+		if err := usePresaleCode(ctx, tx, reservationID, usedCode, accessGroup, held); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := holdSeats(ctx, tx, reservationID, eventID, ticketTypeID, seatIDs); err != nil {
+		return nil, err
+	}
+
+	if ticketTypeID != "" {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE ticket_types
+			SET stock = stock - ?, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ? AND stock >= ?
+		`, quantity, ticketTypeID, quantity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update ticket type stock: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return nil, ErrInsufficientStock
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE events
+		SET ticket_stock = ticket_stock - ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND ticket_stock >= ?
+	`, quantity, eventID, quantity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ticket stock: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		var exists int
+		err := tx.QueryRowContext(ctx, `SELECT 1 FROM events WHERE id = ?`, eventID).Scan(&exists)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("event not found: %w", err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get event: %w", err)
+		}
+		return nil, ErrInsufficientStock
+	}
+
+	if err := syncSoldOut(ctx, tx, eventID); err != nil {
+		return nil, err
+	}
+
+	reservation, err := getReservation(ctx, tx, reservationID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit reservation: %w", err)
+	}
+
+	return reservation, nil
+}
+
+// ReleaseStock returns a reservation's quantity to the event's stock, and to
+// its ticket type's, whether it is still held or was already committed (e.g. a
+// refunded ticket). Releasing an already released reservation is a no-op.
+func (r *EventRepositoryImpl) ReleaseStock(ctx context.Context, reservationID string) (*model.StockReservation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	reservation, err := getReservation(ctx, tx, reservationID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if reservation.Status == model.ReservationStatusReleased {
+		return reservation, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE events
+		SET ticket_stock = ticket_stock + ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+	`, reservation.Quantity, reservation.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ticket stock: %w", err)
+	}
+
+	if reservation.TicketTypeID != "" {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE ticket_types
+			SET stock = stock + ?, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ?
+		`, reservation.Quantity, reservation.TicketTypeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update ticket type stock: %w", err)
+		}
+	}
+
+	if err := releaseSeats(ctx, tx, reservation.ID); err != nil {
+		return nil, err
+	}
+
+	if err := returnPresaleCode(ctx, tx, reservation); err != nil {
+		return nil, err
+	}
+
+	if err := syncSoldOut(ctx, tx, reservation.EventID); err != nil {
+		return nil, err
+	}
+
+	if err := setReservationStatus(ctx, tx, reservation, model.ReservationStatusReleased); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit release: %w", err)
+	}
+
+	return reservation, nil
+}
+
+// CommitStock finalizes a held reservation so its tickets are permanently
+// sold. Committing an already committed reservation is a no-op.
+func (r *EventRepositoryImpl) CommitStock(ctx context.Context, reservationID string) (*model.StockReservation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	reservation, err := getReservation(ctx, tx, reservationID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	switch reservation.Status {
+	case model.ReservationStatusCommitted:
+		return reservation, nil
+	case model.ReservationStatusReleased:
+		return nil, ErrInvalidReservationState
+	}
+
+	if err := sellSeats(ctx, tx, reservation.ID); err != nil {
+		return nil, err
+	}
+
+	if err := setReservationStatus(ctx, tx, reservation, model.ReservationStatusCommitted); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit reservation: %w", err)
+	}
+
+	return reservation, nil
+}
+
+// lockEvent locks an event's row for the rest of tx and returns its status
+// and remaining stock.
+func lockEvent(ctx context.Context, tx *sql.Tx, eventID string) (model.EventStatus, int32, error) {
+	var (
+		status model.EventStatus
+		stock  int32
+	)
+	err := tx.QueryRowContext(ctx, `
+		SELECT status, ticket_stock FROM events WHERE id = ? FOR UPDATE
+	`, eventID).Scan(&status, &stock)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", 0, fmt.Errorf("event not found: %w", err)
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to get event: %w", err)
+	}
+	return status, stock, nil
+}
+
+// checkOnSale reports why tickets for an event in status cannot be sold, if
+// they cannot.
+func checkOnSale(status model.EventStatus) error {
+	switch status {
+	case model.EventStatusOnSale:
+		return nil
+	case model.EventStatusSoldOut:
+		return ErrInsufficientStock
+	}
+	return fmt.Errorf("%w: event is %s", ErrEventNotOnSale, status)
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// syncSoldOut moves an event between ON_SALE and SOLD_OUT to match its
+// remaining stock. Events in any other status are left alone.
+func syncSoldOut(ctx context.Context, db execer, eventID string) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE events
+		SET status = IF(ticket_stock > 0, ?, ?)
+		WHERE id = ? AND status IN (?, ?)
+	`, model.EventStatusOnSale, model.EventStatusSoldOut, eventID, model.EventStatusOnSale, model.EventStatusSoldOut)
+	if err != nil {
+		return fmt.Errorf("failed to update sold out status: %w", err)
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanEvent(row rowScanner) (*model.Event, error) {
+	var (
+		event                              model.Event
+		salesStart, salesEnd, presaleStart sql.NullTime
+	)
+	err := row.Scan(
+		&event.ID,
+		&event.Name,
+		&event.Date,
+		&event.Location,
+		&event.TicketStock,
+		&event.Status,
+		&event.StatusReason,
+		&salesStart,
+		&salesEnd,
+		&presaleStart,
+		&event.MaxPerOrder,
+		&event.MaxPerUser,
+		&event.NonTransferable,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	event.SalesStart = salesStart.Time
+	event.SalesEnd = salesEnd.Time
+	event.PresaleStart = presaleStart.Time
+	return &event, nil
+}
+
+// nullTime stores an unset time as NULL.
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func getReservation(ctx context.Context, tx *sql.Tx, reservationID string, forUpdate bool) (*model.StockReservation, error) {
+	query := `
+		SELECT id, event_id, ticket_type_id, quantity, unit_price, currency, access_code, access_group, status, created_at, updated_at
+		FROM stock_reservations
+		WHERE id = ?
+	`
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
+	var reservation model.StockReservation
+	err := tx.QueryRowContext(ctx, query, reservationID).Scan(
+		&reservation.ID,
+		&reservation.EventID,
+		&reservation.TicketTypeID,
+		&reservation.Quantity,
+		&reservation.UnitPrice,
+		&reservation.Currency,
+		&reservation.AccessCode,
+		&reservation.AccessGroup,
+		&reservation.Status,
+		&reservation.CreatedAt,
+		&reservation.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReservationNotFound
+		}
+		return nil, fmt.Errorf("failed to get reservation: %w", err)
+	}
+
+	reservation.SeatIDs, err = reservationSeatIDs(ctx, tx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reservation, nil
+}
+
+func setReservationStatus(ctx context.Context, tx *sql.Tx, reservation *model.StockReservation, status model.ReservationStatus) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE stock_reservations
+		SET status = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+	`, status, reservation.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update reservation: %w", err)
+	}
+
+	reservation.Status = status
 	return nil
 }
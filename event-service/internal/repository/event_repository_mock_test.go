@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockRepository returns a repository on a sqlmock database, for tests
+// that must run without MySQL.
+func newMockRepository(t *testing.T) (EventRepository, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return NewEventRepository(db), mock
+}
+
+// expectOnSale expects ReserveStock to lock an ON_SALE event with stock left
+// whose sales are open.
+func expectOnSale(mock sqlmock.Sqlmock, eventID string) {
+	mock.ExpectQuery("SELECT status, ticket_stock FROM events WHERE id = \\? FOR UPDATE").
+		WithArgs(eventID).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "ticket_stock"}).AddRow(model.EventStatusOnSale, 10))
+	mock.ExpectQuery("SELECT sales_start, sales_end, presale_start FROM events").
+		WithArgs(eventID).
+		WillReturnRows(sqlmock.NewRows([]string{"sales_start", "sales_end", "presale_start"}).AddRow(nil, nil, nil))
+}
+
+// expectSeatCount expects ReserveStock, reserving without seats, to count the
+// seats of the event's seat map.
+func expectSeatCount(mock sqlmock.Sqlmock, seats int) {
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM seats").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(seats))
+}
+
+func TestEventRepository_ReserveStock_TicketTypes(t *testing.T) {
+	ctx := context.Background()
+	priceColumns := []string{"price", "currency"}
+
+	t.Run("TicketTypeRequired", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM ticket_types WHERE event_id = \\?").
+			WithArgs("event1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectRollback()
+
+		_, err := repo.ReserveStock(ctx, "res1", "event1", "", nil, 1, "", "")
+		assert.ErrorIs(t, err, ErrTicketTypeRequired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("TicketTypeNotFound", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT price, currency FROM ticket_types").
+			WithArgs("vip", "event1").
+			WillReturnRows(sqlmock.NewRows(priceColumns))
+		mock.ExpectRollback()
+
+		_, err := repo.ReserveStock(ctx, "res1", "event1", "vip", nil, 1, "", "")
+		assert.ErrorIs(t, err, ErrTicketTypeNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("TicketTypeSoldOut", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT price, currency FROM ticket_types").
+			WithArgs("vip", "event1").
+			WillReturnRows(sqlmock.NewRows(priceColumns).AddRow(15000, "USD"))
+		mock.ExpectExec("INSERT INTO stock_reservations").
+			WithArgs("res1", "event1", "vip", 3, 15000, "USD", model.ReservationStatusReserved).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectOnSale(mock, "event1")
+		expectSeatCount(mock, 0)
+		mock.ExpectExec("UPDATE ticket_types").
+			WithArgs(3, "vip", 3).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		// The event has stock left, but not of this ticket type, so neither
+		// stock is taken and the reservation is rolled back.
+		_, err := repo.ReserveStock(ctx, "res1", "event1", "vip", nil, 3, "", "")
+		assert.ErrorIs(t, err, ErrInsufficientStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("TicketTypePriced", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		now := time.Now()
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT price, currency FROM ticket_types").
+			WithArgs("vip", "event1").
+			WillReturnRows(sqlmock.NewRows(priceColumns).AddRow(15000, "USD"))
+		mock.ExpectExec("INSERT INTO stock_reservations").
+			WithArgs("res1", "event1", "vip", 2, 15000, "USD", model.ReservationStatusReserved).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectOnSale(mock, "event1")
+		expectSeatCount(mock, 0)
+		mock.ExpectExec("UPDATE ticket_types").
+			WithArgs(2, "vip", 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE events\\s+SET ticket_stock = ticket_stock - \\?").
+			WithArgs(2, "event1", 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("SET status = IF").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("FROM stock_reservations").
+			WithArgs("res1").
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "event_id", "ticket_type_id", "quantity", "unit_price", "currency",
+				"access_code", "access_group", "status", "created_at", "updated_at",
+			}).AddRow("res1", "event1", "vip", 2, 15000, "USD", "", "", model.ReservationStatusReserved, now, now))
+		mock.ExpectQuery("SELECT seat_id FROM reservation_seats").
+			WithArgs("res1").
+			WillReturnRows(sqlmock.NewRows([]string{"seat_id"}))
+		mock.ExpectCommit()
+
+		reservation, err := repo.ReserveStock(ctx, "res1", "event1", "vip", nil, 2, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(15000), reservation.UnitPrice)
+		assert.Equal(t, "USD", reservation.Currency)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestEventRepository_ReserveStock_Seats(t *testing.T) {
+	ctx := context.Background()
+	seatColumns := []string{"id", "ticket_type_id", "status"}
+
+	// expectUntyped expects the reservation of an event without ticket types
+	// to be recorded, and the event to be on sale.
+	expectUntyped := func(mock sqlmock.Sqlmock, quantity int) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM ticket_types").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec("INSERT INTO stock_reservations").
+			WithArgs("res1", "event1", "", quantity, 0, "", model.ReservationStatusReserved).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectOnSale(mock, "event1")
+	}
+
+	t.Run("SeatCountMismatch", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+
+		_, err := repo.ReserveStock(ctx, "res1", "event1", "", []string{"seat1"}, 2, "", "")
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SeatsRequired", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		expectUntyped(mock, 2)
+		expectSeatCount(mock, 40)
+		mock.ExpectRollback()
+
+		_, err := repo.ReserveStock(ctx, "res1", "event1", "", nil, 2, "", "")
+		assert.ErrorIs(t, err, ErrSeatsRequired)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SeatNotFound", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		expectUntyped(mock, 2)
+		mock.ExpectQuery("SELECT id, ticket_type_id, status\\s+FROM seats").
+			WithArgs("event1", "seat1", "seat2").
+			WillReturnRows(sqlmock.NewRows(seatColumns).AddRow("seat1", "", model.SeatStatusAvailable))
+		mock.ExpectRollback()
+
+		_, err := repo.ReserveStock(ctx, "res1", "event1", "", []string{"seat1", "seat2"}, 2, "", "")
+		assert.ErrorIs(t, err, ErrSeatNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SeatTaken", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		expectUntyped(mock, 2)
+		mock.ExpectQuery("SELECT id, ticket_type_id, status\\s+FROM seats").
+			WithArgs("event1", "seat1", "seat2").
+			WillReturnRows(sqlmock.NewRows(seatColumns).
+				AddRow("seat1", "", model.SeatStatusAvailable).
+				AddRow("seat2", "", model.SeatStatusHeld))
+		mock.ExpectRollback()
+
+		// Neither seat is held and no stock is taken.
+		_, err := repo.ReserveStock(ctx, "res1", "event1", "", []string{"seat1", "seat2"}, 2, "", "")
+		assert.ErrorIs(t, err, ErrSeatUnavailable)
+		assert.ErrorContains(t, err, "seat2")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SeatOfOtherTicketType", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		expectUntyped(mock, 1)
+		mock.ExpectQuery("SELECT id, ticket_type_id, status\\s+FROM seats").
+			WithArgs("event1", "seat1").
+			WillReturnRows(sqlmock.NewRows(seatColumns).AddRow("seat1", "vip", model.SeatStatusAvailable))
+		mock.ExpectRollback()
+
+		_, err := repo.ReserveStock(ctx, "res1", "event1", "", []string{"seat1"}, 1, "", "")
+		assert.ErrorIs(t, err, ErrSeatTicketType)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SeatsHeld", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		now := time.Now()
+		expectUntyped(mock, 2)
+		mock.ExpectQuery("SELECT id, ticket_type_id, status\\s+FROM seats").
+			WithArgs("event1", "seat2", "seat1").
+			WillReturnRows(sqlmock.NewRows(seatColumns).
+				AddRow("seat1", "", model.SeatStatusAvailable).
+				AddRow("seat2", "", model.SeatStatusAvailable))
+		mock.ExpectExec("UPDATE seats SET status = \\?, reservation_id = \\?").
+			WithArgs(model.SeatStatusHeld, "res1", "event1", "seat2", "seat1").
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("INSERT INTO reservation_seats").
+			WithArgs("res1", "seat2").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO reservation_seats").
+			WithArgs("res1", "seat1").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("UPDATE events\\s+SET ticket_stock = ticket_stock - \\?").
+			WithArgs(2, "event1", 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("SET status = IF").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("FROM stock_reservations").
+			WithArgs("res1").
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "event_id", "ticket_type_id", "quantity", "unit_price", "currency",
+				"access_code", "access_group", "status", "created_at", "updated_at",
+			}).AddRow("res1", "event1", "", 2, 0, "", "", "", model.ReservationStatusReserved, now, now))
+		mock.ExpectQuery("SELECT seat_id FROM reservation_seats").
+			WithArgs("res1").
+			WillReturnRows(sqlmock.NewRows([]string{"seat_id"}).AddRow("seat1").AddRow("seat2"))
+		mock.ExpectCommit()
+
+		reservation, err := repo.ReserveStock(ctx, "res1", "event1", "", []string{"seat2", "seat1"}, 2, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"seat1", "seat2"}, reservation.SeatIDs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestEventRepository_Update_Stock(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	event := &model.Event{ID: "event1", Name: "Renamed Concert", Date: now, Location: "Arena", TicketStock: 100}
+	eventRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{
+			"id", "name", "date", "location", "ticket_stock", "status", "status_reason",
+			"sales_start", "sales_end", "presale_start", "max_per_order", "max_per_user", "non_transferable",
+			"created_at", "updated_at",
+		}).AddRow("event1", "Renamed Concert", now, "Arena", 7, model.EventStatusOnSale, "", nil, nil, nil, 0, 0, false, now, now)
+	}
+	expectLocked := func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT status, ticket_stock FROM events WHERE id = \\? FOR UPDATE").
+			WithArgs("event1").
+			WillReturnRows(sqlmock.NewRows([]string{"status", "ticket_stock"}).AddRow(model.EventStatusOnSale, 7))
+		mock.ExpectExec("UPDATE events\\s+SET name = \\?").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	t.Run("StockUnchanged", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		// A reservation took 3 tickets since event was read with 100; the
+		// update must not write the 100 back.
+		expectLocked(mock)
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT .* FROM events WHERE id = \\?").
+			WithArgs("event1").
+			WillReturnRows(eventRow())
+		mock.ExpectQuery("FROM ticket_types").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		result, err := repo.Update(ctx, event, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(7), result.TicketStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("StockSet", func(t *testing.T) {
+		repo, mock := newMockRepository(t)
+		stock := int32(150)
+		expectLocked(mock)
+		mock.ExpectExec("UPDATE events SET ticket_stock = \\? WHERE id = \\?").
+			WithArgs(150, "event1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("SET status = IF").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT .* FROM events WHERE id = \\?").
+			WithArgs("event1").
+			WillReturnRows(eventRow())
+		mock.ExpectQuery("FROM ticket_types").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		// The stock is written under the row lock taken before any write.
+		_, err := repo.Update(ctx, event, &stock)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"os"
 	"testing"
 	"time"
 
@@ -13,7 +14,12 @@ import (
 )
 
 func setupTestDB(t *testing.T) *sql.DB {
-	db, err := sql.Open("mysql", "root:password@tcp(localhost:3306)/?parseTime=true")
+	dsn := os.Getenv("MYSQL_TEST_DSN")
+	if dsn == "" {
+		t.Skip("MYSQL_TEST_DSN is not set")
+	}
+
+	db, err := sql.Open("mysql", dsn)
 	if err != nil {
 		t.Fatalf("failed to connect to MySQL: %v", err)
 	}
@@ -29,6 +35,8 @@ func setupTestDB(t *testing.T) *sql.DB {
 		date DATETIME,
 		location VARCHAR(255),
 		ticket_stock INT,
+		status VARCHAR(16),
+		status_reason VARCHAR(255) NOT NULL DEFAULT '',
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
 	);
@@ -38,6 +46,72 @@ func setupTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("failed to create schema: %v", err)
 	}
 
+	_, err = db.Exec(`
+	CREATE TABLE stock_reservations (
+		id VARCHAR(64) PRIMARY KEY,
+		event_id VARCHAR(36),
+		quantity INT,
+		status VARCHAR(16),
+		ticket_type_id VARCHAR(36) NOT NULL DEFAULT '',
+		unit_price BIGINT NOT NULL DEFAULT 0,
+		currency VARCHAR(3) NOT NULL DEFAULT '',
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+	);
+	`)
+	if err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	_, err = db.Exec(`
+	CREATE TABLE ticket_types (
+		id VARCHAR(36) PRIMARY KEY,
+		event_id VARCHAR(36),
+		name VARCHAR(255),
+		price BIGINT,
+		currency VARCHAR(3),
+		capacity INT,
+		stock INT,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		UNIQUE KEY (event_id, name)
+	);
+	`)
+	if err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	_, err = db.Exec(`
+	CREATE TABLE seats (
+		id VARCHAR(36) PRIMARY KEY,
+		event_id VARCHAR(36),
+		section VARCHAR(64),
+		row_label VARCHAR(16),
+		number INT,
+		ticket_type_id VARCHAR(36) NOT NULL DEFAULT '',
+		accessible BOOLEAN NOT NULL DEFAULT FALSE,
+		obstructed BOOLEAN NOT NULL DEFAULT FALSE,
+		status VARCHAR(16),
+		reservation_id VARCHAR(64) NOT NULL DEFAULT '',
+		position INT,
+		UNIQUE KEY (event_id, section, row_label, number)
+	);
+	`)
+	if err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	_, err = db.Exec(`
+	CREATE TABLE reservation_seats (
+		reservation_id VARCHAR(64),
+		seat_id VARCHAR(36),
+		PRIMARY KEY (reservation_id, seat_id)
+	);
+	`)
+	if err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
 	return db
 }
 
@@ -49,6 +123,7 @@ func seedEvent(t *testing.T, repo EventRepository, ticketStock int32) *model.Eve
 		Date:        time.Now().AddDate(0, 1, 0),
 		Location:    "Test Location",
 		TicketStock: ticketStock,
+		Status:      model.EventStatusOnSale,
 	}
 
 	created, err := repo.Create(context.Background(), event)
@@ -56,6 +131,10 @@ func seedEvent(t *testing.T, repo EventRepository, ticketStock int32) *model.Eve
 	return created
 }
 
+func testAnnounce(event *model.Event) (*model.OutboxMessage, error) {
+	return model.NewOutboxMessage(uuid.NewString(), "events.Test", []byte{}), nil
+}
+
 func TestEventRepository_Create(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
@@ -114,7 +193,7 @@ func TestEventRepository_Update(t *testing.T) {
 	updatedEvent.Name = "Updated Concert"
 	updatedEvent.TicketStock = 200
 
-	result, err := repo.Update(context.Background(), &updatedEvent)
+	result, err := repo.Update(context.Background(), &updatedEvent, &updatedEvent.TicketStock)
 	assert.NoError(t, err)
 	assert.Equal(t, updatedEvent.Name, result.Name)
 	assert.Equal(t, updatedEvent.TicketStock, result.TicketStock)
@@ -125,6 +204,24 @@ func TestEventRepository_Update(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, updatedEvent.Name, dbEvent.Name)
 	assert.Equal(t, updatedEvent.TicketStock, dbEvent.TicketStock)
+
+	t.Run("ReservationBetweenReadAndWrite", func(t *testing.T) {
+		ctx := context.Background()
+		event := seedEvent(t, repo, 10)
+
+		read, err := repo.GetByID(ctx, event.ID)
+		assert.NoError(t, err)
+		_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 3, "", "")
+		assert.NoError(t, err)
+
+		// The update was prepared from a read taken before the reservation,
+		// but leaves the stock alone as it does not change it.
+		read.Name = "Renamed Concert"
+		result, err := repo.Update(ctx, read, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "Renamed Concert", result.Name)
+		assert.Equal(t, int32(7), result.TicketStock)
+	})
 }
 
 func TestEventRepository_Delete(t *testing.T) {
@@ -168,25 +265,60 @@ func TestEventRepository_List(t *testing.T) {
 		events[i] = event
 	}
 
-	result, total, err := repo.List(context.Background(), 1, 2)
+	result, total, err := repo.List(context.Background(), 1, 2, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, int32(5), total)
 	assert.Len(t, result, 2)
 	assert.Equal(t, events[0].ID, result[0].ID)
 	assert.Equal(t, events[1].ID, result[1].ID)
 
-	result, total, err = repo.List(context.Background(), 2, 2)
+	result, total, err = repo.List(context.Background(), 2, 2, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, int32(5), total)
 	assert.Len(t, result, 2)
 	assert.Equal(t, events[2].ID, result[0].ID)
 	assert.Equal(t, events[3].ID, result[1].ID)
 
-	result, total, err = repo.List(context.Background(), 3, 2)
+	result, total, err = repo.List(context.Background(), 3, 2, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, int32(5), total)
 	assert.Len(t, result, 1)
 	assert.Equal(t, events[4].ID, result[0].ID)
+
+	_, err = repo.ChangeStatus(context.Background(), events[0].ID, model.EventStatusOnSale, "", time.Time{}, testAnnounce)
+	assert.NoError(t, err)
+
+	result, total, err = repo.List(context.Background(), 1, 10, model.ListedEventStatuses)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), total)
+	assert.Equal(t, events[0].ID, result[0].ID)
+}
+
+func TestEventRepository_ChangeStatus(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := NewEventRepository(db)
+	ctx := context.Background()
+
+	event := seedEvent(t, repo, 2)
+
+	_, err := repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 2, "", "")
+	assert.NoError(t, err)
+	updated, _ := repo.GetByID(ctx, event.ID)
+	assert.Equal(t, model.EventStatusSoldOut, updated.Status)
+
+	date := time.Now().AddDate(0, 2, 0).Truncate(time.Second)
+	postponed, err := repo.ChangeStatus(ctx, event.ID, model.EventStatusPostponed, "weather", date, testAnnounce)
+	assert.NoError(t, err)
+	assert.Equal(t, "weather", postponed.StatusReason)
+
+	_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 1, "", "")
+	assert.ErrorIs(t, err, ErrEventNotOnSale)
+
+	_, err = repo.ChangeStatus(ctx, event.ID, model.EventStatusCancelled, "", time.Time{}, testAnnounce)
+	assert.NoError(t, err)
+	_, err = repo.ChangeStatus(ctx, event.ID, model.EventStatusOnSale, "", time.Time{}, testAnnounce)
+	assert.ErrorIs(t, err, ErrInvalidEventTransition)
 }
 
 func TestEventRepository_CheckAvailability(t *testing.T) {
@@ -213,7 +345,7 @@ func TestEventRepository_CheckAvailability(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			available, err := repo.CheckAvailability(context.Background(), event.ID, tt.quantity)
+			available, err := repo.CheckAvailability(context.Background(), event.ID, tt.quantity, "")
 
 			if tt.wantError {
 				assert.Error(t, err)
@@ -224,7 +356,7 @@ func TestEventRepository_CheckAvailability(t *testing.T) {
 		})
 	}
 
-	_, err = repo.CheckAvailability(context.Background(), uuid.NewString(), 1)
+	_, err = repo.CheckAvailability(context.Background(), uuid.NewString(), 1, "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "event not found")
 }
@@ -264,3 +396,186 @@ func TestEventRepository_UpdateTicketStock(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestEventRepository_ReserveStock(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+	repo := NewEventRepository(db)
+	ctx := context.Background()
+
+	t.Run("Idempotent", func(t *testing.T) {
+		event := seedEvent(t, repo, 10)
+		reservationID := uuid.NewString()
+
+		_, err := repo.ReserveStock(ctx, reservationID, event.ID, "", nil, 4, "", "")
+		assert.NoError(t, err)
+		reservation, err := repo.ReserveStock(ctx, reservationID, event.ID, "", nil, 4, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, model.ReservationStatusReserved, reservation.Status)
+
+		updated, _ := repo.GetByID(ctx, event.ID)
+		assert.Equal(t, int32(6), updated.TicketStock)
+
+		_, err = repo.ReserveStock(ctx, reservationID, event.ID, "", nil, 5, "", "")
+		assert.ErrorIs(t, err, ErrReservationConflict)
+	})
+
+	t.Run("NotAvailable", func(t *testing.T) {
+		event := seedEvent(t, repo, 3)
+		_, err := repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 4, "", "")
+		assert.ErrorIs(t, err, ErrInsufficientStock)
+	})
+
+	t.Run("ReleaseAndCommit", func(t *testing.T) {
+		event := seedEvent(t, repo, 10)
+		held, sold := uuid.NewString(), uuid.NewString()
+		_, err := repo.ReserveStock(ctx, held, event.ID, "", nil, 3, "", "")
+		assert.NoError(t, err)
+		_, err = repo.ReserveStock(ctx, sold, event.ID, "", nil, 2, "", "")
+		assert.NoError(t, err)
+
+		_, err = repo.ReleaseStock(ctx, held)
+		assert.NoError(t, err)
+		_, err = repo.ReleaseStock(ctx, held)
+		assert.NoError(t, err)
+
+		_, err = repo.CommitStock(ctx, sold)
+		assert.NoError(t, err)
+		_, err = repo.CommitStock(ctx, held)
+		assert.ErrorIs(t, err, ErrInvalidReservationState)
+
+		updated, _ := repo.GetByID(ctx, event.ID)
+		assert.Equal(t, int32(8), updated.TicketStock)
+
+		_, err = repo.ReleaseStock(ctx, sold)
+		assert.NoError(t, err)
+
+		updated, _ = repo.GetByID(ctx, event.ID)
+		assert.Equal(t, int32(10), updated.TicketStock)
+	})
+	t.Run("TicketType", func(t *testing.T) {
+		event := seedEvent(t, repo, 10)
+		vip, err := repo.CreateTicketType(ctx, model.NewTicketType(event.ID, "VIP", 15000, "USD", 5))
+		assert.NoError(t, err)
+
+		updated, _ := repo.GetByID(ctx, event.ID)
+		assert.Equal(t, int32(5), updated.TicketStock)
+
+		_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 1, "", "")
+		assert.ErrorIs(t, err, ErrTicketTypeRequired)
+		_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, vip.ID, nil, 6, "", "")
+		assert.ErrorIs(t, err, ErrInsufficientStock)
+
+		reservationID := uuid.NewString()
+		reservation, err := repo.ReserveStock(ctx, reservationID, event.ID, vip.ID, nil, 2, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(15000), reservation.UnitPrice)
+		assert.Equal(t, "USD", reservation.Currency)
+
+		assert.ErrorIs(t, repo.DeleteTicketType(ctx, vip.ID), ErrTicketTypeInUse)
+
+		_, err = repo.ReleaseStock(ctx, reservationID)
+		assert.NoError(t, err)
+
+		released, _ := repo.GetTicketType(ctx, vip.ID)
+		assert.Equal(t, int32(5), released.Stock)
+	})
+	t.Run("Seats", func(t *testing.T) {
+		event := seedEvent(t, repo, 10)
+		seats, err := repo.SaveSeatMap(ctx, event.ID, []*model.Seat{
+			model.NewSeat(event.ID, "Stalls", "A", 1, 0),
+			model.NewSeat(event.ID, "Stalls", "A", 2, 1),
+		})
+		assert.NoError(t, err)
+		assert.Len(t, seats, 2)
+
+		_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 1, "", "")
+		assert.ErrorIs(t, err, ErrSeatsRequired)
+
+		reservationID := uuid.NewString()
+		reservation, err := repo.ReserveStock(ctx, reservationID, event.ID, "", []string{seats[0].ID}, 1, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{seats[0].ID}, reservation.SeatIDs)
+
+		_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", []string{seats[0].ID, seats[1].ID}, 2, "", "")
+		assert.ErrorIs(t, err, ErrSeatUnavailable)
+
+		_, err = repo.CommitStock(ctx, reservationID)
+		assert.NoError(t, err)
+		_, err = repo.SaveSeatMap(ctx, event.ID, nil)
+		assert.ErrorIs(t, err, ErrSeatMapInUse)
+
+		_, err = repo.ReleaseStock(ctx, reservationID)
+		assert.NoError(t, err)
+
+		listed, _ := repo.ListSeats(ctx, event.ID)
+		assert.Equal(t, model.SeatStatusAvailable, listed[0].Status)
+	})
+	t.Run("Presale", func(t *testing.T) {
+		event := seedEvent(t, repo, 10)
+		event.PresaleStart = time.Now().Add(-time.Hour)
+		event.SalesStart = time.Now().Add(time.Hour)
+		_, err := repo.Update(ctx, event, nil)
+		assert.NoError(t, err)
+
+		code, err := model.NewPresaleCode(event.ID, 1)
+		assert.NoError(t, err)
+		_, err = repo.CreatePresaleCodes(ctx, event.ID, []*model.PresaleCode{code})
+		assert.NoError(t, err)
+
+		_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 1, "", "")
+		assert.ErrorIs(t, err, ErrAccessCodeRequired)
+
+		reservationID := uuid.NewString()
+		reservation, err := repo.ReserveStock(ctx, reservationID, event.ID, "", nil, 1, code.Code, "")
+		assert.NoError(t, err)
+		assert.Equal(t, code.Code, reservation.AccessCode)
+
+		_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 1, code.Code, "")
+		assert.ErrorIs(t, err, ErrAccessCodeUsedUp)
+
+		_, err = repo.ReleaseStock(ctx, reservationID)
+		assert.NoError(t, err)
+		available, err := repo.CheckAvailability(ctx, event.ID, 1, code.Code)
+		assert.NoError(t, err)
+		assert.True(t, available)
+
+		_, err = repo.RevokePresaleCode(ctx, event.ID, code.Code)
+		assert.NoError(t, err)
+		_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 1, code.Code, "")
+		assert.ErrorIs(t, err, ErrInvalidAccessCode)
+	})
+	t.Run("PresaleGroup", func(t *testing.T) {
+		event := seedEvent(t, repo, 10)
+		event.PresaleStart = time.Now().Add(-time.Hour)
+		event.SalesStart = time.Now().Add(time.Hour)
+		_, err := repo.Update(ctx, event, nil)
+		assert.NoError(t, err)
+
+		code, err := model.NewPresaleCode(event.ID, 1)
+		assert.NoError(t, err)
+		_, err = repo.CreatePresaleCodes(ctx, event.ID, []*model.PresaleCode{code})
+		assert.NoError(t, err)
+
+		group := uuid.NewString()
+		first, second := uuid.NewString(), uuid.NewString()
+		_, err = repo.ReserveStock(ctx, first, event.ID, "", nil, 1, code.Code, group)
+		assert.NoError(t, err)
+		_, err = repo.ReserveStock(ctx, second, event.ID, "", nil, 1, code.Code, group)
+		assert.NoError(t, err)
+
+		_, err = repo.ReserveStock(ctx, uuid.NewString(), event.ID, "", nil, 1, code.Code, uuid.NewString())
+		assert.ErrorIs(t, err, ErrAccessCodeUsedUp)
+
+		_, err = repo.ReleaseStock(ctx, first)
+		assert.NoError(t, err)
+		_, err = repo.CheckAvailability(ctx, event.ID, 1, code.Code)
+		assert.ErrorIs(t, err, ErrAccessCodeUsedUp)
+
+		_, err = repo.ReleaseStock(ctx, second)
+		assert.NoError(t, err)
+		available, err := repo.CheckAvailability(ctx, event.ID, 1, code.Code)
+		assert.NoError(t, err)
+		assert.True(t, available)
+	})
+}
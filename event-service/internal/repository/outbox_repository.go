@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+)
+
+// Announce builds the outbox message announcing a changed event. It is called
+// inside the change's transaction, so the message is stored if and only if
+// the change is.
+type Announce func(event *model.Event) (*model.OutboxMessage, error)
+
+type OutboxRepository interface {
+	ClaimNext(ctx context.Context, now time.Time, lease time.Duration) (*model.OutboxMessage, error)
+	MarkPublished(ctx context.Context, id string, at time.Time) error
+	MarkFailed(ctx context.Context, id string, next time.Time, cause error) error
+}
+
+type OutboxRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) OutboxRepository {
+	return &OutboxRepositoryImpl{db: db}
+}
+
+// ClaimNext takes the oldest unpublished message that is due at now and
+// hides it from other relays for lease. It returns nil when there is none.
+func (r *OutboxRepositoryImpl) ClaimNext(ctx context.Context, now time.Time, lease time.Duration) (*model.OutboxMessage, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var msg model.OutboxMessage
+	err = tx.QueryRowContext(ctx, `
+		SELECT id, subject, envelope, attempts, next_attempt_at, created_at
+		FROM outbox
+		WHERE published_at IS NULL AND next_attempt_at <= ?
+		ORDER BY created_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	`, now).Scan(&msg.ID, &msg.Subject, &msg.Envelope, &msg.Attempts, &msg.NextAttemptAt, &msg.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outbox message: %w", err)
+	}
+
+	msg.Attempts++
+	msg.NextAttemptAt = now.Add(lease)
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE outbox SET attempts = ?, next_attempt_at = ? WHERE id = ?
+	`, msg.Attempts, msg.NextAttemptAt, msg.ID); err != nil {
+		return nil, fmt.Errorf("failed to claim outbox message: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit outbox claim: %w", err)
+	}
+	return &msg, nil
+}
+
+func (r *OutboxRepositoryImpl) MarkPublished(ctx context.Context, id string, at time.Time) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE outbox SET published_at = ? WHERE id = ?`, at, id); err != nil {
+		return fmt.Errorf("failed to mark outbox message published: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed schedules another attempt at next, recording why the last one
+// failed.
+func (r *OutboxRepositoryImpl) MarkFailed(ctx context.Context, id string, next time.Time, cause error) error {
+	reason := cause.Error()
+	if len(reason) > 255 {
+		reason = reason[:255]
+	}
+
+	if _, err := r.db.ExecContext(ctx, `
+		UPDATE outbox SET next_attempt_at = ?, last_error = ? WHERE id = ?
+	`, next, reason, id); err != nil {
+		return fmt.Errorf("failed to record outbox failure: %w", err)
+	}
+	return nil
+}
+
+// addOutboxMessage stores msg as part of the transaction of q.
+func addOutboxMessage(ctx context.Context, q execer, msg *model.OutboxMessage) error {
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO outbox (id, subject, envelope, next_attempt_at, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, msg.ID, msg.Subject, msg.Envelope, msg.NextAttemptAt, msg.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add outbox message: %w", err)
+	}
+	return nil
+}
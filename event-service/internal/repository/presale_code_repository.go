@@ -0,0 +1,252 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+)
+
+var (
+	ErrSalesNotStarted     = errors.New("ticket sales have not started")
+	ErrSalesEnded          = errors.New("ticket sales have ended")
+	ErrAccessCodeRequired  = errors.New("a presale access code is required")
+	ErrInvalidAccessCode   = errors.New("presale access code is not valid for this event")
+	ErrAccessCodeUsedUp    = errors.New("presale access code has no uses left")
+	ErrPresaleCodeNotFound = errors.New("presale code not found")
+)
+
+const presaleCodeColumns = `code, event_id, max_uses, uses, revoked, created_at`
+
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// CreatePresaleCodes stores new access codes for an event's presale.
+func (r *EventRepositoryImpl) CreatePresaleCodes(ctx context.Context, eventID string, codes []*model.PresaleCode) ([]*model.PresaleCode, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	err = tx.QueryRowContext(ctx, `SELECT 1 FROM events WHERE id = ?`, eventID).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	for _, code := range codes {
+		code.EventID = eventID
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO presale_codes (code, event_id, max_uses, uses, revoked, created_at)
+			VALUES (?, ?, ?, 0, FALSE, ?)
+		`, code.Code, eventID, code.MaxUses, code.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create presale code: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit presale codes: %w", err)
+	}
+
+	return codes, nil
+}
+
+func (r *EventRepositoryImpl) ListPresaleCodes(ctx context.Context, eventID string) ([]*model.PresaleCode, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+presaleCodeColumns+`
+		FROM presale_codes
+		WHERE event_id = ?
+		ORDER BY created_at ASC, code ASC
+	`, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list presale codes: %w", err)
+	}
+	defer rows.Close()
+
+	var codes []*model.PresaleCode
+	for rows.Next() {
+		code, err := scanPresaleCode(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan presale code: %w", err)
+		}
+		codes = append(codes, code)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return codes, nil
+}
+
+// RevokePresaleCode stops a code from being used for further purchases.
+// Purchases already made with it stand.
+func (r *EventRepositoryImpl) RevokePresaleCode(ctx context.Context, eventID, code string) (*model.PresaleCode, error) {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE presale_codes SET revoked = TRUE WHERE code = ? AND event_id = ?
+	`, code, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke presale code: %w", err)
+	}
+
+	revoked, err := scanPresaleCode(r.db.QueryRowContext(ctx, `
+		SELECT `+presaleCodeColumns+` FROM presale_codes WHERE code = ? AND event_id = ?
+	`, code, eventID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPresaleCodeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get presale code: %w", err)
+	}
+	return revoked, nil
+}
+
+// checkSalesWindow reports why an event's tickets cannot be bought at now,
+// if they cannot. During the presale accessCode must be a live code of the
+// event with uses left; it is returned so the caller can use it up. A code
+// whose use is already held by a reservation of accessGroup needs no uses
+// left, and held reports that it must not be counted again. With lock set the
+// code's row stays locked for the rest of the transaction.
+func checkSalesWindow(ctx context.Context, q queryer, eventID, accessCode, accessGroup string, now time.Time, lock bool) (code string, held bool, err error) {
+	var salesStart, salesEnd, presaleStart sql.NullTime
+	err = q.QueryRowContext(ctx, `
+		SELECT sales_start, sales_end, presale_start FROM events WHERE id = ?
+	`, eventID).Scan(&salesStart, &salesEnd, &presaleStart)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, fmt.Errorf("event not found: %w", err)
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	window := model.SalesWindow{
+		PresaleStart: presaleStart.Time,
+		SalesStart:   salesStart.Time,
+		SalesEnd:     salesEnd.Time,
+	}
+
+	switch window.Phase(now) {
+	case model.SalesPhaseOpen:
+		return "", false, nil
+	case model.SalesPhaseEnded:
+		return "", false, ErrSalesEnded
+	case model.SalesPhaseNotStarted:
+		start := window.SalesStart
+		if !window.PresaleStart.IsZero() {
+			start = window.PresaleStart
+		}
+		return "", false, fmt.Errorf("%w: they start at %s", ErrSalesNotStarted, start.Format(time.RFC3339))
+	}
+
+	accessCode = strings.ToUpper(strings.TrimSpace(accessCode))
+	if accessCode == "" {
+		return "", false, ErrAccessCodeRequired
+	}
+
+	query := `SELECT max_uses, uses, revoked FROM presale_codes WHERE code = ? AND event_id = ?`
+	if lock {
+		query += " FOR UPDATE"
+	}
+
+	var (
+		maxUses, uses int32
+		revoked       bool
+	)
+	err = q.QueryRowContext(ctx, query, accessCode, eventID).Scan(&maxUses, &uses, &revoked)
+	if errors.Is(err, sql.ErrNoRows) || revoked {
+		return "", false, ErrInvalidAccessCode
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get presale code: %w", err)
+	}
+
+	if accessGroup != "" {
+		var holders int
+		err := q.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM stock_reservations
+			WHERE access_group = ? AND access_code = ? AND status <> ?
+		`, accessGroup, accessCode, model.ReservationStatusReleased).Scan(&holders)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to check presale code use: %w", err)
+		}
+		if holders > 0 {
+			return accessCode, true, nil
+		}
+	}
+
+	if uses >= maxUses {
+		return "", false, ErrAccessCodeUsedUp
+	}
+
+	return accessCode, false, nil
+}
+
+// usePresaleCode records code and accessGroup on the reservation that used
+// the code, and counts a use of it unless the group already held one.
+func usePresaleCode(ctx context.Context, tx *sql.Tx, reservationID, code, accessGroup string, held bool) error {
+	if !held {
+		if _, err := tx.ExecContext(ctx, `UPDATE presale_codes SET uses = uses + 1 WHERE code = ?`, code); err != nil {
+			return fmt.Errorf("failed to use presale code: %w", err)
+		}
+	}
+
+	_, err := tx.ExecContext(ctx, `UPDATE stock_reservations SET access_code = ?, access_group = ? WHERE id = ?`,
+		code, accessGroup, reservationID)
+	if err != nil {
+		return fmt.Errorf("failed to update reservation: %w", err)
+	}
+	return nil
+}
+
+// returnPresaleCode gives back the use a released reservation took from its
+// access code, once no other reservation of its access group holds it.
+func returnPresaleCode(ctx context.Context, tx *sql.Tx, reservation *model.StockReservation) error {
+	if reservation.AccessCode == "" {
+		return nil
+	}
+
+	if reservation.AccessGroup != "" {
+		var holders int
+		err := tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM stock_reservations
+			WHERE access_group = ? AND access_code = ? AND status <> ? AND id <> ?
+		`, reservation.AccessGroup, reservation.AccessCode, model.ReservationStatusReleased, reservation.ID).Scan(&holders)
+		if err != nil {
+			return fmt.Errorf("failed to check presale code use: %w", err)
+		}
+		if holders > 0 {
+			return nil
+		}
+	}
+
+	_, err := tx.ExecContext(ctx, `UPDATE presale_codes SET uses = uses - 1 WHERE code = ? AND uses > 0`, reservation.AccessCode)
+	if err != nil {
+		return fmt.Errorf("failed to return presale code: %w", err)
+	}
+	return nil
+}
+
+func scanPresaleCode(row rowScanner) (*model.PresaleCode, error) {
+	var code model.PresaleCode
+	err := row.Scan(
+		&code.Code,
+		&code.EventID,
+		&code.MaxUses,
+		&code.Uses,
+		&code.Revoked,
+		&code.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &code, nil
+}
@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+)
+
+var (
+	ErrSeatNotFound    = errors.New("seat not found")
+	ErrSeatUnavailable = errors.New("seat is already held or sold")
+	ErrSeatTicketType  = errors.New("seat is not sold as this ticket type")
+	ErrSeatsRequired   = errors.New("event has reserved seating; seats are required")
+	ErrSeatMapInUse    = errors.New("seat map has seats held or sold")
+)
+
+const seatColumns = `id, event_id, section, row_label, number, ticket_type_id, accessible, obstructed, status, reservation_id, position`
+
+// SaveSeatMap replaces an event's venue layout. A layout can only be replaced
+// while none of its seats are held or sold.
+func (r *EventRepositoryImpl) SaveSeatMap(ctx context.Context, eventID string, seats []*model.Seat) ([]*model.Seat, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	err = tx.QueryRowContext(ctx, `SELECT 1 FROM events WHERE id = ? FOR UPDATE`, eventID).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	var taken int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM seats WHERE event_id = ? AND status <> ?
+	`, eventID, model.SeatStatusAvailable).Scan(&taken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count seats: %w", err)
+	}
+	if taken > 0 {
+		return nil, ErrSeatMapInUse
+	}
+
+	ticketTypeIDs := make(map[string]bool)
+	for _, seat := range seats {
+		if seat.TicketTypeID != "" {
+			ticketTypeIDs[seat.TicketTypeID] = true
+		}
+	}
+	for ticketTypeID := range ticketTypeIDs {
+		var found int
+		err := tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM ticket_types WHERE id = ? AND event_id = ?
+		`, ticketTypeID, eventID).Scan(&found)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ticket type: %w", err)
+		}
+		if found == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrTicketTypeNotFound, ticketTypeID)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM seats WHERE event_id = ?`, eventID); err != nil {
+		return nil, fmt.Errorf("failed to delete seats: %w", err)
+	}
+
+	const batch = 500
+	for start := 0; start < len(seats); start += batch {
+		end := start + batch
+		if end > len(seats) {
+			end = len(seats)
+		}
+
+		var (
+			values []string
+			args   []interface{}
+		)
+		for _, seat := range seats[start:end] {
+			values = append(values, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+			args = append(args,
+				seat.ID, eventID, seat.Section, seat.Row, seat.Number, seat.TicketTypeID,
+				seat.Accessible, seat.Obstructed, model.SeatStatusAvailable, "", seat.Position,
+			)
+		}
+
+		_, err := tx.ExecContext(ctx, `INSERT INTO seats (`+seatColumns+`) VALUES `+strings.Join(values, ", "), args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to save seats: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit seat map: %w", err)
+	}
+
+	return r.ListSeats(ctx, eventID)
+}
+
+// ListSeats returns an event's seats in layout order.
+func (r *EventRepositoryImpl) ListSeats(ctx context.Context, eventID string) ([]*model.Seat, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+seatColumns+`
+		FROM seats
+		WHERE event_id = ?
+		ORDER BY position ASC
+	`, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seats: %w", err)
+	}
+	defer rows.Close()
+
+	var seats []*model.Seat
+	for rows.Next() {
+		var seat model.Seat
+		if err := rows.Scan(
+			&seat.ID,
+			&seat.EventID,
+			&seat.Section,
+			&seat.Row,
+			&seat.Number,
+			&seat.TicketTypeID,
+			&seat.Accessible,
+			&seat.Obstructed,
+			&seat.Status,
+			&seat.ReservationID,
+			&seat.Position,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan seat: %w", err)
+		}
+		seats = append(seats, &seat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return seats, nil
+}
+
+// holdSeats holds seatIDs for a new reservation. Seats already held or sold
+// are reported together in a single ErrSeatUnavailable. A reservation without
+// seats is refused if the event has seats it could have been sold.
+func holdSeats(ctx context.Context, tx *sql.Tx, reservationID, eventID, ticketTypeID string, seatIDs []string) error {
+	if len(seatIDs) == 0 {
+		var seated int
+		err := tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM seats
+			WHERE event_id = ? AND (ticket_type_id = '' OR ticket_type_id = ?)
+		`, eventID, ticketTypeID).Scan(&seated)
+		if err != nil {
+			return fmt.Errorf("failed to count seats: %w", err)
+		}
+		if seated > 0 {
+			return ErrSeatsRequired
+		}
+		return nil
+	}
+
+	args := []interface{}{eventID}
+	for _, seatID := range seatIDs {
+		args = append(args, seatID)
+	}
+	in := "?" + strings.Repeat(", ?", len(seatIDs)-1)
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, ticket_type_id, status
+		FROM seats
+		WHERE event_id = ? AND id IN (`+in+`)
+		FOR UPDATE
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("failed to get seats: %w", err)
+	}
+
+	var (
+		found int
+		taken []string
+	)
+	for rows.Next() {
+		seat := model.Seat{}
+		if err := rows.Scan(&seat.ID, &seat.TicketTypeID, &seat.Status); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan seat: %w", err)
+		}
+		found++
+		if !seat.SellableAs(ticketTypeID) {
+			rows.Close()
+			return fmt.Errorf("%w: %s", ErrSeatTicketType, seat.ID)
+		}
+		if seat.Status != model.SeatStatusAvailable {
+			taken = append(taken, seat.ID)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows error: %w", err)
+	}
+
+	if found != len(seatIDs) {
+		return ErrSeatNotFound
+	}
+	if len(taken) > 0 {
+		sort.Strings(taken)
+		return fmt.Errorf("%w: %s", ErrSeatUnavailable, strings.Join(taken, ", "))
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE seats SET status = ?, reservation_id = ?
+		WHERE event_id = ? AND id IN (`+in+`)
+	`, append([]interface{}{model.SeatStatusHeld, reservationID}, args...)...)
+	if err != nil {
+		return fmt.Errorf("failed to hold seats: %w", err)
+	}
+
+	for _, seatID := range seatIDs {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO reservation_seats (reservation_id, seat_id) VALUES (?, ?)
+		`, reservationID, seatID)
+		if err != nil {
+			return fmt.Errorf("failed to record reserved seat: %w", err)
+		}
+	}
+	return nil
+}
+
+// releaseSeats makes a reservation's seats available again.
+func releaseSeats(ctx context.Context, tx *sql.Tx, reservationID string) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE seats SET status = ?, reservation_id = ''
+		WHERE reservation_id = ?
+	`, model.SeatStatusAvailable, reservationID)
+	if err != nil {
+		return fmt.Errorf("failed to release seats: %w", err)
+	}
+	return nil
+}
+
+// sellSeats marks a reservation's held seats as sold.
+func sellSeats(ctx context.Context, tx *sql.Tx, reservationID string) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE seats SET status = ?
+		WHERE reservation_id = ?
+	`, model.SeatStatusSold, reservationID)
+	if err != nil {
+		return fmt.Errorf("failed to sell seats: %w", err)
+	}
+	return nil
+}
+
+// reservationSeatIDs returns the seats a reservation was made for, sorted.
+func reservationSeatIDs(ctx context.Context, tx *sql.Tx, reservationID string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT seat_id FROM reservation_seats
+		WHERE reservation_id = ?
+		ORDER BY seat_id ASC
+	`, reservationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reserved seats: %w", err)
+	}
+	defer rows.Close()
+
+	var seatIDs []string
+	for rows.Next() {
+		var seatID string
+		if err := rows.Scan(&seatID); err != nil {
+			return nil, fmt.Errorf("failed to scan reserved seat: %w", err)
+		}
+		seatIDs = append(seatIDs, seatID)
+	}
+	return seatIDs, rows.Err()
+}
+
+func sameSeats(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/event-service/internal/model"
+	"github.com/go-sql-driver/mysql"
+)
+
+var (
+	ErrTicketTypeNotFound = errors.New("ticket type not found")
+	ErrTicketTypeRequired = errors.New("event has ticket types; a ticket type is required")
+	ErrTicketTypeExists   = errors.New("event already has a ticket type with this name")
+	ErrTicketTypeInUse    = errors.New("ticket type has tickets held or sold")
+	ErrUntypedStockSold   = errors.New("event has tickets sold without a ticket type")
+)
+
+const ticketTypeColumns = `id, event_id, name, price, currency, capacity, stock, created_at, updated_at`
+
+// CreateTicketType adds a tier to an existing event and adds its capacity to
+// the event's stock. The first tier replaces the event's untyped stock, which
+// is only allowed while none of it has been sold.
+func (r *EventRepositoryImpl) CreateTicketType(ctx context.Context, ticketType *model.TicketType) (*model.TicketType, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists int
+	err = tx.QueryRowContext(ctx, `SELECT 1 FROM events WHERE id = ? FOR UPDATE`, ticketType.EventID).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	var tiers int
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM ticket_types WHERE event_id = ?`, ticketType.EventID).Scan(&tiers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count ticket types: %w", err)
+	}
+
+	stockUpdate := `UPDATE events SET ticket_stock = ticket_stock + ? WHERE id = ?`
+	if tiers == 0 {
+		var sold int
+		err := tx.QueryRowContext(ctx, `
+			SELECT COUNT(*) FROM stock_reservations
+			WHERE event_id = ? AND ticket_type_id = '' AND status <> ?
+		`, ticketType.EventID, model.ReservationStatusReleased).Scan(&sold)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count reservations: %w", err)
+		}
+		if sold > 0 {
+			return nil, ErrUntypedStockSold
+		}
+		stockUpdate = `UPDATE events SET ticket_stock = ? WHERE id = ?`
+	}
+
+	if err := insertTicketType(ctx, tx, ticketType); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, stockUpdate, ticketType.Stock, ticketType.EventID); err != nil {
+		return nil, fmt.Errorf("failed to update ticket stock: %w", err)
+	}
+
+	if err := syncSoldOut(ctx, tx, ticketType.EventID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit ticket type: %w", err)
+	}
+
+	return r.GetTicketType(ctx, ticketType.ID)
+}
+
+func (r *EventRepositoryImpl) GetTicketType(ctx context.Context, id string) (*model.TicketType, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+ticketTypeColumns+` FROM ticket_types WHERE id = ?`, id)
+	return scanTicketType(row)
+}
+
+func (r *EventRepositoryImpl) ListTicketTypes(ctx context.Context, eventID string) ([]*model.TicketType, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+ticketTypeColumns+`
+		FROM ticket_types
+		WHERE event_id = ?
+		ORDER BY price ASC, name ASC
+	`, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ticket types: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTicketTypes(rows)
+}
+
+// UpdateTicketType changes a tier's name, price and capacity. A change in
+// capacity moves the tier's and the event's stock by the same amount; capacity
+// cannot drop below what is already held or sold. Existing reservations keep
+// the price they were made at.
+func (r *EventRepositoryImpl) UpdateTicketType(ctx context.Context, ticketType *model.TicketType) (*model.TicketType, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	current, err := scanTicketType(tx.QueryRowContext(ctx,
+		`SELECT `+ticketTypeColumns+` FROM ticket_types WHERE id = ? FOR UPDATE`, ticketType.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	delta := ticketType.Capacity - current.Capacity
+	if current.Stock+delta < 0 {
+		return nil, ErrTicketTypeInUse
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE ticket_types
+		SET name = ?, price = ?, currency = ?, capacity = ?, stock = stock + ?
+		WHERE id = ?
+	`, ticketType.Name, ticketType.Price, ticketType.Currency, ticketType.Capacity, delta, ticketType.ID)
+	if err != nil {
+		return nil, ticketTypeWriteError(err)
+	}
+
+	if delta != 0 {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE events
+			SET ticket_stock = ticket_stock + ?, updated_at = CURRENT_TIMESTAMP
+			WHERE id = ?
+		`, delta, current.EventID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update ticket stock: %w", err)
+		}
+
+		if err := syncSoldOut(ctx, tx, current.EventID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit ticket type: %w", err)
+	}
+
+	return r.GetTicketType(ctx, ticketType.ID)
+}
+
+// DeleteTicketType removes a tier that has nothing held or sold and takes its
+// capacity out of the event's stock.
+func (r *EventRepositoryImpl) DeleteTicketType(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	current, err := scanTicketType(tx.QueryRowContext(ctx,
+		`SELECT `+ticketTypeColumns+` FROM ticket_types WHERE id = ? FOR UPDATE`, id))
+	if err != nil {
+		return err
+	}
+
+	if current.Sold() > 0 {
+		return ErrTicketTypeInUse
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM ticket_types WHERE id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete ticket type: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE events
+		SET ticket_stock = ticket_stock - ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ?
+	`, current.Stock, current.EventID)
+	if err != nil {
+		return fmt.Errorf("failed to update ticket stock: %w", err)
+	}
+
+	if err := syncSoldOut(ctx, tx, current.EventID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit ticket type deletion: %w", err)
+	}
+
+	return nil
+}
+
+func (r *EventRepositoryImpl) CheckTicketTypeAvailability(ctx context.Context, ticketTypeID string, quantity int32, accessCode string) (bool, error) {
+	if quantity <= 0 {
+		return false, fmt.Errorf("invalid quantity: must be greater than 0")
+	}
+
+	ticketType, err := r.GetTicketType(ctx, ticketTypeID)
+	if err != nil {
+		return false, err
+	}
+
+	var status model.EventStatus
+	err = r.db.QueryRowContext(ctx, `SELECT status FROM events WHERE id = ?`, ticketType.EventID).Scan(&status)
+	if err != nil {
+		return false, fmt.Errorf("failed to get event: %w", err)
+	}
+	if status != model.EventStatusSoldOut {
+		if err := checkOnSale(status); err != nil {
+			return false, err
+		}
+	}
+
+	if _, _, err := checkSalesWindow(ctx, r.db, ticketType.EventID, accessCode, "", time.Now(), false); err != nil {
+		return false, err
+	}
+
+	return ticketType.Stock >= quantity, nil
+}
+
+// attachTicketTypes loads the ticket types of a page of events in one query.
+func (r *EventRepositoryImpl) attachTicketTypes(ctx context.Context, events []*model.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*model.Event, len(events))
+	args := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		byID[event.ID] = event
+		args = append(args, event.ID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+ticketTypeColumns+`
+		FROM ticket_types
+		WHERE event_id IN (?`+strings.Repeat(", ?", len(args)-1)+`)
+		ORDER BY price ASC, name ASC
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("failed to list ticket types: %w", err)
+	}
+	defer rows.Close()
+
+	ticketTypes, err := scanTicketTypes(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, ticketType := range ticketTypes {
+		event := byID[ticketType.EventID]
+		event.TicketTypes = append(event.TicketTypes, ticketType)
+	}
+	return nil
+}
+
+func insertTicketType(ctx context.Context, tx *sql.Tx, ticketType *model.TicketType) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO ticket_types (id, event_id, name, price, currency, capacity, stock)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, ticketType.ID, ticketType.EventID, ticketType.Name, ticketType.Price, ticketType.Currency, ticketType.Capacity, ticketType.Stock)
+	if err != nil {
+		return ticketTypeWriteError(err)
+	}
+	return nil
+}
+
+func ticketTypeWriteError(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+		return ErrTicketTypeExists
+	}
+	return fmt.Errorf("failed to save ticket type: %w", err)
+}
+
+func scanTicketType(row rowScanner) (*model.TicketType, error) {
+	var ticketType model.TicketType
+	err := row.Scan(
+		&ticketType.ID,
+		&ticketType.EventID,
+		&ticketType.Name,
+		&ticketType.Price,
+		&ticketType.Currency,
+		&ticketType.Capacity,
+		&ticketType.Stock,
+		&ticketType.CreatedAt,
+		&ticketType.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTicketTypeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket type: %w", err)
+	}
+	return &ticketType, nil
+}
+
+func scanTicketTypes(rows *sql.Rows) ([]*model.TicketType, error) {
+	var ticketTypes []*model.TicketType
+	for rows.Next() {
+		ticketType, err := scanTicketType(rows)
+		if err != nil {
+			return nil, err
+		}
+		ticketTypes = append(ticketTypes, ticketType)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return ticketTypes, nil
+}
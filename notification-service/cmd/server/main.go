@@ -23,6 +23,7 @@ import (
 	"github.com/doniiel/event-ticketing-platform/notification-service/internal/database"
 	"github.com/doniiel/event-ticketing-platform/notification-service/internal/handler"
 	"github.com/doniiel/event-ticketing-platform/notification-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
 	notificationpb "github.com/doniiel/event-ticketing-platform/proto/notification"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
@@ -51,7 +52,17 @@ func main() {
 	notificationRepo := repository.NewNotificationRepository(db)
 	notificationHandler := handler.NewNotificationHandler(notificationRepo)
 
-	ticketConsumer := consumer.NewTicketConsumer(cfg.TicketServiceAddr, notificationRepo)
+	offsetRepo := repository.NewOffsetRepository(db)
+
+	if cfg.NatsURL == "" {
+		log.Println("Warning: NATS_URL is not set, ticket events will only be read from an in-memory bus")
+	}
+	eventBus, err := bus.Open(cfg.NatsURL, bus.Stream{Name: consumer.TicketEventsStream, Subjects: []string{consumer.TicketEventsSubject}})
+	if err != nil {
+		log.Fatalf("Failed to connect to message bus: %v", err)
+	}
+
+	ticketConsumer := consumer.NewTicketConsumer(eventBus, notificationRepo, offsetRepo)
 	if err := ticketConsumer.Start(); err != nil {
 		log.Printf("Warning: Failed to start ticket consumer: %v", err)
 	}
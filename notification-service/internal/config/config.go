@@ -6,10 +6,10 @@ import (
 )
 
 type Config struct {
-	GRPCPort          int
-	HTTPPort          int
-	DatabaseURL       string
-	TicketServiceAddr string
+	GRPCPort    int
+	HTTPPort    int
+	DatabaseURL string
+	NatsURL     string
 }
 
 func LoadConfig() *Config {
@@ -24,10 +24,10 @@ func LoadConfig() *Config {
 	}
 
 	return &Config{
-		GRPCPort:          grpcPort,
-		HTTPPort:          httpPort,
-		DatabaseURL:       getEnv("DATABASE_URL", "root:password@tcp(mysql:3306)/notifications?parseTime=true"),
-		TicketServiceAddr: getEnv("TICKET_SERVICE_ADDR", "ticket-service:50052"),
+		GRPCPort:    grpcPort,
+		HTTPPort:    httpPort,
+		DatabaseURL: getEnv("DATABASE_URL", "root:password@tcp(mysql:3306)/notifications?parseTime=true"),
+		NatsURL:     os.Getenv("NATS_URL"),
 	}
 }
 
@@ -1,83 +1,246 @@
 package consumer
 
 import (
+	"context"
 	"fmt"
-	"google.golang.org/grpc/credentials/insecure"
 	"log"
 	"time"
 
 	"github.com/doniiel/event-ticketing-platform/notification-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
 	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
-	"google.golang.org/grpc"
 )
 
+const (
+	// TicketEventsStream and TicketEventsSubject must match what
+	// ticket-service publishes its domain events to.
+	TicketEventsStream  = "TICKETS"
+	TicketEventsSubject = "tickets.>"
+
+	consumerName = "notification-service"
+	maxBackoff   = time.Minute
+)
+
+const (
+	eventTicketPurchased = "TicketPurchased"
+	eventTicketCancelled = "TicketCancelled"
+	eventTicketRefunded  = "TicketRefunded"
+
+	eventTicketTransferInitiated = "TicketTransferInitiated"
+	eventTicketTransferAccepted  = "TicketTransferAccepted"
+	eventTicketTransferCancelled = "TicketTransferCancelled"
+
+	eventTicketResold = "TicketResold"
+
+	eventWaitlistOffered      = "WaitlistOffered"
+	eventWaitlistOfferExpired = "WaitlistOfferExpired"
+)
+
+// TicketConsumer turns ticket domain events into user notifications. It
+// commits the sequence of every processed message so that a restart resumes
+// after the last one, and retries a failing message until it succeeds rather
+// than skipping it.
 type TicketConsumer struct {
-	ticketServiceAddr string
-	notificationRepo  repository.NotificationRepository
-	stopCh            chan struct{}
-	client            ticketpb.TicketServiceClient
-	conn              *grpc.ClientConn
+	subscriber       bus.Subscriber
+	notificationRepo repository.NotificationRepository
+	offsetRepo       repository.OffsetRepository
+	cancel           context.CancelFunc
 }
 
-func NewTicketConsumer(ticketServiceAddr string, notificationRepo repository.NotificationRepository) *TicketConsumer {
+func NewTicketConsumer(subscriber bus.Subscriber, notificationRepo repository.NotificationRepository, offsetRepo repository.OffsetRepository) *TicketConsumer {
 	return &TicketConsumer{
-		ticketServiceAddr: ticketServiceAddr,
-		notificationRepo:  notificationRepo,
-		stopCh:            make(chan struct{}),
+		subscriber:       subscriber,
+		notificationRepo: notificationRepo,
+		offsetRepo:       offsetRepo,
 	}
 }
 
 func (c *TicketConsumer) Start() error {
-	conn, err := grpc.Dial(
-		c.ticketServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	offset, err := c.offsetRepo.GetOffset(consumerName, TicketEventsSubject)
 	if err != nil {
-		return fmt.Errorf("failed to connect to ticket service: %w", err)
+		return fmt.Errorf("failed to load consumer offset: %w", err)
 	}
 
-	c.conn = conn
-	c.client = ticketpb.NewTicketServiceClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
 
-	log.Printf("Starting ticket event consumer, connecting to %s", c.ticketServiceAddr)
-	go c.simulateTicketEventListener()
+	if err := c.subscriber.Subscribe(ctx, TicketEventsSubject, offset, c.handle); err != nil {
+		cancel()
+		return fmt.Errorf("failed to subscribe to ticket events: %w", err)
+	}
 
+	log.Printf("Starting ticket event consumer after offset %d", offset)
 	return nil
 }
 
 func (c *TicketConsumer) Stop() {
-	close(c.stopCh)
-	if c.conn != nil {
-		err := c.conn.Close()
-		if err != nil {
-			return
-		}
+	if c.cancel != nil {
+		c.cancel()
 	}
+	if err := c.subscriber.Close(); err != nil {
+		log.Printf("Failed to close subscriber: %v", err)
+	}
+	log.Println("Ticket consumer stopped")
 }
 
-func (c *TicketConsumer) simulateTicketEventListener() {
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
-
+func (c *TicketConsumer) handle(ctx context.Context, msg *bus.Message) error {
+	delay := time.Second
 	for {
+		err := c.process(msg)
+		if err == nil {
+			break
+		}
+
+		log.Printf("Failed to process message %d on %s, retrying in %v: %v", msg.Sequence, msg.Subject, delay, err)
 		select {
-		case <-ticker.C:
-			log.Println("Checking for new ticket events...")
-		case <-c.stopCh:
-			log.Println("Ticket consumer stopped")
-			return
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if delay < maxBackoff {
+			delay *= 2
 		}
 	}
+
+	return c.offsetRepo.CommitOffset(consumerName, TicketEventsSubject, msg.Sequence)
 }
 
-func (c *TicketConsumer) processTicketPurchase(userID, eventID string) {
+func (c *TicketConsumer) process(msg *bus.Message) error {
+	var payload ticketpb.TicketEvent
+	if err := msg.Envelope.GetPayload().UnmarshalTo(&payload); err != nil {
+		log.Printf("Skipping malformed message %d on %s: %v", msg.Sequence, msg.Subject, err)
+		return nil
+	}
+
+	messageID := msg.Envelope.Id
+	switch msg.Envelope.Type {
+	case eventTicketPurchased:
+		return c.processTicketPurchase(messageID, &payload)
+	case eventTicketCancelled:
+		return c.processTicketCancellation(messageID, payload.UserId, payload.EventId, payload.Reason)
+	case eventTicketRefunded:
+		return c.processTicketRefund(messageID, payload.UserId, payload.EventId, payload.Reason)
+	case eventTicketTransferInitiated, eventTicketTransferAccepted, eventTicketTransferCancelled, eventTicketResold:
+		return c.processTicketTransfer(messageID, msg.Envelope.Type, &payload)
+	case eventWaitlistOffered, eventWaitlistOfferExpired:
+		return c.processWaitlistOffer(messageID, msg.Envelope.Type, &payload)
+	default:
+		return nil
+	}
+}
+
+// processTicketPurchase confirms a purchase to its buyer. Ticket-service
+// publishes one event per ticket, so the notice is keyed by the order rather
+// than the message and an order of several tickets is confirmed once.
+func (c *TicketConsumer) processTicketPurchase(messageID string, payload *ticketpb.TicketEvent) error {
+	userID, eventID := payload.UserId, payload.EventId
+
 	message := fmt.Sprintf("Your ticket for event %s has been confirmed!", eventID)
+	if payload.OrderId != "" {
+		messageID = "order:" + payload.OrderId
+		message = fmt.Sprintf("Your order %s has been confirmed! Your tickets are ready.", payload.OrderId)
+	}
 
-	_, err := c.notificationRepo.SaveNotification(userID, message)
-	if err != nil {
-		log.Printf("Failed to send purchase confirmation: %v", err)
-		return
+	if err := c.notify(messageID, userID, message); err != nil {
+		return fmt.Errorf("failed to send purchase confirmation: %w", err)
 	}
 
 	log.Printf("Purchase confirmation sent to user %s for event %s", userID, eventID)
+	return nil
+}
+
+func (c *TicketConsumer) processTicketCancellation(messageID, userID, eventID, reason string) error {
+	message := fmt.Sprintf("Your ticket for event %s has been cancelled.", eventID)
+	if reason != "" {
+		message = fmt.Sprintf("Your ticket for event %s has been cancelled: %s.", eventID, reason)
+	}
+
+	if err := c.notify(messageID, userID, message); err != nil {
+		return fmt.Errorf("failed to send cancellation notice: %w", err)
+	}
+
+	log.Printf("Cancellation notice sent to user %s for event %s", userID, eventID)
+	return nil
+}
+
+func (c *TicketConsumer) processTicketRefund(messageID, userID, eventID, reason string) error {
+	message := fmt.Sprintf("Your ticket for event %s has been refunded.", eventID)
+	if reason != "" {
+		message = fmt.Sprintf("Your ticket for event %s has been refunded: %s.", eventID, reason)
+	}
+
+	if err := c.notify(messageID, userID, message); err != nil {
+		return fmt.Errorf("failed to send refund notice: %w", err)
+	}
+
+	log.Printf("Refund notice sent to user %s for event %s", userID, eventID)
+	return nil
+}
+
+// processTicketTransfer notifies both the sender and the recipient of a
+// transfer, or the seller and buyer of a resold ticket. A retry after the
+// recipient's notice failed skips the sender's, which was already sent.
+func (c *TicketConsumer) processTicketTransfer(messageID, eventType string, payload *ticketpb.TicketEvent) error {
+	from, to, eventID := payload.FromUserId, payload.ToUserId, payload.EventId
+
+	var toSender, toRecipient string
+	switch eventType {
+	case eventTicketTransferInitiated:
+		toSender = fmt.Sprintf("Your ticket for event %s is waiting for %s to accept it.", eventID, to)
+		toRecipient = fmt.Sprintf("%s wants to give you a ticket for event %s. Accept transfer %s to get it.", from, eventID, payload.TransferId)
+	case eventTicketTransferAccepted:
+		toSender = fmt.Sprintf("Your ticket for event %s has been transferred to %s.", eventID, to)
+		toRecipient = fmt.Sprintf("You received a ticket for event %s from %s!", eventID, from)
+	case eventTicketTransferCancelled:
+		toSender = fmt.Sprintf("The transfer of your ticket for event %s to %s has been cancelled.", eventID, to)
+		toRecipient = fmt.Sprintf("The ticket for event %s offered by %s is no longer available.", eventID, from)
+	case eventTicketResold:
+		toSender = fmt.Sprintf("Your ticket for event %s has been sold on listing %s. Your payout is on its way.", eventID, payload.ListingId)
+		toRecipient = fmt.Sprintf("You bought a resale ticket for event %s!", eventID)
+	}
+
+	if err := c.notify(messageID, from, toSender); err != nil {
+		return fmt.Errorf("failed to send transfer notice to sender: %w", err)
+	}
+	if err := c.notify(messageID, to, toRecipient); err != nil {
+		return fmt.Errorf("failed to send transfer notice to recipient: %w", err)
+	}
+
+	log.Printf("Transfer notices sent to users %s and %s for event %s", from, to, eventID)
+	return nil
+}
+
+// processWaitlistOffer tells a user on an event's waitlist that tickets are
+// being held for them, or that the hold ran out.
+func (c *TicketConsumer) processWaitlistOffer(messageID, eventType string, payload *ticketpb.TicketEvent) error {
+	userID, eventID := payload.UserId, payload.EventId
+
+	var message string
+	switch eventType {
+	case eventWaitlistOffered:
+		message = fmt.Sprintf("%d ticket(s) for event %s are being held for you. Claim waitlist offer %s before %s to buy them.",
+			payload.Quantity, eventID, payload.WaitlistEntryId, payload.OfferExpiresAt.AsTime().Format(time.RFC1123))
+	case eventWaitlistOfferExpired:
+		message = fmt.Sprintf("Your waitlist offer for event %s has expired and passed to the next person in line.", eventID)
+	}
+
+	if err := c.notify(messageID, userID, message); err != nil {
+		return fmt.Errorf("failed to send waitlist notice: %w", err)
+	}
+
+	log.Printf("Waitlist notice sent to user %s for event %s", userID, eventID)
+	return nil
+}
+
+// notify saves message for userID unless the notice for this bus message was
+// already sent to them, so that a retried message notifies nobody twice.
+func (c *TicketConsumer) notify(messageID, userID, message string) error {
+	sent, err := c.notificationRepo.SaveNotificationOnce(messageID, userID, message)
+	if err != nil {
+		return err
+	}
+	if !sent {
+		log.Printf("Notice for message %s was already sent to user %s", messageID, userID)
+	}
+	return nil
 }
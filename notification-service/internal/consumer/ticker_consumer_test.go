@@ -0,0 +1,297 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
+	buspb "github.com/doniiel/event-ticketing-platform/proto/bus"
+	notificationpb "github.com/doniiel/event-ticketing-platform/proto/notification"
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) SaveNotification(userID, message string) (*notificationpb.Notification, error) {
+	args := m.Called(userID, message)
+	return args.Get(0).(*notificationpb.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) SaveNotificationOnce(messageID, userID, message string) (bool, error) {
+	args := m.Called(messageID, userID, message)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockNotificationRepository) GetNotificationsByUserID(userID string) ([]*notificationpb.Notification, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]*notificationpb.Notification), args.Error(1)
+}
+
+type memoryOffsetRepository struct {
+	mu      sync.Mutex
+	offsets map[string]uint64
+}
+
+func newMemoryOffsetRepository() *memoryOffsetRepository {
+	return &memoryOffsetRepository{offsets: make(map[string]uint64)}
+}
+
+func (r *memoryOffsetRepository) GetOffset(consumer, subject string) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.offsets[consumer+"/"+subject], nil
+}
+
+func (r *memoryOffsetRepository) CommitOffset(consumer, subject string, sequence uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if sequence > r.offsets[consumer+"/"+subject] {
+		r.offsets[consumer+"/"+subject] = sequence
+	}
+	return nil
+}
+
+func (r *memoryOffsetRepository) offset() uint64 {
+	offset, _ := r.GetOffset(consumerName, TicketEventsSubject)
+	return offset
+}
+
+// memoryNotificationRepository keeps notifications in memory, remembering
+// which notices were sent for which message. failures makes the next saves
+// for a user fail.
+type memoryNotificationRepository struct {
+	mu       sync.Mutex
+	sent     map[string]bool
+	messages map[string][]string
+	failures map[string]int
+}
+
+func newMemoryNotificationRepository() *memoryNotificationRepository {
+	return &memoryNotificationRepository{
+		sent:     make(map[string]bool),
+		messages: make(map[string][]string),
+		failures: make(map[string]int),
+	}
+}
+
+func (r *memoryNotificationRepository) SaveNotification(userID, message string) (*notificationpb.Notification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages[userID] = append(r.messages[userID], message)
+	return &notificationpb.Notification{UserId: userID, Message: message}, nil
+}
+
+func (r *memoryNotificationRepository) SaveNotificationOnce(messageID, userID, message string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failures[userID] > 0 {
+		r.failures[userID]--
+		return false, errors.New("database unavailable")
+	}
+	if r.sent[messageID+"/"+userID] {
+		return false, nil
+	}
+	r.sent[messageID+"/"+userID] = true
+	r.messages[userID] = append(r.messages[userID], message)
+	return true, nil
+}
+
+func (r *memoryNotificationRepository) GetNotificationsByUserID(userID string) ([]*notificationpb.Notification, error) {
+	return nil, nil
+}
+
+func (r *memoryNotificationRepository) notifications(userID string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.messages[userID]...)
+}
+
+func publishTicketEvent(t *testing.T, b *bus.Memory, id, eventType string, payload *ticketpb.TicketEvent) {
+	env, err := bus.NewEnvelope(context.Background(), id, eventType, "ticket-service", time.Now(), payload)
+	assert.NoError(t, err)
+	assert.NoError(t, b.Publish(context.Background(), "tickets."+eventType, env))
+}
+
+func TestTicketConsumer_ProducesNotifications(t *testing.T) {
+	b := bus.NewMemory()
+	mockRepo := new(MockNotificationRepository)
+	offsets := newMemoryOffsetRepository()
+
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "user1", "Your ticket for event event1 has been confirmed!").
+		Return(true, nil).Once()
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "user1", "Your ticket for event event1 has been cancelled: your hold expired.").
+		Return(true, nil).Once()
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "user2", "Your ticket for event event2 has been refunded.").
+		Return(true, nil).Once()
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "user3", "Your ticket for event event3 has been refunded: the event was cancelled (storm warning).").
+		Return(true, nil).Once()
+
+	publishTicketEvent(t, b, "1", "TicketPurchased", &ticketpb.TicketEvent{UserId: "user1", EventId: "event1"})
+	publishTicketEvent(t, b, "2", "TicketCancelled", &ticketpb.TicketEvent{UserId: "user1", EventId: "event1", Reason: "your hold expired"})
+	publishTicketEvent(t, b, "3", "TicketRefunded", &ticketpb.TicketEvent{UserId: "user2", EventId: "event2"})
+	publishTicketEvent(t, b, "4", "TicketRefunded", &ticketpb.TicketEvent{UserId: "user3", EventId: "event3", Reason: "the event was cancelled (storm warning)"})
+	wrongPayload, _ := anypb.New(&notificationpb.Notification{})
+	assert.NoError(t, b.Publish(context.Background(), "tickets.TicketPurchased", &buspb.Envelope{Id: "5", Type: "TicketPurchased", Payload: wrongPayload}))
+
+	consumer := NewTicketConsumer(b, mockRepo, offsets)
+	assert.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	assert.Eventually(t, func() bool { return offsets.offset() == 5 }, time.Second, 10*time.Millisecond)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTicketConsumer_ConfirmsOrderOnce(t *testing.T) {
+	b := bus.NewMemory()
+	repo := newMemoryNotificationRepository()
+	offsets := newMemoryOffsetRepository()
+
+	publishTicketEvent(t, b, "t1", "TicketPurchased", &ticketpb.TicketEvent{TicketId: "t1", UserId: "alice", EventId: "event1", OrderId: "o1"})
+	publishTicketEvent(t, b, "t2", "TicketPurchased", &ticketpb.TicketEvent{TicketId: "t2", UserId: "alice", EventId: "event1", OrderId: "o1"})
+	publishTicketEvent(t, b, "t3", "TicketPurchased", &ticketpb.TicketEvent{TicketId: "t3", UserId: "alice", EventId: "event2", OrderId: "o2"})
+
+	consumer := NewTicketConsumer(b, repo, offsets)
+	assert.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	assert.Eventually(t, func() bool { return offsets.offset() == 3 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{
+		"Your order o1 has been confirmed! Your tickets are ready.",
+		"Your order o2 has been confirmed! Your tickets are ready.",
+	}, repo.notifications("alice"))
+}
+
+func TestTicketConsumer_NotifiesBothSidesOfTransfer(t *testing.T) {
+	b := bus.NewMemory()
+	mockRepo := new(MockNotificationRepository)
+	offsets := newMemoryOffsetRepository()
+
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "alice", "Your ticket for event event1 is waiting for bob to accept it.").
+		Return(true, nil).Once()
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "bob", "alice wants to give you a ticket for event event1. Accept transfer t1 to get it.").
+		Return(true, nil).Once()
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "alice", "Your ticket for event event1 has been transferred to bob.").
+		Return(true, nil).Once()
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "bob", "You received a ticket for event event1 from alice!").
+		Return(true, nil).Once()
+
+	transfer := &ticketpb.TicketEvent{UserId: "alice", EventId: "event1", TransferId: "t1", FromUserId: "alice", ToUserId: "bob"}
+	publishTicketEvent(t, b, "1", "TicketTransferInitiated", transfer)
+	publishTicketEvent(t, b, "2", "TicketTransferAccepted", transfer)
+
+	consumer := NewTicketConsumer(b, mockRepo, offsets)
+	assert.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	assert.Eventually(t, func() bool { return offsets.offset() == 2 }, time.Second, 10*time.Millisecond)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTicketConsumer_RetriedTransferNotifiesSenderOnce(t *testing.T) {
+	b := bus.NewMemory()
+	repo := newMemoryNotificationRepository()
+	repo.failures["bob"] = 1
+	offsets := newMemoryOffsetRepository()
+
+	publishTicketEvent(t, b, "1", "TicketTransferAccepted", &ticketpb.TicketEvent{UserId: "alice", EventId: "event1", TransferId: "t1", FromUserId: "alice", ToUserId: "bob"})
+
+	consumer := NewTicketConsumer(b, repo, offsets)
+	assert.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	assert.Eventually(t, func() bool { return offsets.offset() == 1 }, 3*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"Your ticket for event event1 has been transferred to bob."}, repo.notifications("alice"))
+	assert.Equal(t, []string{"You received a ticket for event event1 from alice!"}, repo.notifications("bob"))
+}
+
+func TestTicketConsumer_NotifiesBothSidesOfResale(t *testing.T) {
+	b := bus.NewMemory()
+	mockRepo := new(MockNotificationRepository)
+	offsets := newMemoryOffsetRepository()
+
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "alice", "Your ticket for event event1 has been sold on listing l1. Your payout is on its way.").
+		Return(true, nil).Once()
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "bob", "You bought a resale ticket for event event1!").
+		Return(true, nil).Once()
+
+	publishTicketEvent(t, b, "1", "TicketResold", &ticketpb.TicketEvent{UserId: "bob", EventId: "event1", ListingId: "l1", FromUserId: "alice", ToUserId: "bob"})
+
+	consumer := NewTicketConsumer(b, mockRepo, offsets)
+	assert.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	assert.Eventually(t, func() bool { return offsets.offset() == 1 }, time.Second, 10*time.Millisecond)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTicketConsumer_NotifiesWaitlistOffers(t *testing.T) {
+	b := bus.NewMemory()
+	mockRepo := new(MockNotificationRepository)
+	offsets := newMemoryOffsetRepository()
+
+	expiresAt := time.Date(2026, 10, 18, 20, 30, 0, 0, time.UTC)
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "alice", "2 ticket(s) for event event1 are being held for you. Claim waitlist offer w1 before Sun, 18 Oct 2026 20:30:00 UTC to buy them.").
+		Return(true, nil).Once()
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "alice", "Your waitlist offer for event event1 has expired and passed to the next person in line.").
+		Return(true, nil).Once()
+
+	publishTicketEvent(t, b, "1", "WaitlistOffered", &ticketpb.TicketEvent{UserId: "alice", EventId: "event1", Quantity: 2, WaitlistEntryId: "w1", OfferExpiresAt: timestamppb.New(expiresAt)})
+	publishTicketEvent(t, b, "2", "WaitlistOfferExpired", &ticketpb.TicketEvent{UserId: "alice", EventId: "event1", Quantity: 2, WaitlistEntryId: "w1"})
+
+	consumer := NewTicketConsumer(b, mockRepo, offsets)
+	assert.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	assert.Eventually(t, func() bool { return offsets.offset() == 2 }, time.Second, 10*time.Millisecond)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTicketConsumer_ResumesAfterOffset(t *testing.T) {
+	b := bus.NewMemory()
+	mockRepo := new(MockNotificationRepository)
+	offsets := newMemoryOffsetRepository()
+
+	publishTicketEvent(t, b, "1", "TicketPurchased", &ticketpb.TicketEvent{UserId: "user1", EventId: "event1"})
+	publishTicketEvent(t, b, "2", "TicketPurchased", &ticketpb.TicketEvent{UserId: "user2", EventId: "event1"})
+	assert.NoError(t, offsets.CommitOffset(consumerName, TicketEventsSubject, 1))
+
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "user2", "Your ticket for event event1 has been confirmed!").
+		Return(true, nil).Once()
+
+	consumer := NewTicketConsumer(b, mockRepo, offsets)
+	assert.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	assert.Eventually(t, func() bool { return offsets.offset() == 2 }, time.Second, 10*time.Millisecond)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "SaveNotificationOnce", mock.Anything, "user1", mock.Anything)
+}
+
+func TestTicketConsumer_RetriesFailedMessage(t *testing.T) {
+	b := bus.NewMemory()
+	mockRepo := new(MockNotificationRepository)
+	offsets := newMemoryOffsetRepository()
+
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "user1", mock.Anything).
+		Return(false, errors.New("database unavailable")).Once()
+	mockRepo.On("SaveNotificationOnce", mock.Anything, "user1", mock.Anything).
+		Return(true, nil).Once()
+
+	publishTicketEvent(t, b, "1", "TicketRefunded", &ticketpb.TicketEvent{UserId: "user1", EventId: "event1"})
+
+	consumer := NewTicketConsumer(b, mockRepo, offsets)
+	assert.NoError(t, consumer.Start())
+	defer consumer.Stop()
+
+	assert.Eventually(t, func() bool { return offsets.offset() == 1 }, 3*time.Second, 10*time.Millisecond)
+	mockRepo.AssertNumberOfCalls(t, "SaveNotificationOnce", 2)
+}
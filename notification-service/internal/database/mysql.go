@@ -32,7 +32,8 @@ func NewMySQLConnection(dsn string) (*sql.DB, error) {
 }
 
 func createTables(db *sql.DB) error {
-	query := `
+	queries := []string{
+		`
 	CREATE TABLE IF NOT EXISTS notifications (
 		id VARCHAR(36) PRIMARY KEY,
 		user_id VARCHAR(36) NOT NULL,
@@ -41,8 +42,31 @@ func createTables(db *sql.DB) error {
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 		INDEX (user_id)
 	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
-	`
+	`,
+		`
+	CREATE TABLE IF NOT EXISTS consumer_offsets (
+		consumer VARCHAR(64) NOT NULL,
+		subject VARCHAR(255) NOT NULL,
+		last_sequence BIGINT UNSIGNED NOT NULL,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		PRIMARY KEY (consumer, subject)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+	`,
+		`
+	CREATE TABLE IF NOT EXISTS sent_notices (
+		message_id VARCHAR(64) NOT NULL,
+		user_id VARCHAR(36) NOT NULL,
+		notification_id VARCHAR(36) NOT NULL,
+		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (message_id, user_id)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+	`,
+	}
 
-	_, err := db.Exec(query)
-	return err
+	for _, query := range queries {
+		if _, err := db.Exec(query); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -19,6 +19,11 @@ func (m *MockNotificationRepository) SaveNotification(userID, message string) (*
 	return args.Get(0).(*notificationpb.Notification), args.Error(1)
 }
 
+func (m *MockNotificationRepository) SaveNotificationOnce(messageID, userID, message string) (bool, error) {
+	args := m.Called(messageID, userID, message)
+	return args.Bool(0), args.Error(1)
+}
+
 func (m *MockNotificationRepository) GetNotificationsByUserID(userID string) ([]*notificationpb.Notification, error) {
 	args := m.Called(userID)
 	return args.Get(0).([]*notificationpb.Notification), args.Error(1)
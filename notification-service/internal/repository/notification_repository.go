@@ -11,6 +11,7 @@ import (
 
 type NotificationRepository interface {
 	SaveNotification(userID, message string) (*notificationpb.Notification, error)
+	SaveNotificationOnce(messageID, userID, message string) (bool, error)
 	GetNotificationsByUserID(userID string) ([]*notificationpb.Notification, error)
 }
 
@@ -42,6 +43,43 @@ func (r *MySQLNotificationRepository) SaveNotification(userID, message string) (
 	}, nil
 }
 
+// SaveNotificationOnce saves the notice for userID about the bus message
+// messageID unless it has been saved before, and reports whether it saved it
+// now. The notice is recorded in the same transaction as the notification, so
+// a retried message notifies each of its recipients once.
+func (r *MySQLNotificationRepository) SaveNotificationOnce(messageID, userID, message string) (bool, error) {
+	id := uuid.New().String()
+	now := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`INSERT IGNORE INTO sent_notices (message_id, user_id, notification_id) VALUES (?, ?, ?)`, messageID, userID, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to record notice: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to record notice: %w", err)
+	}
+	if rows == 0 {
+		return false, nil
+	}
+
+	query := `INSERT INTO notifications (id, user_id, message, sent_at) VALUES (?, ?, ?, ?)`
+	if _, err := tx.Exec(query, id, userID, message, now); err != nil {
+		return false, fmt.Errorf("failed to save notification: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit notification: %w", err)
+	}
+	return true, nil
+}
+
 func (r *MySQLNotificationRepository) GetNotificationsByUserID(userID string) ([]*notificationpb.Notification, error) {
 	query := `SELECT id, user_id, message, sent_at FROM notifications WHERE user_id = ? ORDER BY sent_at DESC`
 	rows, err := r.db.Query(query, userID)
@@ -170,3 +170,51 @@ func TestMySQLNotificationRepository_GetNotificationsByUserID(t *testing.T) {
 		})
 	}
 }
+
+func TestMySQLNotificationRepository_SaveNotificationOnce(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewNotificationRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT IGNORE INTO sent_notices").
+		WithArgs("msg1", "alice", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO notifications").
+		WithArgs(sqlmock.AnyArg(), "alice", "Your ticket has been transferred.", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	sent, err := repo.SaveNotificationOnce("msg1", "alice", "Your ticket has been transferred.")
+	assert.NoError(t, err)
+	assert.True(t, sent)
+
+	// The notice was already sent, so nothing is saved.
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT IGNORE INTO sent_notices").
+		WithArgs("msg1", "alice", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	sent, err = repo.SaveNotificationOnce("msg1", "alice", "Your ticket has been transferred.")
+	assert.NoError(t, err)
+	assert.False(t, sent)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT IGNORE INTO sent_notices").
+		WithArgs("msg2", "bob", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO notifications").
+		WillReturnError(errors.New("database error"))
+	mock.ExpectRollback()
+
+	sent, err = repo.SaveNotificationOnce("msg2", "bob", "You received a ticket.")
+	assert.Error(t, err)
+	assert.False(t, sent)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
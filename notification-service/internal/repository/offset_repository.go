@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// OffsetRepository remembers the last message sequence each consumer has
+// processed on a subject.
+type OffsetRepository interface {
+	GetOffset(consumer, subject string) (uint64, error)
+	CommitOffset(consumer, subject string, sequence uint64) error
+}
+
+type MySQLOffsetRepository struct {
+	db *sql.DB
+}
+
+func NewOffsetRepository(db *sql.DB) OffsetRepository {
+	return &MySQLOffsetRepository{
+		db: db,
+	}
+}
+
+func (r *MySQLOffsetRepository) GetOffset(consumer, subject string) (uint64, error) {
+	query := `SELECT last_sequence FROM consumer_offsets WHERE consumer = ? AND subject = ?`
+
+	var sequence uint64
+	err := r.db.QueryRow(query, consumer, subject).Scan(&sequence)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get consumer offset: %w", err)
+	}
+
+	return sequence, nil
+}
+
+func (r *MySQLOffsetRepository) CommitOffset(consumer, subject string, sequence uint64) error {
+	query := `
+		INSERT INTO consumer_offsets (consumer, subject, last_sequence) VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE last_sequence = GREATEST(last_sequence, VALUES(last_sequence))
+	`
+
+	_, err := r.db.Exec(query, consumer, subject, sequence)
+	if err != nil {
+		return fmt.Errorf("failed to commit consumer offset: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLOffsetRepository_GetOffset(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewOffsetRepository(db)
+
+	mock.ExpectQuery("SELECT last_sequence FROM consumer_offsets WHERE consumer = \\? AND subject = \\?").
+		WithArgs("notification-service", "tickets.>").
+		WillReturnRows(sqlmock.NewRows([]string{"last_sequence"}).AddRow(42))
+
+	offset, err := repo.GetOffset("notification-service", "tickets.>")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(42), offset)
+
+	mock.ExpectQuery("SELECT last_sequence FROM consumer_offsets").
+		WithArgs("new-consumer", "tickets.>").
+		WillReturnRows(sqlmock.NewRows([]string{"last_sequence"}))
+
+	offset, err = repo.GetOffset("new-consumer", "tickets.>")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), offset)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMySQLOffsetRepository_CommitOffset(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create mock database: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewOffsetRepository(db)
+
+	mock.ExpectExec("INSERT INTO consumer_offsets").
+		WithArgs("notification-service", "tickets.>", uint64(7)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	assert.NoError(t, repo.CommitOffset("notification-service", "tickets.>", 7))
+
+	mock.ExpectExec("INSERT INTO consumer_offsets").
+		WithArgs("notification-service", "tickets.>", uint64(8)).
+		WillReturnError(errors.New("database error"))
+
+	err = repo.CommitOffset("notification-service", "tickets.>", 8)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to commit consumer offset")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
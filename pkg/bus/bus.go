@@ -0,0 +1,127 @@
+// Package bus lets services publish and consume domain events without
+// depending on each other. Every event travels in a protobuf Envelope that
+// carries its type, ID, timestamp and the trace context of the operation that
+// emitted it.
+package bus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	buspb "github.com/doniiel/event-ticketing-platform/proto/bus"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Message is a single envelope delivered by a Subscriber. Sequence increases
+// monotonically within a subscription and is what consumers store as their
+// offset.
+type Message struct {
+	Subject  string
+	Sequence uint64
+	Envelope *buspb.Envelope
+}
+
+// Handler processes a message. Messages of one subscription are handled one
+// at a time, in sequence order.
+type Handler func(ctx context.Context, msg *Message) error
+
+type Publisher interface {
+	// Publish stores env on subject. Publishing an envelope whose ID has
+	// already been published is a no-op, so callers can safely retry.
+	Publish(ctx context.Context, subject string, env *buspb.Envelope) error
+	Close() error
+}
+
+type Subscriber interface {
+	// Subscribe starts delivering messages whose subject matches subject and
+	// whose sequence is greater than after. Delivery continues in the
+	// background until ctx is cancelled.
+	Subscribe(ctx context.Context, subject string, after uint64, handler Handler) error
+	Close() error
+}
+
+type Bus interface {
+	Publisher
+	Subscriber
+}
+
+// Stream names a durable log and the subjects it captures.
+type Stream struct {
+	Name     string
+	Subjects []string
+}
+
+// Open connects to the JetStream server at url, or returns an in-process bus
+// when url is empty.
+func Open(url string, streams ...Stream) (Bus, error) {
+	if url == "" {
+		return NewMemory(), nil
+	}
+	return NewJetStream(url, streams...)
+}
+
+var propagator = propagation.TraceContext{}
+
+// NewEnvelope wraps payload in an envelope stamped with the trace context of
+// ctx.
+func NewEnvelope(ctx context.Context, id, eventType, source string, occurredAt time.Time, payload proto.Message) (*buspb.Envelope, error) {
+	body, err := anypb.New(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s payload: %w", eventType, err)
+	}
+
+	return &buspb.Envelope{
+		Id:           id,
+		Type:         eventType,
+		Source:       source,
+		OccurredAt:   timestamppb.New(occurredAt),
+		TraceContext: TraceContext(ctx),
+		Payload:      body,
+	}, nil
+}
+
+// TraceContext returns the trace context of ctx in a form that can be stored
+// alongside an event until it is published.
+func TraceContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier
+}
+
+// WithTraceContext returns ctx carrying a trace context previously captured
+// with TraceContext.
+func WithTraceContext(ctx context.Context, traceContext map[string]string) context.Context {
+	return propagator.Extract(ctx, propagation.MapCarrier(traceContext))
+}
+
+// ContextFromEnvelope returns ctx carrying the trace context stored in env, so
+// that work done for an event joins the trace that emitted it.
+func ContextFromEnvelope(ctx context.Context, env *buspb.Envelope) context.Context {
+	return WithTraceContext(ctx, env.TraceContext)
+}
+
+// subjectMatches reports whether subject matches pattern using NATS-style
+// wildcards: "*" matches one token and ">" matches one or more trailing tokens.
+func subjectMatches(pattern, subject string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+		if i >= len(subjectTokens) {
+			return false
+		}
+		if token != "*" && token != subjectTokens[i] {
+			return false
+		}
+	}
+
+	return len(patternTokens) == len(subjectTokens)
+}
@@ -0,0 +1,56 @@
+package bus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewEnvelope(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	occurredAt := time.Date(2025, 6, 1, 19, 0, 0, 0, time.UTC)
+
+	env, err := NewEnvelope(ctx, "id-1", "EventCreated", "event-service", occurredAt, &eventpb.Event{Id: "event1", Name: "Concert"})
+	assert.NoError(t, err)
+	assert.Equal(t, "id-1", env.Id)
+	assert.Equal(t, "EventCreated", env.Type)
+	assert.Equal(t, "event-service", env.Source)
+	assert.True(t, occurredAt.Equal(env.OccurredAt.AsTime()))
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", env.TraceContext["traceparent"])
+
+	var event eventpb.Event
+	assert.NoError(t, env.Payload.UnmarshalTo(&event))
+	assert.Equal(t, "Concert", event.Name)
+
+	extracted := trace.SpanContextFromContext(ContextFromEnvelope(context.Background(), env))
+	assert.Equal(t, traceID, extracted.TraceID())
+	assert.Equal(t, spanID, extracted.SpanID())
+}
+
+func TestSubjectMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		subject string
+		want    bool
+	}{
+		{"tickets.>", "tickets.TicketPurchased", true},
+		{"tickets.>", "tickets", false},
+		{"tickets.*", "tickets.TicketPurchased", true},
+		{"tickets.*", "tickets.a.b", false},
+		{"tickets.TicketRefunded", "tickets.TicketPurchased", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, subjectMatches(tt.pattern, tt.subject), "%s vs %s", tt.pattern, tt.subject)
+	}
+}
@@ -0,0 +1,121 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	buspb "github.com/doniiel/event-ticketing-platform/proto/bus"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+)
+
+// JetStream is a Bus backed by NATS JetStream. Stream sequence numbers are
+// used as offsets and envelope IDs as message IDs, so retried publishes are
+// deduplicated by the server.
+type JetStream struct {
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+// NewJetStream connects to url and makes sure every stream exists with the
+// given subjects.
+func NewJetStream(url string, streams ...Stream) (*JetStream, error) {
+	conn, err := nats.Connect(url, nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, stream := range streams {
+		_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+			Name:     stream.Name,
+			Subjects: stream.Subjects,
+			Storage:  jetstream.FileStorage,
+		})
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to create stream %s: %w", stream.Name, err)
+		}
+	}
+
+	return &JetStream{conn: conn, js: js}, nil
+}
+
+func (b *JetStream) Publish(ctx context.Context, subject string, env *buspb.Envelope) error {
+	data, err := proto.Marshal(env)
+	if err != nil {
+		return fmt.Errorf("failed to encode envelope: %w", err)
+	}
+
+	if _, err := b.js.Publish(ctx, subject, data, jetstream.WithMsgID(env.Id)); err != nil {
+		return fmt.Errorf("failed to publish to %s: %w", subject, err)
+	}
+	return nil
+}
+
+func (b *JetStream) Subscribe(ctx context.Context, subject string, after uint64, handler Handler) error {
+	stream, err := b.js.StreamNameBySubject(ctx, subject)
+	if err != nil {
+		return fmt.Errorf("failed to find stream for %s: %w", subject, err)
+	}
+
+	cfg := jetstream.OrderedConsumerConfig{
+		FilterSubjects: []string{subject},
+	}
+	if after > 0 {
+		cfg.DeliverPolicy = jetstream.DeliverByStartSequencePolicy
+		cfg.OptStartSeq = after + 1
+	}
+
+	consumer, err := b.js.OrderedConsumer(ctx, stream, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create consumer on %s: %w", stream, err)
+	}
+
+	consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
+		metadata, err := msg.Metadata()
+		if err != nil {
+			log.Printf("Failed to read metadata for message on %s: %v", msg.Subject(), err)
+			return
+		}
+
+		env := &buspb.Envelope{}
+		if err := proto.Unmarshal(msg.Data(), env); err != nil {
+			log.Printf("Skipping undecodable message %d on %s: %v", metadata.Sequence.Stream, msg.Subject(), err)
+			return
+		}
+
+		if err := handler(ctx, &Message{
+			Subject:  msg.Subject(),
+			Sequence: metadata.Sequence.Stream,
+			Envelope: env,
+		}); err != nil {
+			log.Printf("Handler failed for message %d on %s: %v", metadata.Sequence.Stream, msg.Subject(), err)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to consume from %s: %w", stream, err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		consumeCtx.Stop()
+	}()
+
+	return nil
+}
+
+func (b *JetStream) Close() error {
+	return b.conn.Drain()
+}
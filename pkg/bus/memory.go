@@ -0,0 +1,92 @@
+package bus
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	buspb "github.com/doniiel/event-ticketing-platform/proto/bus"
+)
+
+// Memory is an in-process Bus. It keeps every published message so that
+// subscribers can resume from any offset, which makes it suitable for tests
+// and single-process setups.
+type Memory struct {
+	mu       sync.Mutex
+	messages []*Message
+	ids      map[string]bool
+	notify   chan struct{}
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		ids:    make(map[string]bool),
+		notify: make(chan struct{}),
+	}
+}
+
+func (b *Memory) Publish(ctx context.Context, subject string, env *buspb.Envelope) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ids[env.Id] {
+		return nil
+	}
+	b.ids[env.Id] = true
+
+	b.messages = append(b.messages, &Message{
+		Subject:  subject,
+		Sequence: uint64(len(b.messages) + 1),
+		Envelope: env,
+	})
+
+	close(b.notify)
+	b.notify = make(chan struct{})
+
+	return nil
+}
+
+func (b *Memory) Subscribe(ctx context.Context, subject string, after uint64, handler Handler) error {
+	go func() {
+		next := after
+		for {
+			messages, wait := b.since(next)
+			for _, msg := range messages {
+				next = msg.Sequence
+				if !subjectMatches(subject, msg.Subject) {
+					continue
+				}
+				if err := handler(ctx, msg); err != nil {
+					log.Printf("Handler failed for message %d on %s: %v", msg.Sequence, msg.Subject, err)
+				}
+			}
+
+			select {
+			case <-wait:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (b *Memory) Close() error {
+	return nil
+}
+
+// since returns the messages after sequence and a channel that is closed on
+// the next publish.
+func (b *Memory) since(sequence uint64) ([]*Message, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if sequence >= uint64(len(b.messages)) {
+		return nil, b.notify
+	}
+
+	messages := make([]*Message, len(b.messages)-int(sequence))
+	copy(messages, b.messages[sequence:])
+	return messages, b.notify
+}
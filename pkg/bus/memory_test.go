@@ -0,0 +1,68 @@
+package bus
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	buspb "github.com/doniiel/event-ticketing-platform/proto/bus"
+	"github.com/stretchr/testify/assert"
+)
+
+type recorder struct {
+	mu       sync.Mutex
+	messages []*Message
+}
+
+func (r *recorder) handle(ctx context.Context, msg *Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, msg)
+	return nil
+}
+
+func (r *recorder) ids() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := make([]string, 0, len(r.messages))
+	for _, msg := range r.messages {
+		ids = append(ids, msg.Envelope.Id)
+	}
+	return ids
+}
+
+func TestMemory_PublishSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewMemory()
+	assert.NoError(t, b.Publish(ctx, "tickets.TicketPurchased", &buspb.Envelope{Id: "1"}))
+	assert.NoError(t, b.Publish(ctx, "events.EventCreated", &buspb.Envelope{Id: "2"}))
+	assert.NoError(t, b.Publish(ctx, "tickets.TicketPurchased", &buspb.Envelope{Id: "1"}))
+
+	tickets := &recorder{}
+	assert.NoError(t, b.Subscribe(ctx, "tickets.>", 0, tickets.handle))
+
+	assert.NoError(t, b.Publish(ctx, "tickets.TicketRefunded", &buspb.Envelope{Id: "3"}))
+
+	assert.Eventually(t, func() bool { return len(tickets.ids()) == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"1", "3"}, tickets.ids())
+	assert.Equal(t, uint64(3), tickets.messages[1].Sequence)
+}
+
+func TestMemory_SubscribeAfterOffset(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := NewMemory()
+	for _, id := range []string{"1", "2", "3"} {
+		assert.NoError(t, b.Publish(ctx, "tickets.TicketPurchased", &buspb.Envelope{Id: id}))
+	}
+
+	r := &recorder{}
+	assert.NoError(t, b.Subscribe(ctx, "tickets.>", 2, r.handle))
+
+	assert.Eventually(t, func() bool { return len(r.ids()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"3"}, r.ids())
+}
@@ -23,14 +23,29 @@ const (
 )
 
 type Event struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	TicketStock   int32                  `protobuf:"varint,5,opt,name=ticket_stock,json=ticketStock,proto3" json:"ticket_stock,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Date        string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Location    string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	TicketStock int32                  `protobuf:"varint,5,opt,name=ticket_stock,json=ticketStock,proto3" json:"ticket_stock,omitempty"`
+	TicketTypes []*TicketType          `protobuf:"bytes,6,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	// DRAFT, PUBLISHED, ON_SALE, SOLD_OUT, CANCELLED or POSTPONED.
+	Status       string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	StatusReason string `protobuf:"bytes,8,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	// RFC 3339 times, empty when not set. From presale_start until sales_start
+	// tickets are only sold with a presale access code.
+	SalesStart   string `protobuf:"bytes,9,opt,name=sales_start,json=salesStart,proto3" json:"sales_start,omitempty"`
+	SalesEnd     string `protobuf:"bytes,10,opt,name=sales_end,json=salesEnd,proto3" json:"sales_end,omitempty"`
+	PresaleStart string `protobuf:"bytes,11,opt,name=presale_start,json=presaleStart,proto3" json:"presale_start,omitempty"`
+	// Caps on how many tickets one order and one user may buy; 0 means no
+	// limit.
+	MaxPerOrder int32 `protobuf:"varint,12,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"`
+	MaxPerUser  int32 `protobuf:"varint,13,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`
+	// Whether the organizer forbids passing tickets on to other users.
+	NonTransferable bool `protobuf:"varint,14,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Event) Reset() {
@@ -98,19 +113,185 @@ func (x *Event) GetTicketStock() int32 {
 	return 0
 }
 
-type CreateEventRequest struct {
+func (x *Event) GetTicketTypes() []*TicketType {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+func (x *Event) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Event) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *Event) GetSalesStart() string {
+	if x != nil {
+		return x.SalesStart
+	}
+	return ""
+}
+
+func (x *Event) GetSalesEnd() string {
+	if x != nil {
+		return x.SalesEnd
+	}
+	return ""
+}
+
+func (x *Event) GetPresaleStart() string {
+	if x != nil {
+		return x.PresaleStart
+	}
+	return ""
+}
+
+func (x *Event) GetMaxPerOrder() int32 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *Event) GetMaxPerUser() int32 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *Event) GetNonTransferable() bool {
+	if x != nil {
+		return x.NonTransferable
+	}
+	return false
+}
+
+// TicketType is a priced tier of an event's tickets. Prices are in minor
+// units of the currency.
+type TicketType struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	TicketStock   int32                  `protobuf:"varint,4,opt,name=ticket_stock,json=ticketStock,proto3" json:"ticket_stock,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price         int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Capacity      int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Available     int32                  `protobuf:"varint,7,opt,name=available,proto3" json:"available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *TicketType) Reset() {
+	*x = TicketType{}
+	mi := &file_event_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketType) ProtoMessage() {}
+
+func (x *TicketType) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketType.ProtoReflect.Descriptor instead.
+func (*TicketType) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TicketType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketType) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TicketType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TicketType) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TicketType) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TicketType) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *TicketType) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+type CreateEventRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Name     string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Date     string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Location string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// Ignored when ticket_types are given; the event's stock is then the sum of
+	// their capacities.
+	TicketStock     int32         `protobuf:"varint,4,opt,name=ticket_stock,json=ticketStock,proto3" json:"ticket_stock,omitempty"`
+	TicketTypes     []*TicketType `protobuf:"bytes,5,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	SalesStart      string        `protobuf:"bytes,6,opt,name=sales_start,json=salesStart,proto3" json:"sales_start,omitempty"`
+	SalesEnd        string        `protobuf:"bytes,7,opt,name=sales_end,json=salesEnd,proto3" json:"sales_end,omitempty"`
+	PresaleStart    string        `protobuf:"bytes,8,opt,name=presale_start,json=presaleStart,proto3" json:"presale_start,omitempty"`
+	MaxPerOrder     int32         `protobuf:"varint,9,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"`
+	MaxPerUser      int32         `protobuf:"varint,10,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`
+	NonTransferable bool          `protobuf:"varint,11,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *CreateEventRequest) Reset() {
 	*x = CreateEventRequest{}
-	mi := &file_event_event_proto_msgTypes[1]
+	mi := &file_event_event_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -122,7 +303,7 @@ func (x *CreateEventRequest) String() string {
 func (*CreateEventRequest) ProtoMessage() {}
 
 func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[1]
+	mi := &file_event_event_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -135,7 +316,7 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
 func (*CreateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{1}
+	return file_event_event_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateEventRequest) GetName() string {
@@ -166,6 +347,55 @@ func (x *CreateEventRequest) GetTicketStock() int32 {
 	return 0
 }
 
+func (x *CreateEventRequest) GetTicketTypes() []*TicketType {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetSalesStart() string {
+	if x != nil {
+		return x.SalesStart
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetSalesEnd() string {
+	if x != nil {
+		return x.SalesEnd
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetPresaleStart() string {
+	if x != nil {
+		return x.PresaleStart
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetMaxPerOrder() int32 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetMaxPerUser() int32 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *CreateEventRequest) GetNonTransferable() bool {
+	if x != nil {
+		return x.NonTransferable
+	}
+	return false
+}
+
 type CreateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
@@ -175,7 +405,7 @@ type CreateEventResponse struct {
 
 func (x *CreateEventResponse) Reset() {
 	*x = CreateEventResponse{}
-	mi := &file_event_event_proto_msgTypes[2]
+	mi := &file_event_event_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -187,7 +417,7 @@ func (x *CreateEventResponse) String() string {
 func (*CreateEventResponse) ProtoMessage() {}
 
 func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[2]
+	mi := &file_event_event_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +430,7 @@ func (x *CreateEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEventResponse.ProtoReflect.Descriptor instead.
 func (*CreateEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{2}
+	return file_event_event_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateEventResponse) GetEvent() *Event {
@@ -219,7 +449,7 @@ type GetEventRequest struct {
 
 func (x *GetEventRequest) Reset() {
 	*x = GetEventRequest{}
-	mi := &file_event_event_proto_msgTypes[3]
+	mi := &file_event_event_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -231,7 +461,7 @@ func (x *GetEventRequest) String() string {
 func (*GetEventRequest) ProtoMessage() {}
 
 func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[3]
+	mi := &file_event_event_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,7 +474,7 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
 func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{3}
+	return file_event_event_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetEventRequest) GetId() string {
@@ -263,7 +493,7 @@ type GetEventResponse struct {
 
 func (x *GetEventResponse) Reset() {
 	*x = GetEventResponse{}
-	mi := &file_event_event_proto_msgTypes[4]
+	mi := &file_event_event_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -275,7 +505,7 @@ func (x *GetEventResponse) String() string {
 func (*GetEventResponse) ProtoMessage() {}
 
 func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[4]
+	mi := &file_event_event_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -288,7 +518,7 @@ func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
 func (*GetEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{4}
+	return file_event_event_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetEventResponse) GetEvent() *Event {
@@ -299,19 +529,27 @@ func (x *GetEventResponse) GetEvent() *Event {
 }
 
 type UpdateEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
-	TicketStock   int32                  `protobuf:"varint,5,opt,name=ticket_stock,json=ticketStock,proto3" json:"ticket_stock,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Date         string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Location     string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	TicketStock  int32                  `protobuf:"varint,5,opt,name=ticket_stock,json=ticketStock,proto3" json:"ticket_stock,omitempty"`
+	SalesStart   string                 `protobuf:"bytes,6,opt,name=sales_start,json=salesStart,proto3" json:"sales_start,omitempty"`
+	SalesEnd     string                 `protobuf:"bytes,7,opt,name=sales_end,json=salesEnd,proto3" json:"sales_end,omitempty"`
+	PresaleStart string                 `protobuf:"bytes,8,opt,name=presale_start,json=presaleStart,proto3" json:"presale_start,omitempty"`
+	// 0 leaves a purchase limit as it is; a negative value removes it.
+	MaxPerOrder int32 `protobuf:"varint,9,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"`
+	MaxPerUser  int32 `protobuf:"varint,10,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`
+	// Left as it is when not set.
+	NonTransferable *bool `protobuf:"varint,11,opt,name=non_transferable,json=nonTransferable,proto3,oneof" json:"non_transferable,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateEventRequest) Reset() {
 	*x = UpdateEventRequest{}
-	mi := &file_event_event_proto_msgTypes[5]
+	mi := &file_event_event_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -323,7 +561,7 @@ func (x *UpdateEventRequest) String() string {
 func (*UpdateEventRequest) ProtoMessage() {}
 
 func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[5]
+	mi := &file_event_event_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +574,7 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{5}
+	return file_event_event_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateEventRequest) GetId() string {
@@ -374,6 +612,48 @@ func (x *UpdateEventRequest) GetTicketStock() int32 {
 	return 0
 }
 
+func (x *UpdateEventRequest) GetSalesStart() string {
+	if x != nil {
+		return x.SalesStart
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetSalesEnd() string {
+	if x != nil {
+		return x.SalesEnd
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetPresaleStart() string {
+	if x != nil {
+		return x.PresaleStart
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetMaxPerOrder() int32 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetMaxPerUser() int32 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *UpdateEventRequest) GetNonTransferable() bool {
+	if x != nil && x.NonTransferable != nil {
+		return *x.NonTransferable
+	}
+	return false
+}
+
 type UpdateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
@@ -383,7 +663,7 @@ type UpdateEventResponse struct {
 
 func (x *UpdateEventResponse) Reset() {
 	*x = UpdateEventResponse{}
-	mi := &file_event_event_proto_msgTypes[6]
+	mi := &file_event_event_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +675,7 @@ func (x *UpdateEventResponse) String() string {
 func (*UpdateEventResponse) ProtoMessage() {}
 
 func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[6]
+	mi := &file_event_event_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +688,7 @@ func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{6}
+	return file_event_event_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateEventResponse) GetEvent() *Event {
@@ -427,7 +707,7 @@ type DeleteEventRequest struct {
 
 func (x *DeleteEventRequest) Reset() {
 	*x = DeleteEventRequest{}
-	mi := &file_event_event_proto_msgTypes[7]
+	mi := &file_event_event_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +719,7 @@ func (x *DeleteEventRequest) String() string {
 func (*DeleteEventRequest) ProtoMessage() {}
 
 func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[7]
+	mi := &file_event_event_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +732,7 @@ func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{7}
+	return file_event_event_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteEventRequest) GetId() string {
@@ -470,7 +750,7 @@ type DeleteEventResponse struct {
 
 func (x *DeleteEventResponse) Reset() {
 	*x = DeleteEventResponse{}
-	mi := &file_event_event_proto_msgTypes[8]
+	mi := &file_event_event_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -482,7 +762,7 @@ func (x *DeleteEventResponse) String() string {
 func (*DeleteEventResponse) ProtoMessage() {}
 
 func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[8]
+	mi := &file_event_event_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,20 +775,22 @@ func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEventResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{8}
+	return file_event_event_proto_rawDescGZIP(), []int{9}
 }
 
 type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Defaults to PUBLISHED, ON_SALE, SOLD_OUT and POSTPONED.
+	Statuses      []string `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListEventsRequest) Reset() {
 	*x = ListEventsRequest{}
-	mi := &file_event_event_proto_msgTypes[9]
+	mi := &file_event_event_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -520,7 +802,7 @@ func (x *ListEventsRequest) String() string {
 func (*ListEventsRequest) ProtoMessage() {}
 
 func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[9]
+	mi := &file_event_event_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +815,7 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{9}
+	return file_event_event_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListEventsRequest) GetPage() int32 {
@@ -550,6 +832,13 @@ func (x *ListEventsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListEventsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
 type ListEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
@@ -560,7 +849,7 @@ type ListEventsResponse struct {
 
 func (x *ListEventsResponse) Reset() {
 	*x = ListEventsResponse{}
-	mi := &file_event_event_proto_msgTypes[10]
+	mi := &file_event_event_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -572,7 +861,7 @@ func (x *ListEventsResponse) String() string {
 func (*ListEventsResponse) ProtoMessage() {}
 
 func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[10]
+	mi := &file_event_event_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +874,7 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{10}
+	return file_event_event_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListEventsResponse) GetEvents() []*Event {
@@ -606,13 +895,15 @@ type CheckAvailabilityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TicketTypeId  string                 `protobuf:"bytes,3,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	AccessCode    string                 `protobuf:"bytes,4,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CheckAvailabilityRequest) Reset() {
 	*x = CheckAvailabilityRequest{}
-	mi := &file_event_event_proto_msgTypes[11]
+	mi := &file_event_event_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -624,7 +915,1649 @@ func (x *CheckAvailabilityRequest) String() string {
 func (*CheckAvailabilityRequest) ProtoMessage() {}
 
 func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[11]
+	mi := &file_event_event_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckAvailabilityRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CheckAvailabilityRequest) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *CheckAvailabilityRequest) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+type CheckAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAvailabilityResponse) Reset() {
+	*x = CheckAvailabilityResponse{}
+	mi := &file_event_event_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityResponse) ProtoMessage() {}
+
+func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CheckAvailabilityResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type StockReservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	TicketTypeId  string                 `protobuf:"bytes,5,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	UnitPrice     int64                  `protobuf:"varint,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,8,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReservation) Reset() {
+	*x = StockReservation{}
+	mi := &file_event_event_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReservation) ProtoMessage() {}
+
+func (x *StockReservation) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReservation.ProtoReflect.Descriptor instead.
+func (*StockReservation) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StockReservation) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *StockReservation) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StockReservation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockReservation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StockReservation) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *StockReservation) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *StockReservation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *StockReservation) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ReservationId string                 `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Required for events that have ticket types.
+	TicketTypeId string `protobuf:"bytes,4,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	// Required for events with a seat map; one seat per ticket.
+	SeatIds []string `protobuf:"bytes,5,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Required during the presale window.
+	AccessCode string `protobuf:"bytes,6,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
+	// Reservations with the same access group and access code use up a single
+	// use of the code between them, so an order item of several tickets counts
+	// once.
+	AccessGroup   string `protobuf:"bytes,7,opt,name=access_group,json=accessGroup,proto3" json:"access_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_event_event_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReserveStockRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ReserveStockRequest) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetAccessGroup() string {
+	if x != nil {
+		return x.AccessGroup
+	}
+	return ""
+}
+
+type ReserveStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *StockReservation      `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_event_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReserveStockResponse) GetReservation() *StockReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type ReleaseStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockRequest) Reset() {
+	*x = ReleaseStockRequest{}
+	mi := &file_event_event_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockRequest) ProtoMessage() {}
+
+func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReleaseStockRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type ReleaseStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *StockReservation      `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockResponse) Reset() {
+	*x = ReleaseStockResponse{}
+	mi := &file_event_event_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockResponse) ProtoMessage() {}
+
+func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseStockResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReleaseStockResponse) GetReservation() *StockReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type CommitStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitStockRequest) Reset() {
+	*x = CommitStockRequest{}
+	mi := &file_event_event_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitStockRequest) ProtoMessage() {}
+
+func (x *CommitStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitStockRequest.ProtoReflect.Descriptor instead.
+func (*CommitStockRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CommitStockRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type CommitStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *StockReservation      `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitStockResponse) Reset() {
+	*x = CommitStockResponse{}
+	mi := &file_event_event_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitStockResponse) ProtoMessage() {}
+
+func (x *CommitStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitStockResponse.ProtoReflect.Descriptor instead.
+func (*CommitStockResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CommitStockResponse) GetReservation() *StockReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type CreateTicketTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketTypeRequest) Reset() {
+	*x = CreateTicketTypeRequest{}
+	mi := &file_event_event_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketTypeRequest) ProtoMessage() {}
+
+func (x *CreateTicketTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateTicketTypeRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateTicketTypeRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type CreateTicketTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketType    *TicketType            `protobuf:"bytes,1,opt,name=ticket_type,json=ticketType,proto3" json:"ticket_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketTypeResponse) Reset() {
+	*x = CreateTicketTypeResponse{}
+	mi := &file_event_event_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketTypeResponse) ProtoMessage() {}
+
+func (x *CreateTicketTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateTicketTypeResponse) GetTicketType() *TicketType {
+	if x != nil {
+		return x.TicketType
+	}
+	return nil
+}
+
+type ListTicketTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTypesRequest) Reset() {
+	*x = ListTicketTypesRequest{}
+	mi := &file_event_event_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTypesRequest) ProtoMessage() {}
+
+func (x *ListTicketTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketTypesRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTicketTypesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListTicketTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketTypes   []*TicketType          `protobuf:"bytes,1,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTypesResponse) Reset() {
+	*x = ListTicketTypesResponse{}
+	mi := &file_event_event_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTypesResponse) ProtoMessage() {}
+
+func (x *ListTicketTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketTypesResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTicketTypesResponse) GetTicketTypes() []*TicketType {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+type UpdateTicketTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTicketTypeRequest) Reset() {
+	*x = UpdateTicketTypeRequest{}
+	mi := &file_event_event_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTicketTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTicketTypeRequest) ProtoMessage() {}
+
+func (x *UpdateTicketTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTicketTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateTicketTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTicketTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTicketTypeRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateTicketTypeRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateTicketTypeRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type UpdateTicketTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketType    *TicketType            `protobuf:"bytes,1,opt,name=ticket_type,json=ticketType,proto3" json:"ticket_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTicketTypeResponse) Reset() {
+	*x = UpdateTicketTypeResponse{}
+	mi := &file_event_event_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTicketTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTicketTypeResponse) ProtoMessage() {}
+
+func (x *UpdateTicketTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTicketTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateTicketTypeResponse) GetTicketType() *TicketType {
+	if x != nil {
+		return x.TicketType
+	}
+	return nil
+}
+
+type DeleteTicketTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTicketTypeRequest) Reset() {
+	*x = DeleteTicketTypeRequest{}
+	mi := &file_event_event_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTicketTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTicketTypeRequest) ProtoMessage() {}
+
+func (x *DeleteTicketTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTicketTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteTicketTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteTicketTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTicketTypeResponse) Reset() {
+	*x = DeleteTicketTypeResponse{}
+	mi := &file_event_event_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTicketTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTicketTypeResponse) ProtoMessage() {}
+
+func (x *DeleteTicketTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTicketTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{28}
+}
+
+// Seat is a numbered place in a venue. section and row are only set on
+// returned seats; in a seat map they are given by the enclosing section and
+// row.
+type Seat struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Section string                 `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"`
+	Row     string                 `protobuf:"bytes,3,opt,name=row,proto3" json:"row,omitempty"`
+	Number  int32                  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	// Optional. Restricts the seat to a ticket type.
+	TicketTypeId string `protobuf:"bytes,5,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Accessible   bool   `protobuf:"varint,6,opt,name=accessible,proto3" json:"accessible,omitempty"`
+	Obstructed   bool   `protobuf:"varint,7,opt,name=obstructed,proto3" json:"obstructed,omitempty"`
+	// AVAILABLE, HELD or SOLD.
+	Status        string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Seat) Reset() {
+	*x = Seat{}
+	mi := &file_event_event_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Seat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seat) ProtoMessage() {}
+
+func (x *Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
+func (*Seat) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Seat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Seat) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Seat) GetRow() string {
+	if x != nil {
+		return x.Row
+	}
+	return ""
+}
+
+func (x *Seat) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Seat) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *Seat) GetAccessible() bool {
+	if x != nil {
+		return x.Accessible
+	}
+	return false
+}
+
+func (x *Seat) GetObstructed() bool {
+	if x != nil {
+		return x.Obstructed
+	}
+	return false
+}
+
+func (x *Seat) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type SeatMapRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Seats         []*Seat                `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatMapRow) Reset() {
+	*x = SeatMapRow{}
+	mi := &file_event_event_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatMapRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMapRow) ProtoMessage() {}
+
+func (x *SeatMapRow) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMapRow.ProtoReflect.Descriptor instead.
+func (*SeatMapRow) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SeatMapRow) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SeatMapRow) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type SeatMapSection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rows          []*SeatMapRow          `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatMapSection) Reset() {
+	*x = SeatMapSection{}
+	mi := &file_event_event_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatMapSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMapSection) ProtoMessage() {}
+
+func (x *SeatMapSection) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMapSection.ProtoReflect.Descriptor instead.
+func (*SeatMapSection) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SeatMapSection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SeatMapSection) GetRows() []*SeatMapRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type SeatMap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Sections      []*SeatMapSection      `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+	Available     int32                  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatMap) Reset() {
+	*x = SeatMap{}
+	mi := &file_event_event_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatMap) ProtoMessage() {}
+
+func (x *SeatMap) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatMap.ProtoReflect.Descriptor instead.
+func (*SeatMap) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SeatMap) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SeatMap) GetSections() []*SeatMapSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *SeatMap) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+type PublishEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Open ticket sales as well as making the event visible.
+	OnSale        bool `protobuf:"varint,2,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishEventRequest) Reset() {
+	*x = PublishEventRequest{}
+	mi := &file_event_event_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventRequest) ProtoMessage() {}
+
+func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventRequest.ProtoReflect.Descriptor instead.
+func (*PublishEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PublishEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PublishEventRequest) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+type PublishEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishEventResponse) Reset() {
+	*x = PublishEventResponse{}
+	mi := &file_event_event_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventResponse) ProtoMessage() {}
+
+func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventResponse.ProtoReflect.Descriptor instead.
+func (*PublishEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PublishEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type CancelEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventRequest) Reset() {
+	*x = CancelEventRequest{}
+	mi := &file_event_event_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventRequest) ProtoMessage() {}
+
+func (x *CancelEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventRequest.ProtoReflect.Descriptor instead.
+func (*CancelEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CancelEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelEventRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventResponse) Reset() {
+	*x = CancelEventResponse{}
+	mi := &file_event_event_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventResponse) ProtoMessage() {}
+
+func (x *CancelEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventResponse.ProtoReflect.Descriptor instead.
+func (*CancelEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CancelEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type PostponeEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional new date (RFC 3339) if one is already known.
+	Date          string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostponeEventRequest) Reset() {
+	*x = PostponeEventRequest{}
+	mi := &file_event_event_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostponeEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostponeEventRequest) ProtoMessage() {}
+
+func (x *PostponeEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostponeEventRequest.ProtoReflect.Descriptor instead.
+func (*PostponeEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PostponeEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PostponeEventRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PostponeEventRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PostponeEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostponeEventResponse) Reset() {
+	*x = PostponeEventResponse{}
+	mi := &file_event_event_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostponeEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostponeEventResponse) ProtoMessage() {}
+
+func (x *PostponeEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostponeEventResponse.ProtoReflect.Descriptor instead.
+func (*PostponeEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PostponeEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type SaveSeatMapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Sections      []*SeatMapSection      `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSeatMapRequest) Reset() {
+	*x = SaveSeatMapRequest{}
+	mi := &file_event_event_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSeatMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSeatMapRequest) ProtoMessage() {}
+
+func (x *SaveSeatMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSeatMapRequest.ProtoReflect.Descriptor instead.
+func (*SaveSeatMapRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SaveSeatMapRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SaveSeatMapRequest) GetSections() []*SeatMapSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type SaveSeatMapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatMap       *SeatMap               `protobuf:"bytes,1,opt,name=seat_map,json=seatMap,proto3" json:"seat_map,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSeatMapResponse) Reset() {
+	*x = SaveSeatMapResponse{}
+	mi := &file_event_event_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSeatMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSeatMapResponse) ProtoMessage() {}
+
+func (x *SaveSeatMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSeatMapResponse.ProtoReflect.Descriptor instead.
+func (*SaveSeatMapResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SaveSeatMapResponse) GetSeatMap() *SeatMap {
+	if x != nil {
+		return x.SeatMap
+	}
+	return nil
+}
+
+type GetSeatMapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatMapRequest) Reset() {
+	*x = GetSeatMapRequest{}
+	mi := &file_event_event_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapRequest) ProtoMessage() {}
+
+func (x *GetSeatMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMapRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatMapRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetSeatMapRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetSeatMapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatMap       *SeatMap               `protobuf:"bytes,1,opt,name=seat_map,json=seatMap,proto3" json:"seat_map,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatMapResponse) Reset() {
+	*x = GetSeatMapResponse{}
+	mi := &file_event_event_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapResponse) ProtoMessage() {}
+
+func (x *GetSeatMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,47 +2568,128 @@ func (x *CheckAvailabilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAvailabilityRequest.ProtoReflect.Descriptor instead.
-func (*CheckAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetSeatMapResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatMapResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CheckAvailabilityRequest) GetEventId() string {
+func (x *GetSeatMapResponse) GetSeatMap() *SeatMap {
+	if x != nil {
+		return x.SeatMap
+	}
+	return nil
+}
+
+// PresaleCode grants access to an event's presale for up to max_uses
+// purchases.
+type PresaleCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses          int32                  `protobuf:"varint,4,opt,name=uses,proto3" json:"uses,omitempty"`
+	Revoked       bool                   `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresaleCode) Reset() {
+	*x = PresaleCode{}
+	mi := &file_event_event_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresaleCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresaleCode) ProtoMessage() {}
+
+func (x *PresaleCode) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresaleCode.ProtoReflect.Descriptor instead.
+func (*PresaleCode) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PresaleCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PresaleCode) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *CheckAvailabilityRequest) GetQuantity() int32 {
+func (x *PresaleCode) GetMaxUses() int32 {
 	if x != nil {
-		return x.Quantity
+		return x.MaxUses
 	}
 	return 0
 }
 
-type CheckAvailabilityResponse struct {
+func (x *PresaleCode) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *PresaleCode) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *PresaleCode) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GeneratePresaleCodesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Available     bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckAvailabilityResponse) Reset() {
-	*x = CheckAvailabilityResponse{}
-	mi := &file_event_event_proto_msgTypes[12]
+func (x *GeneratePresaleCodesRequest) Reset() {
+	*x = GeneratePresaleCodesRequest{}
+	mi := &file_event_event_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAvailabilityResponse) String() string {
+func (x *GeneratePresaleCodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAvailabilityResponse) ProtoMessage() {}
+func (*GeneratePresaleCodesRequest) ProtoMessage() {}
 
-func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[12]
+func (x *GeneratePresaleCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,62 +2700,475 @@ func (x *CheckAvailabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAvailabilityResponse.ProtoReflect.Descriptor instead.
-func (*CheckAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GeneratePresaleCodesRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePresaleCodesRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CheckAvailabilityResponse) GetAvailable() bool {
+func (x *GeneratePresaleCodesRequest) GetEventId() string {
 	if x != nil {
-		return x.Available
+		return x.EventId
 	}
-	return false
+	return ""
+}
+
+func (x *GeneratePresaleCodesRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GeneratePresaleCodesRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+type GeneratePresaleCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Codes         []*PresaleCode         `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePresaleCodesResponse) Reset() {
+	*x = GeneratePresaleCodesResponse{}
+	mi := &file_event_event_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePresaleCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePresaleCodesResponse) ProtoMessage() {}
+
+func (x *GeneratePresaleCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePresaleCodesResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePresaleCodesResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GeneratePresaleCodesResponse) GetCodes() []*PresaleCode {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type ListPresaleCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPresaleCodesRequest) Reset() {
+	*x = ListPresaleCodesRequest{}
+	mi := &file_event_event_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPresaleCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPresaleCodesRequest) ProtoMessage() {}
+
+func (x *ListPresaleCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPresaleCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListPresaleCodesRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListPresaleCodesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListPresaleCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Codes         []*PresaleCode         `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPresaleCodesResponse) Reset() {
+	*x = ListPresaleCodesResponse{}
+	mi := &file_event_event_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPresaleCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPresaleCodesResponse) ProtoMessage() {}
+
+func (x *ListPresaleCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPresaleCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListPresaleCodesResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListPresaleCodesResponse) GetCodes() []*PresaleCode {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type RevokePresaleCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePresaleCodeRequest) Reset() {
+	*x = RevokePresaleCodeRequest{}
+	mi := &file_event_event_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePresaleCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePresaleCodeRequest) ProtoMessage() {}
+
+func (x *RevokePresaleCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePresaleCodeRequest.ProtoReflect.Descriptor instead.
+func (*RevokePresaleCodeRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RevokePresaleCodeRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RevokePresaleCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RevokePresaleCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          *PresaleCode           `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePresaleCodeResponse) Reset() {
+	*x = RevokePresaleCodeResponse{}
+	mi := &file_event_event_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePresaleCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePresaleCodeResponse) ProtoMessage() {}
+
+func (x *RevokePresaleCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePresaleCodeResponse.ProtoReflect.Descriptor instead.
+func (*RevokePresaleCodeResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RevokePresaleCodeResponse) GetCode() *PresaleCode {
+	if x != nil {
+		return x.Code
+	}
+	return nil
 }
 
 var File_event_event_proto protoreflect.FileDescriptor
 
 const file_event_event_proto_rawDesc = "" +
 	"\n" +
-	"\x11event/event.proto\x12\x05event\x1a\x1cgoogle/api/annotations.proto\"~\n" +
+	"\x11event/event.proto\x12\x05event\x1a\x1cgoogle/api/annotations.proto\"\xc5\x03\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1a\n" +
 	"\blocation\x18\x04 \x01(\tR\blocation\x12!\n" +
-	"\fticket_stock\x18\x05 \x01(\x05R\vticketStock\"{\n" +
+	"\fticket_stock\x18\x05 \x01(\x05R\vticketStock\x124\n" +
+	"\fticket_types\x18\x06 \x03(\v2\x11.event.TicketTypeR\vticketTypes\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12#\n" +
+	"\rstatus_reason\x18\b \x01(\tR\fstatusReason\x12\x1f\n" +
+	"\vsales_start\x18\t \x01(\tR\n" +
+	"salesStart\x12\x1b\n" +
+	"\tsales_end\x18\n" +
+	" \x01(\tR\bsalesEnd\x12#\n" +
+	"\rpresale_start\x18\v \x01(\tR\fpresaleStart\x12\"\n" +
+	"\rmax_per_order\x18\f \x01(\x05R\vmaxPerOrder\x12 \n" +
+	"\fmax_per_user\x18\r \x01(\x05R\n" +
+	"maxPerUser\x12)\n" +
+	"\x10non_transferable\x18\x0e \x01(\bR\x0fnonTransferable\"\xb7\x01\n" +
+	"\n" +
+	"TicketType\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\x12\x1c\n" +
+	"\tavailable\x18\a \x01(\x05R\tavailable\"\x85\x03\n" +
 	"\x12CreateEventRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1a\n" +
 	"\blocation\x18\x03 \x01(\tR\blocation\x12!\n" +
-	"\fticket_stock\x18\x04 \x01(\x05R\vticketStock\"9\n" +
+	"\fticket_stock\x18\x04 \x01(\x05R\vticketStock\x124\n" +
+	"\fticket_types\x18\x05 \x03(\v2\x11.event.TicketTypeR\vticketTypes\x12\x1f\n" +
+	"\vsales_start\x18\x06 \x01(\tR\n" +
+	"salesStart\x12\x1b\n" +
+	"\tsales_end\x18\a \x01(\tR\bsalesEnd\x12#\n" +
+	"\rpresale_start\x18\b \x01(\tR\fpresaleStart\x12\"\n" +
+	"\rmax_per_order\x18\t \x01(\x05R\vmaxPerOrder\x12 \n" +
+	"\fmax_per_user\x18\n" +
+	" \x01(\x05R\n" +
+	"maxPerUser\x12)\n" +
+	"\x10non_transferable\x18\v \x01(\bR\x0fnonTransferable\"9\n" +
 	"\x13CreateEventResponse\x12\"\n" +
 	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"!\n" +
 	"\x0fGetEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
 	"\x10GetEventResponse\x12\"\n" +
-	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"\x8b\x01\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"\xf9\x02\n" +
 	"\x12UpdateEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1a\n" +
 	"\blocation\x18\x04 \x01(\tR\blocation\x12!\n" +
-	"\fticket_stock\x18\x05 \x01(\x05R\vticketStock\"9\n" +
+	"\fticket_stock\x18\x05 \x01(\x05R\vticketStock\x12\x1f\n" +
+	"\vsales_start\x18\x06 \x01(\tR\n" +
+	"salesStart\x12\x1b\n" +
+	"\tsales_end\x18\a \x01(\tR\bsalesEnd\x12#\n" +
+	"\rpresale_start\x18\b \x01(\tR\fpresaleStart\x12\"\n" +
+	"\rmax_per_order\x18\t \x01(\x05R\vmaxPerOrder\x12 \n" +
+	"\fmax_per_user\x18\n" +
+	" \x01(\x05R\n" +
+	"maxPerUser\x12.\n" +
+	"\x10non_transferable\x18\v \x01(\bH\x00R\x0fnonTransferable\x88\x01\x01B\x13\n" +
+	"\x11_non_transferable\"9\n" +
 	"\x13UpdateEventResponse\x12\"\n" +
 	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"$\n" +
 	"\x12DeleteEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
-	"\x13DeleteEventResponse\"D\n" +
+	"\x13DeleteEventResponse\"`\n" +
 	"\x11ListEventsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"P\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1a\n" +
+	"\bstatuses\x18\x03 \x03(\tR\bstatuses\"P\n" +
 	"\x12ListEventsResponse\x12$\n" +
 	"\x06events\x18\x01 \x03(\v2\f.event.EventR\x06events\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"Q\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x98\x01\n" +
 	"\x18CheckAvailabilityRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"9\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12$\n" +
+	"\x0eticket_type_id\x18\x03 \x01(\tR\fticketTypeId\x12\x1f\n" +
+	"\vaccess_code\x18\x04 \x01(\tR\n" +
+	"accessCode\"9\n" +
 	"\x19CheckAvailabilityResponse\x12\x1c\n" +
-	"\tavailable\x18\x01 \x01(\bR\tavailable2\xe7\x04\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\"\x84\x02\n" +
+	"\x10StockReservation\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12$\n" +
+	"\x0eticket_type_id\x18\x05 \x01(\tR\fticketTypeId\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\x03R\tunitPrice\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x19\n" +
+	"\bseat_ids\x18\b \x03(\tR\aseatIds\"\xf8\x01\n" +
+	"\x13ReserveStockRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12%\n" +
+	"\x0ereservation_id\x18\x02 \x01(\tR\rreservationId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12$\n" +
+	"\x0eticket_type_id\x18\x04 \x01(\tR\fticketTypeId\x12\x19\n" +
+	"\bseat_ids\x18\x05 \x03(\tR\aseatIds\x12\x1f\n" +
+	"\vaccess_code\x18\x06 \x01(\tR\n" +
+	"accessCode\x12!\n" +
+	"\faccess_group\x18\a \x01(\tR\vaccessGroup\"Q\n" +
+	"\x14ReserveStockResponse\x129\n" +
+	"\vreservation\x18\x01 \x01(\v2\x17.event.StockReservationR\vreservation\"<\n" +
+	"\x13ReleaseStockRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\"Q\n" +
+	"\x14ReleaseStockResponse\x129\n" +
+	"\vreservation\x18\x01 \x01(\v2\x17.event.StockReservationR\vreservation\";\n" +
+	"\x12CommitStockRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\"P\n" +
+	"\x13CommitStockResponse\x129\n" +
+	"\vreservation\x18\x01 \x01(\v2\x17.event.StockReservationR\vreservation\"\x96\x01\n" +
+	"\x17CreateTicketTypeRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\"N\n" +
+	"\x18CreateTicketTypeResponse\x122\n" +
+	"\vticket_type\x18\x01 \x01(\v2\x11.event.TicketTypeR\n" +
+	"ticketType\"3\n" +
+	"\x16ListTicketTypesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"O\n" +
+	"\x17ListTicketTypesResponse\x124\n" +
+	"\fticket_types\x18\x01 \x03(\v2\x11.event.TicketTypeR\vticketTypes\"\x8b\x01\n" +
+	"\x17UpdateTicketTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\"N\n" +
+	"\x18UpdateTicketTypeResponse\x122\n" +
+	"\vticket_type\x18\x01 \x01(\v2\x11.event.TicketTypeR\n" +
+	"ticketType\")\n" +
+	"\x17DeleteTicketTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1a\n" +
+	"\x18DeleteTicketTypeResponse\"\xd8\x01\n" +
+	"\x04Seat\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asection\x18\x02 \x01(\tR\asection\x12\x10\n" +
+	"\x03row\x18\x03 \x01(\tR\x03row\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x05R\x06number\x12$\n" +
+	"\x0eticket_type_id\x18\x05 \x01(\tR\fticketTypeId\x12\x1e\n" +
+	"\n" +
+	"accessible\x18\x06 \x01(\bR\n" +
+	"accessible\x12\x1e\n" +
+	"\n" +
+	"obstructed\x18\a \x01(\bR\n" +
+	"obstructed\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"E\n" +
+	"\n" +
+	"SeatMapRow\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12!\n" +
+	"\x05seats\x18\x02 \x03(\v2\v.event.SeatR\x05seats\"K\n" +
+	"\x0eSeatMapSection\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x04rows\x18\x02 \x03(\v2\x11.event.SeatMapRowR\x04rows\"u\n" +
+	"\aSeatMap\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x121\n" +
+	"\bsections\x18\x02 \x03(\v2\x15.event.SeatMapSectionR\bsections\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x05R\tavailable\">\n" +
+	"\x13PublishEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aon_sale\x18\x02 \x01(\bR\x06onSale\":\n" +
+	"\x14PublishEventResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"<\n" +
+	"\x12CancelEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"9\n" +
+	"\x13CancelEventResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"R\n" +
+	"\x14PostponeEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\";\n" +
+	"\x15PostponeEventResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"b\n" +
+	"\x12SaveSeatMapRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x121\n" +
+	"\bsections\x18\x02 \x03(\v2\x15.event.SeatMapSectionR\bsections\"@\n" +
+	"\x13SaveSeatMapResponse\x12)\n" +
+	"\bseat_map\x18\x01 \x01(\v2\x0e.event.SeatMapR\aseatMap\".\n" +
+	"\x11GetSeatMapRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"?\n" +
+	"\x12GetSeatMapResponse\x12)\n" +
+	"\bseat_map\x18\x01 \x01(\v2\x0e.event.SeatMapR\aseatMap\"\xa4\x01\n" +
+	"\vPresaleCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x19\n" +
+	"\bmax_uses\x18\x03 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x04 \x01(\x05R\x04uses\x12\x18\n" +
+	"\arevoked\x18\x05 \x01(\bR\arevoked\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"i\n" +
+	"\x1bGeneratePresaleCodesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x19\n" +
+	"\bmax_uses\x18\x03 \x01(\x05R\amaxUses\"H\n" +
+	"\x1cGeneratePresaleCodesResponse\x12(\n" +
+	"\x05codes\x18\x01 \x03(\v2\x12.event.PresaleCodeR\x05codes\"4\n" +
+	"\x17ListPresaleCodesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"D\n" +
+	"\x18ListPresaleCodesResponse\x12(\n" +
+	"\x05codes\x18\x01 \x03(\v2\x12.event.PresaleCodeR\x05codes\"I\n" +
+	"\x18RevokePresaleCodeRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"C\n" +
+	"\x19RevokePresaleCodeResponse\x12&\n" +
+	"\x04code\x18\x01 \x01(\v2\x12.event.PresaleCodeR\x04code2\x96\x13\n" +
 	"\fEventService\x12[\n" +
 	"\vCreateEvent\x12\x19.event.CreateEventRequest\x1a\x1a.event.CreateEventResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/events\x12T\n" +
@@ -751,7 +3178,23 @@ const file_event_event_proto_rawDesc = "" +
 	"\n" +
 	"ListEvents\x12\x18.event.ListEventsRequest\x1a\x19.event.ListEventsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/events\x12\x8b\x01\n" +
-	"\x11CheckAvailability\x12\x1f.event.CheckAvailabilityRequest\x1a .event.CheckAvailabilityResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/events/{event_id}/check-availabilityB9Z7github.com/doniiel/event-ticketing-platform/proto/eventb\x06proto3"
+	"\x11CheckAvailability\x12\x1f.event.CheckAvailabilityRequest\x1a .event.CheckAvailabilityResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/events/{event_id}/check-availability\x12v\n" +
+	"\fReserveStock\x12\x1a.event.ReserveStockRequest\x1a\x1b.event.ReserveStockResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/events/{event_id}/reservations\x12}\n" +
+	"\fReleaseStock\x12\x1a.event.ReleaseStockRequest\x1a\x1b.event.ReleaseStockResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/reservations/{reservation_id}/release\x12y\n" +
+	"\vCommitStock\x12\x19.event.CommitStockRequest\x1a\x1a.event.CommitStockResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/reservations/{reservation_id}/commit\x12\x82\x01\n" +
+	"\x10CreateTicketType\x12\x1e.event.CreateTicketTypeRequest\x1a\x1f.event.CreateTicketTypeResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/events/{event_id}/ticket-types\x12|\n" +
+	"\x0fListTicketTypes\x12\x1d.event.ListTicketTypesRequest\x1a\x1e.event.ListTicketTypesResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/events/{event_id}/ticket-types\x12u\n" +
+	"\x10UpdateTicketType\x12\x1e.event.UpdateTicketTypeRequest\x1a\x1f.event.UpdateTicketTypeResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/ticket-types/{id}\x12r\n" +
+	"\x10DeleteTicketType\x12\x1e.event.DeleteTicketTypeRequest\x1a\x1f.event.DeleteTicketTypeResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/ticket-types/{id}\x12k\n" +
+	"\fPublishEvent\x12\x1a.event.PublishEventRequest\x1a\x1b.event.PublishEventResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/events/{id}/publish\x12g\n" +
+	"\vCancelEvent\x12\x19.event.CancelEventRequest\x1a\x1a.event.CancelEventResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/events/{id}/cancel\x12o\n" +
+	"\rPostponeEvent\x12\x1b.event.PostponeEventRequest\x1a\x1c.event.PostponeEventResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/events/{id}/postpone\x12o\n" +
+	"\vSaveSeatMap\x12\x19.event.SaveSeatMapRequest\x1a\x1a.event.SaveSeatMapResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/events/{event_id}/seat-map\x12i\n" +
+	"\n" +
+	"GetSeatMap\x12\x18.event.GetSeatMapRequest\x1a\x19.event.GetSeatMapResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/events/{event_id}/seat-map\x12\x8f\x01\n" +
+	"\x14GeneratePresaleCodes\x12\".event.GeneratePresaleCodesRequest\x1a#.event.GeneratePresaleCodesResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/events/{event_id}/presale-codes\x12\x80\x01\n" +
+	"\x10ListPresaleCodes\x12\x1e.event.ListPresaleCodesRequest\x1a\x1f.event.ListPresaleCodesResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/events/{event_id}/presale-codes\x12\x94\x01\n" +
+	"\x11RevokePresaleCode\x12\x1f.event.RevokePresaleCodeRequest\x1a .event.RevokePresaleCodeResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/v1/events/{event_id}/presale-codes/{code}/revokeB9Z7github.com/doniiel/event-ticketing-platform/proto/eventb\x06proto3"
 
 var (
 	file_event_event_proto_rawDescOnce sync.Once
@@ -765,44 +3208,131 @@ func file_event_event_proto_rawDescGZIP() []byte {
 	return file_event_event_proto_rawDescData
 }
 
-var file_event_event_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_event_event_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_event_event_proto_goTypes = []any{
-	(*Event)(nil),                     // 0: event.Event
-	(*CreateEventRequest)(nil),        // 1: event.CreateEventRequest
-	(*CreateEventResponse)(nil),       // 2: event.CreateEventResponse
-	(*GetEventRequest)(nil),           // 3: event.GetEventRequest
-	(*GetEventResponse)(nil),          // 4: event.GetEventResponse
-	(*UpdateEventRequest)(nil),        // 5: event.UpdateEventRequest
-	(*UpdateEventResponse)(nil),       // 6: event.UpdateEventResponse
-	(*DeleteEventRequest)(nil),        // 7: event.DeleteEventRequest
-	(*DeleteEventResponse)(nil),       // 8: event.DeleteEventResponse
-	(*ListEventsRequest)(nil),         // 9: event.ListEventsRequest
-	(*ListEventsResponse)(nil),        // 10: event.ListEventsResponse
-	(*CheckAvailabilityRequest)(nil),  // 11: event.CheckAvailabilityRequest
-	(*CheckAvailabilityResponse)(nil), // 12: event.CheckAvailabilityResponse
+	(*Event)(nil),                        // 0: event.Event
+	(*TicketType)(nil),                   // 1: event.TicketType
+	(*CreateEventRequest)(nil),           // 2: event.CreateEventRequest
+	(*CreateEventResponse)(nil),          // 3: event.CreateEventResponse
+	(*GetEventRequest)(nil),              // 4: event.GetEventRequest
+	(*GetEventResponse)(nil),             // 5: event.GetEventResponse
+	(*UpdateEventRequest)(nil),           // 6: event.UpdateEventRequest
+	(*UpdateEventResponse)(nil),          // 7: event.UpdateEventResponse
+	(*DeleteEventRequest)(nil),           // 8: event.DeleteEventRequest
+	(*DeleteEventResponse)(nil),          // 9: event.DeleteEventResponse
+	(*ListEventsRequest)(nil),            // 10: event.ListEventsRequest
+	(*ListEventsResponse)(nil),           // 11: event.ListEventsResponse
+	(*CheckAvailabilityRequest)(nil),     // 12: event.CheckAvailabilityRequest
+	(*CheckAvailabilityResponse)(nil),    // 13: event.CheckAvailabilityResponse
+	(*StockReservation)(nil),             // 14: event.StockReservation
+	(*ReserveStockRequest)(nil),          // 15: event.ReserveStockRequest
+	(*ReserveStockResponse)(nil),         // 16: event.ReserveStockResponse
+	(*ReleaseStockRequest)(nil),          // 17: event.ReleaseStockRequest
+	(*ReleaseStockResponse)(nil),         // 18: event.ReleaseStockResponse
+	(*CommitStockRequest)(nil),           // 19: event.CommitStockRequest
+	(*CommitStockResponse)(nil),          // 20: event.CommitStockResponse
+	(*CreateTicketTypeRequest)(nil),      // 21: event.CreateTicketTypeRequest
+	(*CreateTicketTypeResponse)(nil),     // 22: event.CreateTicketTypeResponse
+	(*ListTicketTypesRequest)(nil),       // 23: event.ListTicketTypesRequest
+	(*ListTicketTypesResponse)(nil),      // 24: event.ListTicketTypesResponse
+	(*UpdateTicketTypeRequest)(nil),      // 25: event.UpdateTicketTypeRequest
+	(*UpdateTicketTypeResponse)(nil),     // 26: event.UpdateTicketTypeResponse
+	(*DeleteTicketTypeRequest)(nil),      // 27: event.DeleteTicketTypeRequest
+	(*DeleteTicketTypeResponse)(nil),     // 28: event.DeleteTicketTypeResponse
+	(*Seat)(nil),                         // 29: event.Seat
+	(*SeatMapRow)(nil),                   // 30: event.SeatMapRow
+	(*SeatMapSection)(nil),               // 31: event.SeatMapSection
+	(*SeatMap)(nil),                      // 32: event.SeatMap
+	(*PublishEventRequest)(nil),          // 33: event.PublishEventRequest
+	(*PublishEventResponse)(nil),         // 34: event.PublishEventResponse
+	(*CancelEventRequest)(nil),           // 35: event.CancelEventRequest
+	(*CancelEventResponse)(nil),          // 36: event.CancelEventResponse
+	(*PostponeEventRequest)(nil),         // 37: event.PostponeEventRequest
+	(*PostponeEventResponse)(nil),        // 38: event.PostponeEventResponse
+	(*SaveSeatMapRequest)(nil),           // 39: event.SaveSeatMapRequest
+	(*SaveSeatMapResponse)(nil),          // 40: event.SaveSeatMapResponse
+	(*GetSeatMapRequest)(nil),            // 41: event.GetSeatMapRequest
+	(*GetSeatMapResponse)(nil),           // 42: event.GetSeatMapResponse
+	(*PresaleCode)(nil),                  // 43: event.PresaleCode
+	(*GeneratePresaleCodesRequest)(nil),  // 44: event.GeneratePresaleCodesRequest
+	(*GeneratePresaleCodesResponse)(nil), // 45: event.GeneratePresaleCodesResponse
+	(*ListPresaleCodesRequest)(nil),      // 46: event.ListPresaleCodesRequest
+	(*ListPresaleCodesResponse)(nil),     // 47: event.ListPresaleCodesResponse
+	(*RevokePresaleCodeRequest)(nil),     // 48: event.RevokePresaleCodeRequest
+	(*RevokePresaleCodeResponse)(nil),    // 49: event.RevokePresaleCodeResponse
 }
 var file_event_event_proto_depIdxs = []int32{
-	0,  // 0: event.CreateEventResponse.event:type_name -> event.Event
-	0,  // 1: event.GetEventResponse.event:type_name -> event.Event
-	0,  // 2: event.UpdateEventResponse.event:type_name -> event.Event
-	0,  // 3: event.ListEventsResponse.events:type_name -> event.Event
-	1,  // 4: event.EventService.CreateEvent:input_type -> event.CreateEventRequest
-	3,  // 5: event.EventService.GetEvent:input_type -> event.GetEventRequest
-	5,  // 6: event.EventService.UpdateEvent:input_type -> event.UpdateEventRequest
-	7,  // 7: event.EventService.DeleteEvent:input_type -> event.DeleteEventRequest
-	9,  // 8: event.EventService.ListEvents:input_type -> event.ListEventsRequest
-	11, // 9: event.EventService.CheckAvailability:input_type -> event.CheckAvailabilityRequest
-	2,  // 10: event.EventService.CreateEvent:output_type -> event.CreateEventResponse
-	4,  // 11: event.EventService.GetEvent:output_type -> event.GetEventResponse
-	6,  // 12: event.EventService.UpdateEvent:output_type -> event.UpdateEventResponse
-	8,  // 13: event.EventService.DeleteEvent:output_type -> event.DeleteEventResponse
-	10, // 14: event.EventService.ListEvents:output_type -> event.ListEventsResponse
-	12, // 15: event.EventService.CheckAvailability:output_type -> event.CheckAvailabilityResponse
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 0: event.Event.ticket_types:type_name -> event.TicketType
+	1,  // 1: event.CreateEventRequest.ticket_types:type_name -> event.TicketType
+	0,  // 2: event.CreateEventResponse.event:type_name -> event.Event
+	0,  // 3: event.GetEventResponse.event:type_name -> event.Event
+	0,  // 4: event.UpdateEventResponse.event:type_name -> event.Event
+	0,  // 5: event.ListEventsResponse.events:type_name -> event.Event
+	14, // 6: event.ReserveStockResponse.reservation:type_name -> event.StockReservation
+	14, // 7: event.ReleaseStockResponse.reservation:type_name -> event.StockReservation
+	14, // 8: event.CommitStockResponse.reservation:type_name -> event.StockReservation
+	1,  // 9: event.CreateTicketTypeResponse.ticket_type:type_name -> event.TicketType
+	1,  // 10: event.ListTicketTypesResponse.ticket_types:type_name -> event.TicketType
+	1,  // 11: event.UpdateTicketTypeResponse.ticket_type:type_name -> event.TicketType
+	29, // 12: event.SeatMapRow.seats:type_name -> event.Seat
+	30, // 13: event.SeatMapSection.rows:type_name -> event.SeatMapRow
+	31, // 14: event.SeatMap.sections:type_name -> event.SeatMapSection
+	0,  // 15: event.PublishEventResponse.event:type_name -> event.Event
+	0,  // 16: event.CancelEventResponse.event:type_name -> event.Event
+	0,  // 17: event.PostponeEventResponse.event:type_name -> event.Event
+	31, // 18: event.SaveSeatMapRequest.sections:type_name -> event.SeatMapSection
+	32, // 19: event.SaveSeatMapResponse.seat_map:type_name -> event.SeatMap
+	32, // 20: event.GetSeatMapResponse.seat_map:type_name -> event.SeatMap
+	43, // 21: event.GeneratePresaleCodesResponse.codes:type_name -> event.PresaleCode
+	43, // 22: event.ListPresaleCodesResponse.codes:type_name -> event.PresaleCode
+	43, // 23: event.RevokePresaleCodeResponse.code:type_name -> event.PresaleCode
+	2,  // 24: event.EventService.CreateEvent:input_type -> event.CreateEventRequest
+	4,  // 25: event.EventService.GetEvent:input_type -> event.GetEventRequest
+	6,  // 26: event.EventService.UpdateEvent:input_type -> event.UpdateEventRequest
+	8,  // 27: event.EventService.DeleteEvent:input_type -> event.DeleteEventRequest
+	10, // 28: event.EventService.ListEvents:input_type -> event.ListEventsRequest
+	12, // 29: event.EventService.CheckAvailability:input_type -> event.CheckAvailabilityRequest
+	15, // 30: event.EventService.ReserveStock:input_type -> event.ReserveStockRequest
+	17, // 31: event.EventService.ReleaseStock:input_type -> event.ReleaseStockRequest
+	19, // 32: event.EventService.CommitStock:input_type -> event.CommitStockRequest
+	21, // 33: event.EventService.CreateTicketType:input_type -> event.CreateTicketTypeRequest
+	23, // 34: event.EventService.ListTicketTypes:input_type -> event.ListTicketTypesRequest
+	25, // 35: event.EventService.UpdateTicketType:input_type -> event.UpdateTicketTypeRequest
+	27, // 36: event.EventService.DeleteTicketType:input_type -> event.DeleteTicketTypeRequest
+	33, // 37: event.EventService.PublishEvent:input_type -> event.PublishEventRequest
+	35, // 38: event.EventService.CancelEvent:input_type -> event.CancelEventRequest
+	37, // 39: event.EventService.PostponeEvent:input_type -> event.PostponeEventRequest
+	39, // 40: event.EventService.SaveSeatMap:input_type -> event.SaveSeatMapRequest
+	41, // 41: event.EventService.GetSeatMap:input_type -> event.GetSeatMapRequest
+	44, // 42: event.EventService.GeneratePresaleCodes:input_type -> event.GeneratePresaleCodesRequest
+	46, // 43: event.EventService.ListPresaleCodes:input_type -> event.ListPresaleCodesRequest
+	48, // 44: event.EventService.RevokePresaleCode:input_type -> event.RevokePresaleCodeRequest
+	3,  // 45: event.EventService.CreateEvent:output_type -> event.CreateEventResponse
+	5,  // 46: event.EventService.GetEvent:output_type -> event.GetEventResponse
+	7,  // 47: event.EventService.UpdateEvent:output_type -> event.UpdateEventResponse
+	9,  // 48: event.EventService.DeleteEvent:output_type -> event.DeleteEventResponse
+	11, // 49: event.EventService.ListEvents:output_type -> event.ListEventsResponse
+	13, // 50: event.EventService.CheckAvailability:output_type -> event.CheckAvailabilityResponse
+	16, // 51: event.EventService.ReserveStock:output_type -> event.ReserveStockResponse
+	18, // 52: event.EventService.ReleaseStock:output_type -> event.ReleaseStockResponse
+	20, // 53: event.EventService.CommitStock:output_type -> event.CommitStockResponse
+	22, // 54: event.EventService.CreateTicketType:output_type -> event.CreateTicketTypeResponse
+	24, // 55: event.EventService.ListTicketTypes:output_type -> event.ListTicketTypesResponse
+	26, // 56: event.EventService.UpdateTicketType:output_type -> event.UpdateTicketTypeResponse
+	28, // 57: event.EventService.DeleteTicketType:output_type -> event.DeleteTicketTypeResponse
+	34, // 58: event.EventService.PublishEvent:output_type -> event.PublishEventResponse
+	36, // 59: event.EventService.CancelEvent:output_type -> event.CancelEventResponse
+	38, // 60: event.EventService.PostponeEvent:output_type -> event.PostponeEventResponse
+	40, // 61: event.EventService.SaveSeatMap:output_type -> event.SaveSeatMapResponse
+	42, // 62: event.EventService.GetSeatMap:output_type -> event.GetSeatMapResponse
+	45, // 63: event.EventService.GeneratePresaleCodes:output_type -> event.GeneratePresaleCodesResponse
+	47, // 64: event.EventService.ListPresaleCodes:output_type -> event.ListPresaleCodesResponse
+	49, // 65: event.EventService.RevokePresaleCode:output_type -> event.RevokePresaleCodeResponse
+	45, // [45:66] is the sub-list for method output_type
+	24, // [24:45] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_event_event_proto_init() }
@@ -810,13 +3340,14 @@ func file_event_event_proto_init() {
 	if File_event_event_proto != nil {
 		return
 	}
+	file_event_event_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_event_proto_rawDesc), len(file_event_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventService_CreateEvent_FullMethodName       = "/event.EventService/CreateEvent"
-	EventService_GetEvent_FullMethodName          = "/event.EventService/GetEvent"
-	EventService_UpdateEvent_FullMethodName       = "/event.EventService/UpdateEvent"
-	EventService_DeleteEvent_FullMethodName       = "/event.EventService/DeleteEvent"
-	EventService_ListEvents_FullMethodName        = "/event.EventService/ListEvents"
-	EventService_CheckAvailability_FullMethodName = "/event.EventService/CheckAvailability"
+	EventService_CreateEvent_FullMethodName          = "/event.EventService/CreateEvent"
+	EventService_GetEvent_FullMethodName             = "/event.EventService/GetEvent"
+	EventService_UpdateEvent_FullMethodName          = "/event.EventService/UpdateEvent"
+	EventService_DeleteEvent_FullMethodName          = "/event.EventService/DeleteEvent"
+	EventService_ListEvents_FullMethodName           = "/event.EventService/ListEvents"
+	EventService_CheckAvailability_FullMethodName    = "/event.EventService/CheckAvailability"
+	EventService_ReserveStock_FullMethodName         = "/event.EventService/ReserveStock"
+	EventService_ReleaseStock_FullMethodName         = "/event.EventService/ReleaseStock"
+	EventService_CommitStock_FullMethodName          = "/event.EventService/CommitStock"
+	EventService_CreateTicketType_FullMethodName     = "/event.EventService/CreateTicketType"
+	EventService_ListTicketTypes_FullMethodName      = "/event.EventService/ListTicketTypes"
+	EventService_UpdateTicketType_FullMethodName     = "/event.EventService/UpdateTicketType"
+	EventService_DeleteTicketType_FullMethodName     = "/event.EventService/DeleteTicketType"
+	EventService_PublishEvent_FullMethodName         = "/event.EventService/PublishEvent"
+	EventService_CancelEvent_FullMethodName          = "/event.EventService/CancelEvent"
+	EventService_PostponeEvent_FullMethodName        = "/event.EventService/PostponeEvent"
+	EventService_SaveSeatMap_FullMethodName          = "/event.EventService/SaveSeatMap"
+	EventService_GetSeatMap_FullMethodName           = "/event.EventService/GetSeatMap"
+	EventService_GeneratePresaleCodes_FullMethodName = "/event.EventService/GeneratePresaleCodes"
+	EventService_ListPresaleCodes_FullMethodName     = "/event.EventService/ListPresaleCodes"
+	EventService_RevokePresaleCode_FullMethodName    = "/event.EventService/RevokePresaleCode"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -37,6 +52,21 @@ type EventServiceClient interface {
 	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*DeleteEventResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	CheckAvailability(ctx context.Context, in *CheckAvailabilityRequest, opts ...grpc.CallOption) (*CheckAvailabilityResponse, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error)
+	ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error)
+	CommitStock(ctx context.Context, in *CommitStockRequest, opts ...grpc.CallOption) (*CommitStockResponse, error)
+	CreateTicketType(ctx context.Context, in *CreateTicketTypeRequest, opts ...grpc.CallOption) (*CreateTicketTypeResponse, error)
+	ListTicketTypes(ctx context.Context, in *ListTicketTypesRequest, opts ...grpc.CallOption) (*ListTicketTypesResponse, error)
+	UpdateTicketType(ctx context.Context, in *UpdateTicketTypeRequest, opts ...grpc.CallOption) (*UpdateTicketTypeResponse, error)
+	DeleteTicketType(ctx context.Context, in *DeleteTicketTypeRequest, opts ...grpc.CallOption) (*DeleteTicketTypeResponse, error)
+	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error)
+	CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error)
+	PostponeEvent(ctx context.Context, in *PostponeEventRequest, opts ...grpc.CallOption) (*PostponeEventResponse, error)
+	SaveSeatMap(ctx context.Context, in *SaveSeatMapRequest, opts ...grpc.CallOption) (*SaveSeatMapResponse, error)
+	GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error)
+	GeneratePresaleCodes(ctx context.Context, in *GeneratePresaleCodesRequest, opts ...grpc.CallOption) (*GeneratePresaleCodesResponse, error)
+	ListPresaleCodes(ctx context.Context, in *ListPresaleCodesRequest, opts ...grpc.CallOption) (*ListPresaleCodesResponse, error)
+	RevokePresaleCode(ctx context.Context, in *RevokePresaleCodeRequest, opts ...grpc.CallOption) (*RevokePresaleCodeResponse, error)
 }
 
 type eventServiceClient struct {
@@ -107,6 +137,156 @@ func (c *eventServiceClient) CheckAvailability(ctx context.Context, in *CheckAva
 	return out, nil
 }
 
+func (c *eventServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveStockResponse)
+	err := c.cc.Invoke(ctx, EventService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseStockResponse)
+	err := c.cc.Invoke(ctx, EventService_ReleaseStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CommitStock(ctx context.Context, in *CommitStockRequest, opts ...grpc.CallOption) (*CommitStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitStockResponse)
+	err := c.cc.Invoke(ctx, EventService_CommitStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateTicketType(ctx context.Context, in *CreateTicketTypeRequest, opts ...grpc.CallOption) (*CreateTicketTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTicketTypeResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListTicketTypes(ctx context.Context, in *ListTicketTypesRequest, opts ...grpc.CallOption) (*ListTicketTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketTypesResponse)
+	err := c.cc.Invoke(ctx, EventService_ListTicketTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateTicketType(ctx context.Context, in *UpdateTicketTypeRequest, opts ...grpc.CallOption) (*UpdateTicketTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTicketTypeResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdateTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteTicketType(ctx context.Context, in *DeleteTicketTypeRequest, opts ...grpc.CallOption) (*DeleteTicketTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTicketTypeResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishEventResponse)
+	err := c.cc.Invoke(ctx, EventService_PublishEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelEventResponse)
+	err := c.cc.Invoke(ctx, EventService_CancelEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) PostponeEvent(ctx context.Context, in *PostponeEventRequest, opts ...grpc.CallOption) (*PostponeEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostponeEventResponse)
+	err := c.cc.Invoke(ctx, EventService_PostponeEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SaveSeatMap(ctx context.Context, in *SaveSeatMapRequest, opts ...grpc.CallOption) (*SaveSeatMapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveSeatMapResponse)
+	err := c.cc.Invoke(ctx, EventService_SaveSeatMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeatMapResponse)
+	err := c.cc.Invoke(ctx, EventService_GetSeatMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GeneratePresaleCodes(ctx context.Context, in *GeneratePresaleCodesRequest, opts ...grpc.CallOption) (*GeneratePresaleCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePresaleCodesResponse)
+	err := c.cc.Invoke(ctx, EventService_GeneratePresaleCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListPresaleCodes(ctx context.Context, in *ListPresaleCodesRequest, opts ...grpc.CallOption) (*ListPresaleCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPresaleCodesResponse)
+	err := c.cc.Invoke(ctx, EventService_ListPresaleCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RevokePresaleCode(ctx context.Context, in *RevokePresaleCodeRequest, opts ...grpc.CallOption) (*RevokePresaleCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokePresaleCodeResponse)
+	err := c.cc.Invoke(ctx, EventService_RevokePresaleCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -117,6 +297,21 @@ type EventServiceServer interface {
 	DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error)
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error)
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+	ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error)
+	CommitStock(context.Context, *CommitStockRequest) (*CommitStockResponse, error)
+	CreateTicketType(context.Context, *CreateTicketTypeRequest) (*CreateTicketTypeResponse, error)
+	ListTicketTypes(context.Context, *ListTicketTypesRequest) (*ListTicketTypesResponse, error)
+	UpdateTicketType(context.Context, *UpdateTicketTypeRequest) (*UpdateTicketTypeResponse, error)
+	DeleteTicketType(context.Context, *DeleteTicketTypeRequest) (*DeleteTicketTypeResponse, error)
+	PublishEvent(context.Context, *PublishEventRequest) (*PublishEventResponse, error)
+	CancelEvent(context.Context, *CancelEventRequest) (*CancelEventResponse, error)
+	PostponeEvent(context.Context, *PostponeEventRequest) (*PostponeEventResponse, error)
+	SaveSeatMap(context.Context, *SaveSeatMapRequest) (*SaveSeatMapResponse, error)
+	GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error)
+	GeneratePresaleCodes(context.Context, *GeneratePresaleCodesRequest) (*GeneratePresaleCodesResponse, error)
+	ListPresaleCodes(context.Context, *ListPresaleCodesRequest) (*ListPresaleCodesResponse, error)
+	RevokePresaleCode(context.Context, *RevokePresaleCodeRequest) (*RevokePresaleCodeResponse, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -145,6 +340,51 @@ func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRe
 func (UnimplementedEventServiceServer) CheckAvailability(context.Context, *CheckAvailabilityRequest) (*CheckAvailabilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAvailability not implemented")
 }
+func (UnimplementedEventServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedEventServiceServer) ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseStock not implemented")
+}
+func (UnimplementedEventServiceServer) CommitStock(context.Context, *CommitStockRequest) (*CommitStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitStock not implemented")
+}
+func (UnimplementedEventServiceServer) CreateTicketType(context.Context, *CreateTicketTypeRequest) (*CreateTicketTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTicketType not implemented")
+}
+func (UnimplementedEventServiceServer) ListTicketTypes(context.Context, *ListTicketTypesRequest) (*ListTicketTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketTypes not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateTicketType(context.Context, *UpdateTicketTypeRequest) (*UpdateTicketTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTicketType not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteTicketType(context.Context, *DeleteTicketTypeRequest) (*DeleteTicketTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTicketType not implemented")
+}
+func (UnimplementedEventServiceServer) PublishEvent(context.Context, *PublishEventRequest) (*PublishEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CancelEvent(context.Context, *CancelEventRequest) (*CancelEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEvent not implemented")
+}
+func (UnimplementedEventServiceServer) PostponeEvent(context.Context, *PostponeEventRequest) (*PostponeEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostponeEvent not implemented")
+}
+func (UnimplementedEventServiceServer) SaveSeatMap(context.Context, *SaveSeatMapRequest) (*SaveSeatMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSeatMap not implemented")
+}
+func (UnimplementedEventServiceServer) GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeatMap not implemented")
+}
+func (UnimplementedEventServiceServer) GeneratePresaleCodes(context.Context, *GeneratePresaleCodesRequest) (*GeneratePresaleCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePresaleCodes not implemented")
+}
+func (UnimplementedEventServiceServer) ListPresaleCodes(context.Context, *ListPresaleCodesRequest) (*ListPresaleCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPresaleCodes not implemented")
+}
+func (UnimplementedEventServiceServer) RevokePresaleCode(context.Context, *RevokePresaleCodeRequest) (*RevokePresaleCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePresaleCode not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -274,6 +514,276 @@ func _EventService_CheckAvailability_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReleaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReleaseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ReleaseStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReleaseStock(ctx, req.(*ReleaseStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CommitStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CommitStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CommitStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CommitStock(ctx, req.(*CommitStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateTicketType(ctx, req.(*CreateTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListTicketTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListTicketTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListTicketTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListTicketTypes(ctx, req.(*ListTicketTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateTicketType(ctx, req.(*UpdateTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteTicketType(ctx, req.(*DeleteTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_PublishEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).PublishEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_PublishEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).PublishEvent(ctx, req.(*PublishEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelEvent(ctx, req.(*CancelEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_PostponeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostponeEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).PostponeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_PostponeEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).PostponeEvent(ctx, req.(*PostponeEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SaveSeatMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSeatMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SaveSeatMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SaveSeatMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SaveSeatMap(ctx, req.(*SaveSeatMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetSeatMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetSeatMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetSeatMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetSeatMap(ctx, req.(*GetSeatMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GeneratePresaleCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePresaleCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GeneratePresaleCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GeneratePresaleCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GeneratePresaleCodes(ctx, req.(*GeneratePresaleCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListPresaleCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPresaleCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListPresaleCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListPresaleCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListPresaleCodes(ctx, req.(*ListPresaleCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RevokePresaleCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePresaleCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RevokePresaleCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RevokePresaleCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RevokePresaleCode(ctx, req.(*RevokePresaleCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +815,66 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckAvailability",
 			Handler:    _EventService_CheckAvailability_Handler,
 		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _EventService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ReleaseStock",
+			Handler:    _EventService_ReleaseStock_Handler,
+		},
+		{
+			MethodName: "CommitStock",
+			Handler:    _EventService_CommitStock_Handler,
+		},
+		{
+			MethodName: "CreateTicketType",
+			Handler:    _EventService_CreateTicketType_Handler,
+		},
+		{
+			MethodName: "ListTicketTypes",
+			Handler:    _EventService_ListTicketTypes_Handler,
+		},
+		{
+			MethodName: "UpdateTicketType",
+			Handler:    _EventService_UpdateTicketType_Handler,
+		},
+		{
+			MethodName: "DeleteTicketType",
+			Handler:    _EventService_DeleteTicketType_Handler,
+		},
+		{
+			MethodName: "PublishEvent",
+			Handler:    _EventService_PublishEvent_Handler,
+		},
+		{
+			MethodName: "CancelEvent",
+			Handler:    _EventService_CancelEvent_Handler,
+		},
+		{
+			MethodName: "PostponeEvent",
+			Handler:    _EventService_PostponeEvent_Handler,
+		},
+		{
+			MethodName: "SaveSeatMap",
+			Handler:    _EventService_SaveSeatMap_Handler,
+		},
+		{
+			MethodName: "GetSeatMap",
+			Handler:    _EventService_GetSeatMap_Handler,
+		},
+		{
+			MethodName: "GeneratePresaleCodes",
+			Handler:    _EventService_GeneratePresaleCodes_Handler,
+		},
+		{
+			MethodName: "ListPresaleCodes",
+			Handler:    _EventService_ListPresaleCodes_Handler,
+		},
+		{
+			MethodName: "RevokePresaleCode",
+			Handler:    _EventService_RevokePresaleCode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event/event.proto",
@@ -7,9 +7,11 @@
 package ticket
 
 import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,11 +25,39 @@ const (
 )
 
 type Ticket struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId   string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Quantity  int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Prices are in minor units of currency (e.g. cents for USD).
+	UnitPrice    int64    `protobuf:"varint,9,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	TotalPrice   int64    `protobuf:"varint,10,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Currency     string   `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	TicketTypeId string   `protobuf:"bytes,12,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	SeatIds      []string `protobuf:"bytes,13,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Taken off the price of the tickets by promo_codes; total_price is after
+	// the discount.
+	Discount   int64    `protobuf:"varint,14,opt,name=discount,proto3" json:"discount,omitempty"`
+	PromoCodes []string `protobuf:"bytes,15,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	// What the buyer was charged, line by line; total_price equals its total.
+	Breakdown *PriceBreakdown `protobuf:"bytes,16,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	// The order the ticket was bought in. Tickets bought in one order each
+	// admit one person and carry their share of the order's price.
+	OrderId string `protobuf:"bytes,17,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// When and at which gate the ticket was first scanned in, and how many
+	// times it has been let in; set once the ticket is USED.
+	CheckedInAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	GateId      string                 `protobuf:"bytes,19,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	Entries     int32                  `protobuf:"varint,20,opt,name=entries,proto3" json:"entries,omitempty"`
+	LastEntryAt *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=last_entry_at,json=lastEntryAt,proto3" json:"last_entry_at,omitempty"`
+	// Every accepted transfer of the ticket, oldest first; user_id is the
+	// current holder.
+	Transfers     []*TicketTransferRecord `protobuf:"bytes,22,rep,name=transfers,proto3" json:"transfers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,29 +120,159 @@ func (x *Ticket) GetStatus() string {
 	return ""
 }
 
-type PurchaseTicketRequest struct {
+func (x *Ticket) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Ticket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *Ticket) GetTotalPrice() int64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Ticket) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Ticket) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *Ticket) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *Ticket) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Ticket) GetPromoCodes() []string {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+func (x *Ticket) GetBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *Ticket) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Ticket) GetCheckedInAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *Ticket) GetEntries() int32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *Ticket) GetLastEntryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastEntryAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetTransfers() []*TicketTransferRecord {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+// TicketTransferRecord is one change of a ticket's holder.
+type TicketTransferRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	FromUserId    string                 `protobuf:"bytes,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	TransferredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=transferred_at,json=transferredAt,proto3" json:"transferred_at,omitempty"`
+	// Set instead of transfer_id when the ticket was bought on resale.
+	ListingId     string `protobuf:"bytes,5,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurchaseTicketRequest) Reset() {
-	*x = PurchaseTicketRequest{}
+func (x *TicketTransferRecord) Reset() {
+	*x = TicketTransferRecord{}
 	mi := &file_ticket_ticket_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurchaseTicketRequest) String() string {
+func (x *TicketTransferRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurchaseTicketRequest) ProtoMessage() {}
+func (*TicketTransferRecord) ProtoMessage() {}
 
-func (x *PurchaseTicketRequest) ProtoReflect() protoreflect.Message {
+func (x *TicketTransferRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_ticket_ticket_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -124,53 +284,79 @@ func (x *PurchaseTicketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurchaseTicketRequest.ProtoReflect.Descriptor instead.
-func (*PurchaseTicketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TicketTransferRecord.ProtoReflect.Descriptor instead.
+func (*TicketTransferRecord) Descriptor() ([]byte, []int) {
 	return file_ticket_ticket_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *PurchaseTicketRequest) GetEventId() string {
+func (x *TicketTransferRecord) GetTransferId() string {
 	if x != nil {
-		return x.EventId
+		return x.TransferId
 	}
 	return ""
 }
 
-func (x *PurchaseTicketRequest) GetUserId() string {
+func (x *TicketTransferRecord) GetFromUserId() string {
 	if x != nil {
-		return x.UserId
+		return x.FromUserId
 	}
 	return ""
 }
 
-func (x *PurchaseTicketRequest) GetQuantity() int32 {
+func (x *TicketTransferRecord) GetToUserId() string {
 	if x != nil {
-		return x.Quantity
+		return x.ToUserId
 	}
-	return 0
+	return ""
 }
 
-type PurchaseTicketResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ticket        *Ticket                `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+func (x *TicketTransferRecord) GetTransferredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransferredAt
+	}
+	return nil
+}
+
+func (x *TicketTransferRecord) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+// PriceBreakdown splits the price of an order into its parts, in minor units
+// of an ISO 4217 currency. Fees are charged on the discounted base and tax on
+// the discounted base plus fees.
+type PriceBreakdown struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Base            int64                  `protobuf:"varint,2,opt,name=base,proto3" json:"base,omitempty"`
+	Discount        int64                  `protobuf:"varint,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	ServiceFee      int64                  `protobuf:"varint,4,opt,name=service_fee,json=serviceFee,proto3" json:"service_fee,omitempty"`
+	FacilityFee     int64                  `protobuf:"varint,5,opt,name=facility_fee,json=facilityFee,proto3" json:"facility_fee,omitempty"`
+	Tax             int64                  `protobuf:"varint,6,opt,name=tax,proto3" json:"tax,omitempty"`
+	Total           int64                  `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	TaxJurisdiction string                 `protobuf:"bytes,8,opt,name=tax_jurisdiction,json=taxJurisdiction,proto3" json:"tax_jurisdiction,omitempty"`
+	// Tax rate in basis points (1/100 of a percent).
+	TaxRateBps    int32 `protobuf:"varint,9,opt,name=tax_rate_bps,json=taxRateBps,proto3" json:"tax_rate_bps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurchaseTicketResponse) Reset() {
-	*x = PurchaseTicketResponse{}
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
 	mi := &file_ticket_ticket_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurchaseTicketResponse) String() string {
+func (x *PriceBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurchaseTicketResponse) ProtoMessage() {}
+func (*PriceBreakdown) ProtoMessage() {}
 
-func (x *PurchaseTicketResponse) ProtoReflect() protoreflect.Message {
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
 	mi := &file_ticket_ticket_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -182,39 +368,110 @@ func (x *PurchaseTicketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurchaseTicketResponse.ProtoReflect.Descriptor instead.
-func (*PurchaseTicketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
 	return file_ticket_ticket_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PurchaseTicketResponse) GetTicket() *Ticket {
+func (x *PriceBreakdown) GetCurrency() string {
 	if x != nil {
-		return x.Ticket
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-type GetTicketRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *PriceBreakdown) GetBase() int64 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetServiceFee() int64 {
+	if x != nil {
+		return x.ServiceFee
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetFacilityFee() int64 {
+	if x != nil {
+		return x.FacilityFee
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTax() int64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTaxJurisdiction() string {
+	if x != nil {
+		return x.TaxJurisdiction
+	}
+	return ""
+}
+
+func (x *PriceBreakdown) GetTaxRateBps() int32 {
+	if x != nil {
+		return x.TaxRateBps
+	}
+	return 0
+}
+
+type PurchaseTicketRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EventId  string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId   string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Quantity int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Optional. May also be sent as the Idempotency-Key HTTP header.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Opaque payment method token passed to the payment provider.
+	PaymentMethod string `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// Required for events that have ticket types; sets the price paid.
+	TicketTypeId string `protobuf:"bytes,6,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	// Required for events with reserved seating, one per ticket. quantity may
+	// be left out when seats are given.
+	SeatIds []string `protobuf:"bytes,7,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Presale access code, required while the event is in its presale.
+	AccessCode string `protobuf:"bytes,8,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
+	// Promo codes to apply, in order. Only stackable codes can be combined.
+	PromoCodes    []string `protobuf:"bytes,9,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTicketRequest) Reset() {
-	*x = GetTicketRequest{}
+func (x *PurchaseTicketRequest) Reset() {
+	*x = PurchaseTicketRequest{}
 	mi := &file_ticket_ticket_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTicketRequest) String() string {
+func (x *PurchaseTicketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTicketRequest) ProtoMessage() {}
+func (*PurchaseTicketRequest) ProtoMessage() {}
 
-func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
+func (x *PurchaseTicketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ticket_ticket_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -226,39 +483,99 @@ func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
-func (*GetTicketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurchaseTicketRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseTicketRequest) Descriptor() ([]byte, []int) {
 	return file_ticket_ticket_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetTicketRequest) GetId() string {
+func (x *PurchaseTicketRequest) GetEventId() string {
 	if x != nil {
-		return x.Id
+		return x.EventId
 	}
 	return ""
 }
 
-type GetTicketResponse struct {
+func (x *PurchaseTicketRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PurchaseTicketRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseTicketRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PurchaseTicketRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *PurchaseTicketRequest) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *PurchaseTicketRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *PurchaseTicketRequest) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+func (x *PurchaseTicketRequest) GetPromoCodes() []string {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+// PurchaseTicketResponse is the order placed for the purchase. ticket is the
+// first of its tickets.
+type PurchaseTicketResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ticket        *Ticket                `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Tickets       []*Ticket              `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	Order         *Order                 `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTicketResponse) Reset() {
-	*x = GetTicketResponse{}
+func (x *PurchaseTicketResponse) Reset() {
+	*x = PurchaseTicketResponse{}
 	mi := &file_ticket_ticket_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTicketResponse) String() string {
+func (x *PurchaseTicketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTicketResponse) ProtoMessage() {}
+func (*PurchaseTicketResponse) ProtoMessage() {}
 
-func (x *GetTicketResponse) ProtoReflect() protoreflect.Message {
+func (x *PurchaseTicketResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ticket_ticket_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -270,41 +587,7258 @@ func (x *GetTicketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTicketResponse.ProtoReflect.Descriptor instead.
-func (*GetTicketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurchaseTicketResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseTicketResponse) Descriptor() ([]byte, []int) {
 	return file_ticket_ticket_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetTicketResponse) GetTicket() *Ticket {
+func (x *PurchaseTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *PurchaseTicketResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *PurchaseTicketResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketRequest) Reset() {
+	*x = GetTicketRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketRequest) ProtoMessage() {}
+
+func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTicketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *Ticket                `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketResponse) Reset() {
+	*x = GetTicketResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketResponse) ProtoMessage() {}
+
+func (x *GetTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type ListTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsRequest) Reset() {
+	*x = ListTicketsRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsRequest) ProtoMessage() {}
+
+func (x *ListTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTicketsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTicketsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListTicketsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListTicketsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTicketsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTicketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tickets       []*Ticket              `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketsResponse) Reset() {
+	*x = ListTicketsResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsResponse) ProtoMessage() {}
+
+func (x *ListTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTicketsResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *ListTicketsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ConfirmTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTicketRequest) Reset() {
+	*x = ConfirmTicketRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTicketRequest) ProtoMessage() {}
+
+func (x *ConfirmTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTicketRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConfirmTicketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ConfirmTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *Ticket                `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTicketResponse) Reset() {
+	*x = ConfirmTicketResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTicketResponse) ProtoMessage() {}
+
+func (x *ConfirmTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTicketResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTicketResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfirmTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type CancelTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTicketRequest) Reset() {
+	*x = CancelTicketRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTicketRequest) ProtoMessage() {}
+
+func (x *CancelTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTicketRequest.ProtoReflect.Descriptor instead.
+func (*CancelTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelTicketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *Ticket                `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTicketResponse) Reset() {
+	*x = CancelTicketResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTicketResponse) ProtoMessage() {}
+
+func (x *CancelTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTicketResponse.ProtoReflect.Descriptor instead.
+func (*CancelTicketResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type RefundTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundTicketRequest) Reset() {
+	*x = RefundTicketRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTicketRequest) ProtoMessage() {}
+
+func (x *RefundTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTicketRequest.ProtoReflect.Descriptor instead.
+func (*RefundTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RefundTicketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RefundTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *Ticket                `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundTicketResponse) Reset() {
+	*x = RefundTicketResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTicketResponse) ProtoMessage() {}
+
+func (x *RefundTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTicketResponse.ProtoReflect.Descriptor instead.
+func (*RefundTicketResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RefundTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type GetCancellationJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCancellationJobRequest) Reset() {
+	*x = GetCancellationJobRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCancellationJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancellationJobRequest) ProtoMessage() {}
+
+func (x *GetCancellationJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancellationJobRequest.ProtoReflect.Descriptor instead.
+func (*GetCancellationJobRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCancellationJobRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetCancellationJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *CancellationJob       `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCancellationJobResponse) Reset() {
+	*x = GetCancellationJobResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCancellationJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancellationJobResponse) ProtoMessage() {}
+
+func (x *GetCancellationJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancellationJobResponse.ProtoReflect.Descriptor instead.
+func (*GetCancellationJobResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCancellationJobResponse) GetJob() *CancellationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// Order is a purchase of one or more tickets, possibly for several events,
+// paid with a single payment. Amounts are in minor units of currency.
+type Order struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// PENDING, CONFIRMED or FAILED.
+	Status     string       `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Items      []*OrderItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	PromoCodes []string     `protobuf:"bytes,5,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	Currency   string       `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The sum of the breakdowns of the items.
+	Breakdown  *PriceBreakdown `protobuf:"bytes,7,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	TotalPrice int64           `protobuf:"varint,8,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	// The payment provider's reference for the order's payment.
+	PaymentReference string                 `protobuf:"bytes,9,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+	FailureReason    string                 `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Set when the order bought a resale listing.
+	ListingId     string `protobuf:"bytes,13,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_ticket_ticket_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetPromoCodes() []string {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+func (x *Order) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Order) GetBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *Order) GetTotalPrice() int64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *Order) GetPaymentReference() string {
+	if x != nil {
+		return x.PaymentReference
+	}
+	return ""
+}
+
+func (x *Order) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+// OrderItem is a number of tickets of one tier of an event.
+type OrderItem struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	EventId      string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TicketTypeId string                 `protobuf:"bytes,2,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Quantity     int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SeatIds      []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	UnitPrice    int64                  `protobuf:"varint,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Currency     string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Discount     int64                  `protobuf:"varint,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	Breakdown    *PriceBreakdown        `protobuf:"bytes,8,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	// One ticket per admitted person.
+	TicketIds     []string `protobuf:"bytes,9,rep,name=ticket_ids,json=ticketIds,proto3" json:"ticket_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_ticket_ticket_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OrderItem) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *OrderItem) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *OrderItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *OrderItem) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *OrderItem) GetBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *OrderItem) GetTicketIds() []string {
+	if x != nil {
+		return x.TicketIds
+	}
+	return nil
+}
+
+type CreateOrderRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Items  []*CreateOrderItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// Promo codes to apply, in order. Each applies to the items it covers.
+	PromoCodes []string `protobuf:"bytes,3,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	// Opaque payment method token passed to the payment provider.
+	PaymentMethod string `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// Optional. May also be sent as the Idempotency-Key HTTP header.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetItems() []*CreateOrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetPromoCodes() []string {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CreateOrderItem struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Required for events that have ticket types; sets the price paid.
+	TicketTypeId string `protobuf:"bytes,2,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Quantity     int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Required for events with reserved seating, one per ticket. quantity may
+	// be left out when seats are given.
+	SeatIds []string `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Presale access code, required while the event is in its presale.
+	AccessCode    string `protobuf:"bytes,5,opt,name=access_code,json=accessCode,proto3" json:"access_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderItem) Reset() {
+	*x = CreateOrderItem{}
+	mi := &file_ticket_ticket_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderItem) ProtoMessage() {}
+
+func (x *CreateOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderItem.ProtoReflect.Descriptor instead.
+func (*CreateOrderItem) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateOrderItem) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateOrderItem) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *CreateOrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateOrderItem) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *CreateOrderItem) GetAccessCode() string {
+	if x != nil {
+		return x.AccessCode
+	}
+	return ""
+}
+
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Tickets       []*Ticket              `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *CreateOrderResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Tickets       []*Ticket              `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *GetOrderResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetTicketCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// PNG (the default) or SVG.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Width and height of the image in pixels; 256 when left out.
+	Size          int32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketCodeRequest) Reset() {
+	*x = GetTicketCodeRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketCodeRequest) ProtoMessage() {}
+
+func (x *GetTicketCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketCodeRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTicketCodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTicketCodeRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetTicketCodeRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// GetTicketCodeResponse is a signed token for a confirmed ticket and a QR
+// code of it for the door. The token can be verified offline with the
+// published signing keys.
+type GetTicketCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Image         []byte                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	KeyId         string                 `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketCodeResponse) Reset() {
+	*x = GetTicketCodeResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketCodeResponse) ProtoMessage() {}
+
+func (x *GetTicketCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketCodeResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTicketCodeResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetTicketCodeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetTicketCodeResponse) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *GetTicketCodeResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *GetTicketCodeResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// TicketSigningKey is a public key that ticket tokens are signed with. The
+// ACTIVE key signs new tokens; RETIRED keys still verify tokens signed before
+// they were rotated out and REVOKED keys verify nothing.
+type TicketSigningKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Always Ed25519.
+	Algorithm     string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	PublicKey     []byte                 `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RetiredAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=retired_at,json=retiredAt,proto3" json:"retired_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketSigningKey) Reset() {
+	*x = TicketSigningKey{}
+	mi := &file_ticket_ticket_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketSigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketSigningKey) ProtoMessage() {}
+
+func (x *TicketSigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketSigningKey.ProtoReflect.Descriptor instead.
+func (*TicketSigningKey) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TicketSigningKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketSigningKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *TicketSigningKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *TicketSigningKey) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TicketSigningKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TicketSigningKey) GetRetiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetiredAt
+	}
+	return nil
+}
+
+type RotateTicketSigningKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateTicketSigningKeyRequest) Reset() {
+	*x = RotateTicketSigningKeyRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTicketSigningKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTicketSigningKeyRequest) ProtoMessage() {}
+
+func (x *RotateTicketSigningKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTicketSigningKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateTicketSigningKeyRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{29}
+}
+
+type RotateTicketSigningKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *TicketSigningKey      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateTicketSigningKeyResponse) Reset() {
+	*x = RotateTicketSigningKeyResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTicketSigningKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTicketSigningKeyResponse) ProtoMessage() {}
+
+func (x *RotateTicketSigningKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTicketSigningKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateTicketSigningKeyResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RotateTicketSigningKeyResponse) GetKey() *TicketSigningKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type ListTicketSigningKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketSigningKeysRequest) Reset() {
+	*x = ListTicketSigningKeysRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketSigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketSigningKeysRequest) ProtoMessage() {}
+
+func (x *ListTicketSigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketSigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketSigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{31}
+}
+
+// ListTicketSigningKeysResponse lists the keys scanners should trust: the
+// active key and every retired one.
+type ListTicketSigningKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*TicketSigningKey    `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketSigningKeysResponse) Reset() {
+	*x = ListTicketSigningKeysResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketSigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketSigningKeysResponse) ProtoMessage() {}
+
+func (x *ListTicketSigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketSigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketSigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListTicketSigningKeysResponse) GetKeys() []*TicketSigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RevokeTicketSigningKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTicketSigningKeyRequest) Reset() {
+	*x = RevokeTicketSigningKeyRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTicketSigningKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTicketSigningKeyRequest) ProtoMessage() {}
+
+func (x *RevokeTicketSigningKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTicketSigningKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTicketSigningKeyRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RevokeTicketSigningKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeTicketSigningKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *TicketSigningKey      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTicketSigningKeyResponse) Reset() {
+	*x = RevokeTicketSigningKeyResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTicketSigningKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTicketSigningKeyResponse) ProtoMessage() {}
+
+func (x *RevokeTicketSigningKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTicketSigningKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTicketSigningKeyResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RevokeTicketSigningKeyResponse) GetKey() *TicketSigningKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// CheckIn is the outcome of one scan of a ticket code at a gate.
+type CheckIn struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Chosen by the scanner so a scan uploaded twice is recorded once.
+	ScanId   string `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	TicketId string `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	EventId  string `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	GateId   string `protobuf:"bytes,4,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	// ACCEPTED or REJECTED.
+	Result string `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	// Why a scan was rejected: INVALID_CODE, CODE_REPLACED, EXPIRED,
+	// WRONG_EVENT, NOT_CONFIRMED, ALREADY_USED, ENTRY_LIMIT_REACHED, CANCELLED
+	// or REFUNDED.
+	Reason  string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// Which entry of the ticket an accepted scan was, starting at 1.
+	Entry int32 `protobuf:"varint,8,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Whether the scan was made offline and uploaded with SyncCheckIns.
+	Offline       bool                   `protobuf:"varint,9,opt,name=offline,proto3" json:"offline,omitempty"`
+	ScannedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckIn) Reset() {
+	*x = CheckIn{}
+	mi := &file_ticket_ticket_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckIn) ProtoMessage() {}
+
+func (x *CheckIn) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckIn.ProtoReflect.Descriptor instead.
+func (*CheckIn) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CheckIn) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *CheckIn) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *CheckIn) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckIn) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *CheckIn) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *CheckIn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckIn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckIn) GetEntry() int32 {
+	if x != nil {
+		return x.Entry
+	}
+	return 0
+}
+
+func (x *CheckIn) GetOffline() bool {
+	if x != nil {
+		return x.Offline
+	}
+	return false
+}
+
+func (x *CheckIn) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+func (x *CheckIn) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CheckInTicketRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The token from the ticket's code.
+	Token  string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	GateId string `protobuf:"bytes,2,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	// The event the gate admits to; tickets to other events are rejected.
+	EventId string `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Generated when left out.
+	ScanId        string `protobuf:"bytes,4,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInTicketRequest) Reset() {
+	*x = CheckInTicketRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInTicketRequest) ProtoMessage() {}
+
+func (x *CheckInTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInTicketRequest.ProtoReflect.Descriptor instead.
+func (*CheckInTicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CheckInTicketRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckInTicketRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *CheckInTicketRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CheckInTicketRequest) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+// CheckInTicketResponse reports whether to let the holder in. A rejected scan
+// is a result, not an error.
+type CheckInTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckIn       *CheckIn               `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	Ticket        *Ticket                `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInTicketResponse) Reset() {
+	*x = CheckInTicketResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInTicketResponse) ProtoMessage() {}
+
+func (x *CheckInTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInTicketResponse.ProtoReflect.Descriptor instead.
+func (*CheckInTicketResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckInTicketResponse) GetCheckIn() *CheckIn {
+	if x != nil {
+		return x.CheckIn
+	}
+	return nil
+}
+
+func (x *CheckInTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+// OfflineScan is a scan a gate made without connectivity and accepted or
+// rejected on its own using the published signing keys.
+type OfflineScan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ScannedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfflineScan) Reset() {
+	*x = OfflineScan{}
+	mi := &file_ticket_ticket_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfflineScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflineScan) ProtoMessage() {}
+
+func (x *OfflineScan) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflineScan.ProtoReflect.Descriptor instead.
+func (*OfflineScan) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *OfflineScan) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *OfflineScan) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *OfflineScan) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+type SyncCheckInsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GateId        string                 `protobuf:"bytes,1,opt,name=gate_id,json=gateId,proto3" json:"gate_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Scans         []*OfflineScan         `protobuf:"bytes,3,rep,name=scans,proto3" json:"scans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncCheckInsRequest) Reset() {
+	*x = SyncCheckInsRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncCheckInsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncCheckInsRequest) ProtoMessage() {}
+
+func (x *SyncCheckInsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncCheckInsRequest.ProtoReflect.Descriptor instead.
+func (*SyncCheckInsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SyncCheckInsRequest) GetGateId() string {
+	if x != nil {
+		return x.GateId
+	}
+	return ""
+}
+
+func (x *SyncCheckInsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SyncCheckInsRequest) GetScans() []*OfflineScan {
+	if x != nil {
+		return x.Scans
+	}
+	return nil
+}
+
+// SyncCheckInsResponse holds the recorded outcome of every uploaded scan, in
+// the order they were made. A scan the gate accepted but that is REJECTED
+// here, e.g. as ALREADY_USED, let someone in twice.
+type SyncCheckInsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckIns      []*CheckIn             `protobuf:"bytes,1,rep,name=check_ins,json=checkIns,proto3" json:"check_ins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncCheckInsResponse) Reset() {
+	*x = SyncCheckInsResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncCheckInsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncCheckInsResponse) ProtoMessage() {}
+
+func (x *SyncCheckInsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncCheckInsResponse.ProtoReflect.Descriptor instead.
+func (*SyncCheckInsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SyncCheckInsResponse) GetCheckIns() []*CheckIn {
+	if x != nil {
+		return x.CheckIns
+	}
+	return nil
+}
+
+// EntryPolicy is how often a ticket to an event lets its holder in. Without
+// re-entry a ticket is good for one entry; with it, for max_entries, or any
+// number when max_entries is 0.
+type EntryPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	AllowReentry  bool                   `protobuf:"varint,2,opt,name=allow_reentry,json=allowReentry,proto3" json:"allow_reentry,omitempty"`
+	MaxEntries    int32                  `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntryPolicy) Reset() {
+	*x = EntryPolicy{}
+	mi := &file_ticket_ticket_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryPolicy) ProtoMessage() {}
+
+func (x *EntryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryPolicy.ProtoReflect.Descriptor instead.
+func (*EntryPolicy) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *EntryPolicy) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EntryPolicy) GetAllowReentry() bool {
+	if x != nil {
+		return x.AllowReentry
+	}
+	return false
+}
+
+func (x *EntryPolicy) GetMaxEntries() int32 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+func (x *EntryPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetEntryPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EntryPolicy   *EntryPolicy           `protobuf:"bytes,2,opt,name=entry_policy,json=entryPolicy,proto3" json:"entry_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEntryPolicyRequest) Reset() {
+	*x = SetEntryPolicyRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEntryPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEntryPolicyRequest) ProtoMessage() {}
+
+func (x *SetEntryPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEntryPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetEntryPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetEntryPolicyRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetEntryPolicyRequest) GetEntryPolicy() *EntryPolicy {
+	if x != nil {
+		return x.EntryPolicy
+	}
+	return nil
+}
+
+type SetEntryPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryPolicy   *EntryPolicy           `protobuf:"bytes,1,opt,name=entry_policy,json=entryPolicy,proto3" json:"entry_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEntryPolicyResponse) Reset() {
+	*x = SetEntryPolicyResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEntryPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEntryPolicyResponse) ProtoMessage() {}
+
+func (x *SetEntryPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEntryPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetEntryPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetEntryPolicyResponse) GetEntryPolicy() *EntryPolicy {
+	if x != nil {
+		return x.EntryPolicy
+	}
+	return nil
+}
+
+type GetEntryPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntryPolicyRequest) Reset() {
+	*x = GetEntryPolicyRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntryPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryPolicyRequest) ProtoMessage() {}
+
+func (x *GetEntryPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetEntryPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetEntryPolicyRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetEntryPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryPolicy   *EntryPolicy           `protobuf:"bytes,1,opt,name=entry_policy,json=entryPolicy,proto3" json:"entry_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntryPolicyResponse) Reset() {
+	*x = GetEntryPolicyResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntryPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntryPolicyResponse) ProtoMessage() {}
+
+func (x *GetEntryPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntryPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetEntryPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetEntryPolicyResponse) GetEntryPolicy() *EntryPolicy {
+	if x != nil {
+		return x.EntryPolicy
+	}
+	return nil
+}
+
+// TicketTransfer is an offer by a ticket's holder to give it to another
+// user. It is PENDING until the recipient accepts it or either side cancels
+// it, and becomes ACCEPTED or CANCELLED.
+type TicketTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId      string                 `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	FromUserId    string                 `protobuf:"bytes,4,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,5,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketTransfer) Reset() {
+	*x = TicketTransfer{}
+	mi := &file_ticket_ticket_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketTransfer) ProtoMessage() {}
+
+func (x *TicketTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketTransfer.ProtoReflect.Descriptor instead.
+func (*TicketTransfer) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TicketTransfer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TicketTransfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TicketTransfer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type InitiateTicketTransferRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TicketId string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	// The ticket's current holder.
+	FromUserId    string `protobuf:"bytes,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string `protobuf:"bytes,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateTicketTransferRequest) Reset() {
+	*x = InitiateTicketTransferRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateTicketTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateTicketTransferRequest) ProtoMessage() {}
+
+func (x *InitiateTicketTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateTicketTransferRequest.ProtoReflect.Descriptor instead.
+func (*InitiateTicketTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InitiateTicketTransferRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *InitiateTicketTransferRequest) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *InitiateTicketTransferRequest) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+type InitiateTicketTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *TicketTransfer        `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateTicketTransferResponse) Reset() {
+	*x = InitiateTicketTransferResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateTicketTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateTicketTransferResponse) ProtoMessage() {}
+
+func (x *InitiateTicketTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateTicketTransferResponse.ProtoReflect.Descriptor instead.
+func (*InitiateTicketTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *InitiateTicketTransferResponse) GetTransfer() *TicketTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type AcceptTicketTransferRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The recipient.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTicketTransferRequest) Reset() {
+	*x = AcceptTicketTransferRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTicketTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTicketTransferRequest) ProtoMessage() {}
+
+func (x *AcceptTicketTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTicketTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTicketTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AcceptTicketTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcceptTicketTransferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// AcceptTicketTransferResponse carries a fresh code for the new holder; the
+// previous holder's code no longer gets in.
+type AcceptTicketTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *TicketTransfer        `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Ticket        *Ticket                `protobuf:"bytes,2,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	CodeExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=code_expires_at,json=codeExpiresAt,proto3" json:"code_expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTicketTransferResponse) Reset() {
+	*x = AcceptTicketTransferResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTicketTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTicketTransferResponse) ProtoMessage() {}
+
+func (x *AcceptTicketTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTicketTransferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptTicketTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AcceptTicketTransferResponse) GetTransfer() *TicketTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *AcceptTicketTransferResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *AcceptTicketTransferResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptTicketTransferResponse) GetCodeExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CodeExpiresAt
+	}
+	return nil
+}
+
+type CancelTicketTransferRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Either the sender or the recipient.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTicketTransferRequest) Reset() {
+	*x = CancelTicketTransferRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTicketTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTicketTransferRequest) ProtoMessage() {}
+
+func (x *CancelTicketTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTicketTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelTicketTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CancelTicketTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelTicketTransferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CancelTicketTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *TicketTransfer        `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTicketTransferResponse) Reset() {
+	*x = CancelTicketTransferResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTicketTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTicketTransferResponse) ProtoMessage() {}
+
+func (x *CancelTicketTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTicketTransferResponse.ProtoReflect.Descriptor instead.
+func (*CancelTicketTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CancelTicketTransferResponse) GetTransfer() *TicketTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type GetTicketTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketTransferRequest) Reset() {
+	*x = GetTicketTransferRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketTransferRequest) ProtoMessage() {}
+
+func (x *GetTicketTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketTransferRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetTicketTransferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTicketTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *TicketTransfer        `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTicketTransferResponse) Reset() {
+	*x = GetTicketTransferResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTicketTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketTransferResponse) ProtoMessage() {}
+
+func (x *GetTicketTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetTicketTransferResponse) GetTransfer() *TicketTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type ListTicketTransfersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Transfers sent or received by the user.
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTransfersRequest) Reset() {
+	*x = ListTicketTransfersRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTransfersRequest) ProtoMessage() {}
+
+func (x *ListTicketTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListTicketTransfersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTicketTransfersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListTicketTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTicketTransfersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTicketTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*TicketTransfer      `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTransfersResponse) Reset() {
+	*x = ListTicketTransfersResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTransfersResponse) ProtoMessage() {}
+
+func (x *ListTicketTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListTicketTransfersResponse) GetTransfers() []*TicketTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *ListTicketTransfersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Listing offers a confirmed ticket for resale. It is ACTIVE until it is
+// SOLD, WITHDRAWN by its seller or DELISTED because the ticket was checked
+// in, cancelled, refunded or transferred, or its event was cancelled.
+type Listing struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TicketId     string                 `protobuf:"bytes,2,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	EventId      string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TicketTypeId string                 `protobuf:"bytes,4,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	SeatIds      []string               `protobuf:"bytes,5,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	SellerId     string                 `protobuf:"bytes,6,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	// Prices are in minor units of currency. face_value is the ticket's
+	// original price, which the event's resale policy caps price against.
+	Price     int64  `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`
+	Currency  string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	FaceValue int64  `protobuf:"varint,9,opt,name=face_value,json=faceValue,proto3" json:"face_value,omitempty"`
+	Status    string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// Why a listing was DELISTED.
+	Reason string `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Set once the listing is SOLD.
+	BuyerId string `protobuf:"bytes,12,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	OrderId string `protobuf:"bytes,13,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// What the seller is owed: price less the resale fee.
+	SellerPayout  int64                  `protobuf:"varint,14,opt,name=seller_payout,json=sellerPayout,proto3" json:"seller_payout,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SoldAt        *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=sold_at,json=soldAt,proto3" json:"sold_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Listing) Reset() {
+	*x = Listing{}
+	mi := &file_ticket_ticket_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Listing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listing) ProtoMessage() {}
+
+func (x *Listing) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listing.ProtoReflect.Descriptor instead.
+func (*Listing) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Listing) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Listing) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *Listing) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Listing) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *Listing) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *Listing) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *Listing) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Listing) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Listing) GetFaceValue() int64 {
+	if x != nil {
+		return x.FaceValue
+	}
+	return 0
+}
+
+func (x *Listing) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Listing) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Listing) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *Listing) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Listing) GetSellerPayout() int64 {
+	if x != nil {
+		return x.SellerPayout
+	}
+	return 0
+}
+
+func (x *Listing) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Listing) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Listing) GetSoldAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SoldAt
+	}
+	return nil
+}
+
+// ResalePolicy is the organizer's rule for reselling tickets to an event.
+// Listings may ask at most max_markup_bps basis points over face value; 0
+// means face value. seller_fee_bps of the price is kept from the seller's
+// payout.
+type ResalePolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	MaxMarkupBps  int32                  `protobuf:"varint,2,opt,name=max_markup_bps,json=maxMarkupBps,proto3" json:"max_markup_bps,omitempty"`
+	SellerFeeBps  int32                  `protobuf:"varint,3,opt,name=seller_fee_bps,json=sellerFeeBps,proto3" json:"seller_fee_bps,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResalePolicy) Reset() {
+	*x = ResalePolicy{}
+	mi := &file_ticket_ticket_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResalePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResalePolicy) ProtoMessage() {}
+
+func (x *ResalePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResalePolicy.ProtoReflect.Descriptor instead.
+func (*ResalePolicy) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResalePolicy) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ResalePolicy) GetMaxMarkupBps() int32 {
+	if x != nil {
+		return x.MaxMarkupBps
+	}
+	return 0
+}
+
+func (x *ResalePolicy) GetSellerFeeBps() int32 {
+	if x != nil {
+		return x.SellerFeeBps
+	}
+	return 0
+}
+
+func (x *ResalePolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	SellerId      string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListingRequest) Reset() {
+	*x = CreateListingRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListingRequest) ProtoMessage() {}
+
+func (x *CreateListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListingRequest.ProtoReflect.Descriptor instead.
+func (*CreateListingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateListingRequest) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *CreateListingRequest) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *CreateListingRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type CreateListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListingResponse) Reset() {
+	*x = CreateListingResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListingResponse) ProtoMessage() {}
+
+func (x *CreateListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListingResponse.ProtoReflect.Descriptor instead.
+func (*CreateListingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateListingResponse) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type GetListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListingRequest) Reset() {
+	*x = GetListingRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingRequest) ProtoMessage() {}
+
+func (x *GetListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingRequest.ProtoReflect.Descriptor instead.
+func (*GetListingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetListingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListingResponse) Reset() {
+	*x = GetListingResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListingResponse) ProtoMessage() {}
+
+func (x *GetListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListingResponse.ProtoReflect.Descriptor instead.
+func (*GetListingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetListingResponse) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type ListListingsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EventId  string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SellerId string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	// ACTIVE when left out.
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListListingsRequest) Reset() {
+	*x = ListListingsRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListListingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListingsRequest) ProtoMessage() {}
+
+func (x *ListListingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListingsRequest.ProtoReflect.Descriptor instead.
+func (*ListListingsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListListingsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListListingsRequest) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *ListListingsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListListingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListListingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListListingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listings      []*Listing             `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListListingsResponse) Reset() {
+	*x = ListListingsResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListingsResponse) ProtoMessage() {}
+
+func (x *ListListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListingsResponse.ProtoReflect.Descriptor instead.
+func (*ListListingsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListListingsResponse) GetListings() []*Listing {
+	if x != nil {
+		return x.Listings
+	}
+	return nil
+}
+
+func (x *ListListingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type WithdrawListingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SellerId      string                 `protobuf:"bytes,2,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawListingRequest) Reset() {
+	*x = WithdrawListingRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawListingRequest) ProtoMessage() {}
+
+func (x *WithdrawListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawListingRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawListingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *WithdrawListingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WithdrawListingRequest) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+type WithdrawListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawListingResponse) Reset() {
+	*x = WithdrawListingResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawListingResponse) ProtoMessage() {}
+
+func (x *WithdrawListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawListingResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawListingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *WithdrawListingResponse) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+type BuyListingRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BuyerId string                 `protobuf:"bytes,2,opt,name=buyer_id,json=buyerId,proto3" json:"buyer_id,omitempty"`
+	// Opaque payment method token passed to the payment provider.
+	PaymentMethod string `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// Optional. May also be sent as the Idempotency-Key HTTP header.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BuyListingRequest) Reset() {
+	*x = BuyListingRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyListingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyListingRequest) ProtoMessage() {}
+
+func (x *BuyListingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyListingRequest.ProtoReflect.Descriptor instead.
+func (*BuyListingRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BuyListingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BuyListingRequest) GetBuyerId() string {
+	if x != nil {
+		return x.BuyerId
+	}
+	return ""
+}
+
+func (x *BuyListingRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *BuyListingRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// BuyListingResponse carries the buyer's order and the ticket, reissued to
+// them with a fresh code; the seller's code no longer gets in.
+type BuyListingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Listing       *Listing               `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing,omitempty"`
+	Order         *Order                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	Ticket        *Ticket                `protobuf:"bytes,3,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	CodeExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=code_expires_at,json=codeExpiresAt,proto3" json:"code_expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyListingResponse) Reset() {
+	*x = BuyListingResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyListingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyListingResponse) ProtoMessage() {}
+
+func (x *BuyListingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyListingResponse.ProtoReflect.Descriptor instead.
+func (*BuyListingResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *BuyListingResponse) GetListing() *Listing {
+	if x != nil {
+		return x.Listing
+	}
+	return nil
+}
+
+func (x *BuyListingResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *BuyListingResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *BuyListingResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BuyListingResponse) GetCodeExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CodeExpiresAt
+	}
+	return nil
+}
+
+type SetResalePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ResalePolicy  *ResalePolicy          `protobuf:"bytes,2,opt,name=resale_policy,json=resalePolicy,proto3" json:"resale_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResalePolicyRequest) Reset() {
+	*x = SetResalePolicyRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResalePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResalePolicyRequest) ProtoMessage() {}
+
+func (x *SetResalePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResalePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetResalePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetResalePolicyRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetResalePolicyRequest) GetResalePolicy() *ResalePolicy {
+	if x != nil {
+		return x.ResalePolicy
+	}
+	return nil
+}
+
+type SetResalePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResalePolicy  *ResalePolicy          `protobuf:"bytes,1,opt,name=resale_policy,json=resalePolicy,proto3" json:"resale_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResalePolicyResponse) Reset() {
+	*x = SetResalePolicyResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResalePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResalePolicyResponse) ProtoMessage() {}
+
+func (x *SetResalePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResalePolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetResalePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetResalePolicyResponse) GetResalePolicy() *ResalePolicy {
+	if x != nil {
+		return x.ResalePolicy
+	}
+	return nil
+}
+
+type GetResalePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResalePolicyRequest) Reset() {
+	*x = GetResalePolicyRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResalePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResalePolicyRequest) ProtoMessage() {}
+
+func (x *GetResalePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResalePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetResalePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetResalePolicyRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetResalePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResalePolicy  *ResalePolicy          `protobuf:"bytes,1,opt,name=resale_policy,json=resalePolicy,proto3" json:"resale_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResalePolicyResponse) Reset() {
+	*x = GetResalePolicyResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResalePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResalePolicyResponse) ProtoMessage() {}
+
+func (x *GetResalePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResalePolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetResalePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetResalePolicyResponse) GetResalePolicy() *ResalePolicy {
+	if x != nil {
+		return x.ResalePolicy
+	}
+	return nil
+}
+
+// WaitlistEntry is a user's place in line for a sold-out event. When stock is
+// released the first WAITING entry is OFFERED its quantity, which is held for
+// it until offer_expires_at; claiming the offer buys the held tickets and
+// leaves the entry CLAIMED. An offer not claimed in time is EXPIRED and goes
+// to the next entry. Entries left by their user are CANCELLED.
+type WaitlistEntry struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId      string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TicketTypeId string                 `protobuf:"bytes,3,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	UserId       string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Quantity     int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status       string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// 1 for the next entry to be offered tickets; only set while WAITING.
+	Position       int32                  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	OfferedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=offered_at,json=offeredAt,proto3" json:"offered_at,omitempty"`
+	OfferExpiresAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"`
+	// The order that claimed the offer.
+	OrderId string `protobuf:"bytes,10,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Why an entry was closed without the user asking.
+	Reason        string                 `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_ticket_ticket_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *WaitlistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetOfferedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OfferedAt
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetOfferExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OfferExpiresAt
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type JoinWaitlistRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EventId  string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId   string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Quantity int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Required for events with ticket types.
+	TicketTypeId  string `protobuf:"bytes,4,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *JoinWaitlistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+type JoinWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistResponse) ProtoMessage() {}
+
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *JoinWaitlistResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetWaitlistEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitlistEntryRequest) Reset() {
+	*x = GetWaitlistEntryRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitlistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistEntryRequest) ProtoMessage() {}
+
+func (x *GetWaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetWaitlistEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWaitlistEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitlistEntryResponse) Reset() {
+	*x = GetWaitlistEntryResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitlistEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistEntryResponse) ProtoMessage() {}
+
+func (x *GetWaitlistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistEntryResponse.ProtoReflect.Descriptor instead.
+func (*GetWaitlistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetWaitlistEntryResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ListWaitlistEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWaitlistEntriesRequest) Reset() {
+	*x = ListWaitlistEntriesRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWaitlistEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistEntriesRequest) ProtoMessage() {}
+
+func (x *ListWaitlistEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWaitlistEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListWaitlistEntriesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListWaitlistEntriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWaitlistEntriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWaitlistEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWaitlistEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListWaitlistEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitlistEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWaitlistEntriesResponse) Reset() {
+	*x = ListWaitlistEntriesResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWaitlistEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaitlistEntriesResponse) ProtoMessage() {}
+
+func (x *ListWaitlistEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaitlistEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWaitlistEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListWaitlistEntriesResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListWaitlistEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *LeaveWaitlistRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LeaveWaitlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LeaveWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistResponse) Reset() {
+	*x = LeaveWaitlistResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistResponse) ProtoMessage() {}
+
+func (x *LeaveWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *LeaveWaitlistResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ClaimWaitlistOfferRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PromoCodes []string               `protobuf:"bytes,3,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	// Opaque payment method token passed to the payment provider.
+	PaymentMethod string `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimWaitlistOfferRequest) Reset() {
+	*x = ClaimWaitlistOfferRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimWaitlistOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimWaitlistOfferRequest) ProtoMessage() {}
+
+func (x *ClaimWaitlistOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimWaitlistOfferRequest.ProtoReflect.Descriptor instead.
+func (*ClaimWaitlistOfferRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ClaimWaitlistOfferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClaimWaitlistOfferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClaimWaitlistOfferRequest) GetPromoCodes() []string {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+func (x *ClaimWaitlistOfferRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+type ClaimWaitlistOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Order         *Order                 `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	Tickets       []*Ticket              `protobuf:"bytes,3,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimWaitlistOfferResponse) Reset() {
+	*x = ClaimWaitlistOfferResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimWaitlistOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimWaitlistOfferResponse) ProtoMessage() {}
+
+func (x *ClaimWaitlistOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimWaitlistOfferResponse.ProtoReflect.Descriptor instead.
+func (*ClaimWaitlistOfferResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ClaimWaitlistOfferResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *ClaimWaitlistOfferResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ClaimWaitlistOfferResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// CancellationJob is the progress of closing out every active ticket of a
+// cancelled event.
+type CancellationJob struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Reason  string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// RUNNING, COMPLETED or COMPLETED_WITH_ERRORS.
+	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Refunded  int32  `protobuf:"varint,4,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	Cancelled int32  `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// Tickets that changed status on their own while the job ran.
+	Skipped       int32                  `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed        int32                  `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	Failures      []*CancellationFailure `protobuf:"bytes,8,rep,name=failures,proto3" json:"failures,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancellationJob) Reset() {
+	*x = CancellationJob{}
+	mi := &file_ticket_ticket_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationJob) ProtoMessage() {}
+
+func (x *CancellationJob) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationJob.ProtoReflect.Descriptor instead.
+func (*CancellationJob) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CancellationJob) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CancellationJob) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancellationJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CancellationJob) GetRefunded() int32 {
+	if x != nil {
+		return x.Refunded
+	}
+	return 0
+}
+
+func (x *CancellationJob) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *CancellationJob) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *CancellationJob) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *CancellationJob) GetFailures() []*CancellationFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *CancellationJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CancellationJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CancellationJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type CancellationFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancellationFailure) Reset() {
+	*x = CancellationFailure{}
+	mi := &file_ticket_ticket_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationFailure) ProtoMessage() {}
+
+func (x *CancellationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationFailure.ProtoReflect.Descriptor instead.
+func (*CancellationFailure) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CancellationFailure) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *CancellationFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// PromoCode is a discount buyers can apply to a purchase. Amounts are in
+// minor units of currency.
+type PromoCode struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Code        string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// PERCENTAGE or FIXED.
+	DiscountType string `protobuf:"bytes,3,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	// Percent off the price of each eligible ticket, 1 to 100, for PERCENTAGE
+	// codes.
+	PercentOff int32 `protobuf:"varint,4,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	// Amount off the price of each eligible ticket, for FIXED codes.
+	AmountOff int64  `protobuf:"varint,5,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	Currency  string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Restricts the code to one event; empty applies to every event.
+	EventId string `protobuf:"bytes,7,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Restricts the code to these ticket types; empty applies to every tier.
+	TicketTypeIds []string `protobuf:"bytes,8,rep,name=ticket_type_ids,json=ticketTypeIds,proto3" json:"ticket_type_ids,omitempty"`
+	// Stackable codes may be combined with other stackable codes; any other
+	// code must be used alone.
+	Stackable bool `protobuf:"varint,9,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	// Caps on redemptions overall and per user; 0 means no cap.
+	MaxRedemptions int64 `protobuf:"varint,10,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	MaxPerUser     int64 `protobuf:"varint,11,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`
+	Redemptions    int64 `protobuf:"varint,12,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
+	// Unset for codes that never expire.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	mi := &file_ticket_ticket_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromoCode) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *PromoCode) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *PromoCode) GetAmountOff() int64 {
+	if x != nil {
+		return x.AmountOff
+	}
+	return 0
+}
+
+func (x *PromoCode) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PromoCode) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PromoCode) GetTicketTypeIds() []string {
+	if x != nil {
+		return x.TicketTypeIds
+	}
+	return nil
+}
+
+func (x *PromoCode) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *PromoCode) GetMaxRedemptions() int64 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMaxPerUser() int64 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *PromoCode) GetRedemptions() int64 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+func (x *PromoCode) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreatePromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeRequest) Reset() {
+	*x = CreatePromoCodeRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRequest) ProtoMessage() {}
+
+func (x *CreatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreatePromoCodeRequest) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type CreatePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeResponse) Reset() {
+	*x = CreatePromoCodeResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeResponse) ProtoMessage() {}
+
+func (x *CreatePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreatePromoCodeResponse) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type GetPromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromoCodeRequest) Reset() {
+	*x = GetPromoCodeRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromoCodeRequest) ProtoMessage() {}
+
+func (x *GetPromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetPromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetPromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetPromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromoCodeResponse) Reset() {
+	*x = GetPromoCodeResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromoCodeResponse) ProtoMessage() {}
+
+func (x *GetPromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetPromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetPromoCodeResponse) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type ListPromoCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoCodesRequest) Reset() {
+	*x = ListPromoCodesRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesRequest) ProtoMessage() {}
+
+func (x *ListPromoCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListPromoCodesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListPromoCodesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPromoCodesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPromoCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCodes    []*PromoCode           `protobuf:"bytes,1,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoCodesResponse) Reset() {
+	*x = ListPromoCodesResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesResponse) ProtoMessage() {}
+
+func (x *ListPromoCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListPromoCodesResponse) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+func (x *ListPromoCodesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdatePromoCodeRequest replaces the settings of a code. Its redemptions so
+// far are kept.
+type UpdatePromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	PromoCode     *PromoCode             `protobuf:"bytes,2,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromoCodeRequest) Reset() {
+	*x = UpdatePromoCodeRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromoCodeRequest) ProtoMessage() {}
+
+func (x *UpdatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdatePromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdatePromoCodeRequest) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type UpdatePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromoCodeResponse) Reset() {
+	*x = UpdatePromoCodeResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromoCodeResponse) ProtoMessage() {}
+
+func (x *UpdatePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdatePromoCodeResponse) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type DeletePromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromoCodeRequest) Reset() {
+	*x = DeletePromoCodeRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoCodeRequest) ProtoMessage() {}
+
+func (x *DeletePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeletePromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeletePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromoCodeResponse) Reset() {
+	*x = DeletePromoCodeResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoCodeResponse) ProtoMessage() {}
+
+func (x *DeletePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{96}
+}
+
+type QuoteOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketTypeId  string                 `protobuf:"bytes,3,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PromoCodes    []string               `protobuf:"bytes,5,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteOrderRequest) Reset() {
+	*x = QuoteOrderRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteOrderRequest) ProtoMessage() {}
+
+func (x *QuoteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteOrderRequest.ProtoReflect.Descriptor instead.
+func (*QuoteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *QuoteOrderRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *QuoteOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuoteOrderRequest) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *QuoteOrderRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *QuoteOrderRequest) GetPromoCodes() []string {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+type QuoteOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *Quote                 `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteOrderResponse) Reset() {
+	*x = QuoteOrderResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteOrderResponse) ProtoMessage() {}
+
+func (x *QuoteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteOrderResponse.ProtoReflect.Descriptor instead.
+func (*QuoteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *QuoteOrderResponse) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+// Quote is the price of an order before it is placed. Amounts are in minor
+// units of currency.
+type Quote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	LineItems     []*QuoteLineItem       `protobuf:"bytes,2,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	Discounts     []*QuoteDiscount       `protobuf:"bytes,3,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	Subtotal      int64                  `protobuf:"varint,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountTotal int64                  `protobuf:"varint,5,opt,name=discount_total,json=discountTotal,proto3" json:"discount_total,omitempty"`
+	// Includes fees and tax.
+	Total         int64           `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Breakdown     *PriceBreakdown `protobuf:"bytes,7,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_ticket_ticket_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *Quote) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Quote) GetLineItems() []*QuoteLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Quote) GetDiscounts() []*QuoteDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *Quote) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *Quote) GetDiscountTotal() int64 {
+	if x != nil {
+		return x.DiscountTotal
+	}
+	return 0
+}
+
+func (x *Quote) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Quote) GetBreakdown() *PriceBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type QuoteLineItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TicketTypeId  string                 `protobuf:"bytes,3,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     int64                  `protobuf:"varint,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Amount        int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteLineItem) Reset() {
+	*x = QuoteLineItem{}
+	mi := &file_ticket_ticket_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteLineItem) ProtoMessage() {}
+
+func (x *QuoteLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteLineItem.ProtoReflect.Descriptor instead.
+func (*QuoteLineItem) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *QuoteLineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QuoteLineItem) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *QuoteLineItem) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *QuoteLineItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *QuoteLineItem) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *QuoteLineItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type QuoteDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteDiscount) Reset() {
+	*x = QuoteDiscount{}
+	mi := &file_ticket_ticket_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteDiscount) ProtoMessage() {}
+
+func (x *QuoteDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteDiscount.ProtoReflect.Descriptor instead.
+func (*QuoteDiscount) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *QuoteDiscount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *QuoteDiscount) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QuoteDiscount) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// FeeSchedule is what an event charges on top of the ticket price. Fixed fees
+// are per ticket, in minor units of currency. Tax is charged at the rate of
+// the event's jurisdiction.
+type FeeSchedule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	EventId  string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Currency string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Service fee in basis points of the discounted ticket price.
+	ServiceFeeBps        int32 `protobuf:"varint,3,opt,name=service_fee_bps,json=serviceFeeBps,proto3" json:"service_fee_bps,omitempty"`
+	ServiceFeePerTicket  int64 `protobuf:"varint,4,opt,name=service_fee_per_ticket,json=serviceFeePerTicket,proto3" json:"service_fee_per_ticket,omitempty"`
+	FacilityFeePerTicket int64 `protobuf:"varint,5,opt,name=facility_fee_per_ticket,json=facilityFeePerTicket,proto3" json:"facility_fee_per_ticket,omitempty"`
+	// Key of a tax rate, e.g. US-CA. Empty for no tax.
+	Jurisdiction  string                 `protobuf:"bytes,6,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeSchedule) Reset() {
+	*x = FeeSchedule{}
+	mi := &file_ticket_ticket_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSchedule) ProtoMessage() {}
+
+func (x *FeeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeSchedule.ProtoReflect.Descriptor instead.
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *FeeSchedule) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetServiceFeeBps() int32 {
+	if x != nil {
+		return x.ServiceFeeBps
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetServiceFeePerTicket() int64 {
+	if x != nil {
+		return x.ServiceFeePerTicket
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetFacilityFeePerTicket() int64 {
+	if x != nil {
+		return x.FacilityFeePerTicket
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetFeeScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	FeeSchedule   *FeeSchedule           `protobuf:"bytes,2,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeeScheduleRequest) Reset() {
+	*x = SetFeeScheduleRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeScheduleRequest) ProtoMessage() {}
+
+func (x *SetFeeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetFeeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SetFeeScheduleRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetFeeScheduleRequest) GetFeeSchedule() *FeeSchedule {
+	if x != nil {
+		return x.FeeSchedule
+	}
+	return nil
+}
+
+type SetFeeScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeeSchedule   *FeeSchedule           `protobuf:"bytes,1,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeeScheduleResponse) Reset() {
+	*x = SetFeeScheduleResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeScheduleResponse) ProtoMessage() {}
+
+func (x *SetFeeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SetFeeScheduleResponse) GetFeeSchedule() *FeeSchedule {
+	if x != nil {
+		return x.FeeSchedule
+	}
+	return nil
+}
+
+type GetFeeScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeeScheduleRequest) Reset() {
+	*x = GetFeeScheduleRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeeScheduleRequest) ProtoMessage() {}
+
+func (x *GetFeeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetFeeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetFeeScheduleRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetFeeScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeeSchedule   *FeeSchedule           `protobuf:"bytes,1,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeeScheduleResponse) Reset() {
+	*x = GetFeeScheduleResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeeScheduleResponse) ProtoMessage() {}
+
+func (x *GetFeeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetFeeScheduleResponse) GetFeeSchedule() *FeeSchedule {
+	if x != nil {
+		return x.FeeSchedule
+	}
+	return nil
+}
+
+type TaxRate struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Jurisdiction string                 `protobuf:"bytes,1,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Rate in basis points (1/100 of a percent).
+	RateBps       int32                  `protobuf:"varint,3,opt,name=rate_bps,json=rateBps,proto3" json:"rate_bps,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxRate) Reset() {
+	*x = TaxRate{}
+	mi := &file_ticket_ticket_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRate) ProtoMessage() {}
+
+func (x *TaxRate) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRate.ProtoReflect.Descriptor instead.
+func (*TaxRate) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *TaxRate) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *TaxRate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxRate) GetRateBps() int32 {
+	if x != nil {
+		return x.RateBps
+	}
+	return 0
+}
+
+func (x *TaxRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetTaxRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jurisdiction  string                 `protobuf:"bytes,1,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	TaxRate       *TaxRate               `protobuf:"bytes,2,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxRateRequest) Reset() {
+	*x = SetTaxRateRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateRequest) ProtoMessage() {}
+
+func (x *SetTaxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateRequest.ProtoReflect.Descriptor instead.
+func (*SetTaxRateRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SetTaxRateRequest) GetJurisdiction() string {
 	if x != nil {
-		return x.Ticket
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *SetTaxRateRequest) GetTaxRate() *TaxRate {
+	if x != nil {
+		return x.TaxRate
+	}
+	return nil
+}
+
+type SetTaxRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRate       *TaxRate               `protobuf:"bytes,1,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxRateResponse) Reset() {
+	*x = SetTaxRateResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateResponse) ProtoMessage() {}
+
+func (x *SetTaxRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateResponse.ProtoReflect.Descriptor instead.
+func (*SetTaxRateResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SetTaxRateResponse) GetTaxRate() *TaxRate {
+	if x != nil {
+		return x.TaxRate
+	}
+	return nil
+}
+
+type ListTaxRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRatesRequest) Reset() {
+	*x = ListTaxRatesRequest{}
+	mi := &file_ticket_ticket_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRatesRequest) ProtoMessage() {}
+
+func (x *ListTaxRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaxRatesRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{110}
+}
+
+type ListTaxRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRates      []*TaxRate             `protobuf:"bytes,1,rep,name=tax_rates,json=taxRates,proto3" json:"tax_rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRatesResponse) Reset() {
+	*x = ListTaxRatesResponse{}
+	mi := &file_ticket_ticket_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRatesResponse) ProtoMessage() {}
+
+func (x *ListTaxRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaxRatesResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListTaxRatesResponse) GetTaxRates() []*TaxRate {
+	if x != nil {
+		return x.TaxRates
+	}
+	return nil
+}
+
+// TicketEvent is the payload of the ticket domain events published on the
+// message bus.
+type TicketEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TicketId string                 `protobuf:"bytes,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	EventId  string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId   string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Quantity int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status   string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Reason   string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Set on transfer and resale events, which notify both sides.
+	TransferId string `protobuf:"bytes,7,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	FromUserId string `protobuf:"bytes,8,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId   string `protobuf:"bytes,9,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	ListingId  string `protobuf:"bytes,10,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	// Set on waitlist events, which are about an entry rather than a ticket.
+	WaitlistEntryId string                 `protobuf:"bytes,11,opt,name=waitlist_entry_id,json=waitlistEntryId,proto3" json:"waitlist_entry_id,omitempty"`
+	OfferExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"`
+	// Set on purchase events; the tickets of an order are notified together.
+	OrderId       string `protobuf:"bytes,13,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketEvent) Reset() {
+	*x = TicketEvent{}
+	mi := &file_ticket_ticket_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketEvent) ProtoMessage() {}
+
+func (x *TicketEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_ticket_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketEvent.ProtoReflect.Descriptor instead.
+func (*TicketEvent) Descriptor() ([]byte, []int) {
+	return file_ticket_ticket_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *TicketEvent) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TicketEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetListingId() string {
+	if x != nil {
+		return x.ListingId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetWaitlistEntryId() string {
+	if x != nil {
+		return x.WaitlistEntryId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetOfferExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OfferExpiresAt
 	}
 	return nil
 }
 
+func (x *TicketEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
 var File_ticket_ticket_proto protoreflect.FileDescriptor
 
 const file_ticket_ticket_proto_rawDesc = "" +
 	"\n" +
-	"\x13ticket/ticket.proto\x12\x06ticket\x1a\x1cgoogle/api/annotations.proto\"d\n" +
+	"\x13ticket/ticket.proto\x12\x06ticket\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xcb\x06\n" +
 	"\x06Ticket\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\"g\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\t \x01(\x03R\tunitPrice\x12\x1f\n" +
+	"\vtotal_price\x18\n" +
+	" \x01(\x03R\n" +
+	"totalPrice\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\x12$\n" +
+	"\x0eticket_type_id\x18\f \x01(\tR\fticketTypeId\x12\x19\n" +
+	"\bseat_ids\x18\r \x03(\tR\aseatIds\x12\x1a\n" +
+	"\bdiscount\x18\x0e \x01(\x03R\bdiscount\x12\x1f\n" +
+	"\vpromo_codes\x18\x0f \x03(\tR\n" +
+	"promoCodes\x124\n" +
+	"\tbreakdown\x18\x10 \x01(\v2\x16.ticket.PriceBreakdownR\tbreakdown\x12\x19\n" +
+	"\border_id\x18\x11 \x01(\tR\aorderId\x12>\n" +
+	"\rchecked_in_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\vcheckedInAt\x12\x17\n" +
+	"\agate_id\x18\x13 \x01(\tR\x06gateId\x12\x18\n" +
+	"\aentries\x18\x14 \x01(\x05R\aentries\x12>\n" +
+	"\rlast_entry_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\vlastEntryAt\x12:\n" +
+	"\ttransfers\x18\x16 \x03(\v2\x1c.ticket.TicketTransferRecordR\ttransfers\"\xd9\x01\n" +
+	"\x14TicketTransferRecord\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\x12 \n" +
+	"\ffrom_user_id\x18\x02 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x03 \x01(\tR\btoUserId\x12A\n" +
+	"\x0etransferred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rtransferredAt\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\x05 \x01(\tR\tlistingId\"\x95\x02\n" +
+	"\x0ePriceBreakdown\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04base\x18\x02 \x01(\x03R\x04base\x12\x1a\n" +
+	"\bdiscount\x18\x03 \x01(\x03R\bdiscount\x12\x1f\n" +
+	"\vservice_fee\x18\x04 \x01(\x03R\n" +
+	"serviceFee\x12!\n" +
+	"\ffacility_fee\x18\x05 \x01(\x03R\vfacilityFee\x12\x10\n" +
+	"\x03tax\x18\x06 \x01(\x03R\x03tax\x12\x14\n" +
+	"\x05total\x18\a \x01(\x03R\x05total\x12)\n" +
+	"\x10tax_jurisdiction\x18\b \x01(\tR\x0ftaxJurisdiction\x12 \n" +
+	"\ftax_rate_bps\x18\t \x01(\x05R\n" +
+	"taxRateBps\"\xba\x02\n" +
 	"\x15PurchaseTicketRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"@\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x12%\n" +
+	"\x0epayment_method\x18\x05 \x01(\tR\rpaymentMethod\x12$\n" +
+	"\x0eticket_type_id\x18\x06 \x01(\tR\fticketTypeId\x12\x19\n" +
+	"\bseat_ids\x18\a \x03(\tR\aseatIds\x12\x1f\n" +
+	"\vaccess_code\x18\b \x01(\tR\n" +
+	"accessCode\x12\x1f\n" +
+	"\vpromo_codes\x18\t \x03(\tR\n" +
+	"promoCodes\"\x8f\x01\n" +
 	"\x16PurchaseTicketResponse\x12&\n" +
-	"\x06ticket\x18\x01 \x01(\v2\x0e.ticket.TicketR\x06ticket\"\"\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x0e.ticket.TicketR\x06ticket\x12(\n" +
+	"\atickets\x18\x02 \x03(\v2\x0e.ticket.TicketR\atickets\x12#\n" +
+	"\x05order\x18\x03 \x01(\v2\r.ticket.OrderR\x05order\"\"\n" +
 	"\x10GetTicketRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
 	"\x11GetTicketResponse\x12&\n" +
-	"\x06ticket\x18\x01 \x01(\v2\x0e.ticket.TicketR\x06ticket2\xd4\x01\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x0e.ticket.TicketR\x06ticket\"\x9c\x01\n" +
+	"\x12ListTicketsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"g\n" +
+	"\x13ListTicketsResponse\x12(\n" +
+	"\atickets\x18\x01 \x03(\v2\x0e.ticket.TicketR\atickets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"&\n" +
+	"\x14ConfirmTicketRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x15ConfirmTicketResponse\x12&\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x0e.ticket.TicketR\x06ticket\"%\n" +
+	"\x13CancelTicketRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x14CancelTicketResponse\x12&\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x0e.ticket.TicketR\x06ticket\"%\n" +
+	"\x13RefundTicketRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x14RefundTicketResponse\x12&\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x0e.ticket.TicketR\x06ticket\"6\n" +
+	"\x19GetCancellationJobRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"G\n" +
+	"\x1aGetCancellationJobResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.ticket.CancellationJobR\x03job\"\xee\x03\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x05items\x18\x04 \x03(\v2\x11.ticket.OrderItemR\x05items\x12\x1f\n" +
+	"\vpromo_codes\x18\x05 \x03(\tR\n" +
+	"promoCodes\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x124\n" +
+	"\tbreakdown\x18\a \x01(\v2\x16.ticket.PriceBreakdownR\tbreakdown\x12\x1f\n" +
+	"\vtotal_price\x18\b \x01(\x03R\n" +
+	"totalPrice\x12+\n" +
+	"\x11payment_reference\x18\t \x01(\tR\x10paymentReference\x12%\n" +
+	"\x0efailure_reason\x18\n" +
+	" \x01(\tR\rfailureReason\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\r \x01(\tR\tlistingId\"\xaf\x02\n" +
+	"\tOrderItem\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12$\n" +
+	"\x0eticket_type_id\x18\x02 \x01(\tR\fticketTypeId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x03R\tunitPrice\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bdiscount\x18\a \x01(\x03R\bdiscount\x124\n" +
+	"\tbreakdown\x18\b \x01(\v2\x16.ticket.PriceBreakdownR\tbreakdown\x12\x1d\n" +
+	"\n" +
+	"ticket_ids\x18\t \x03(\tR\tticketIds\"\xcd\x01\n" +
+	"\x12CreateOrderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.ticket.CreateOrderItemR\x05items\x12\x1f\n" +
+	"\vpromo_codes\x18\x03 \x03(\tR\n" +
+	"promoCodes\x12%\n" +
+	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\"\xaa\x01\n" +
+	"\x0fCreateOrderItem\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12$\n" +
+	"\x0eticket_type_id\x18\x02 \x01(\tR\fticketTypeId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12\x1f\n" +
+	"\vaccess_code\x18\x05 \x01(\tR\n" +
+	"accessCode\"d\n" +
+	"\x13CreateOrderResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.ticket.OrderR\x05order\x12(\n" +
+	"\atickets\x18\x02 \x03(\v2\x0e.ticket.TicketR\atickets\"!\n" +
+	"\x0fGetOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"a\n" +
+	"\x10GetOrderResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.ticket.OrderR\x05order\x12(\n" +
+	"\atickets\x18\x02 \x03(\v2\x0e.ticket.TicketR\atickets\"\x80\x01\n" +
+	"\x11ListOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"c\n" +
+	"\x12ListOrdersResponse\x12%\n" +
+	"\x06orders\x18\x01 \x03(\v2\r.ticket.OrderR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"R\n" +
+	"\x14GetTicketCodeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"\xb8\x01\n" +
+	"\x15GetTicketCodeResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\fR\x05image\x12\x15\n" +
+	"\x06key_id\x18\x04 \x01(\tR\x05keyId\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xed\x01\n" +
+	"\x10TicketSigningKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x03 \x01(\fR\tpublicKey\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"retired_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tretiredAt\"\x1f\n" +
+	"\x1dRotateTicketSigningKeyRequest\"L\n" +
+	"\x1eRotateTicketSigningKeyResponse\x12*\n" +
+	"\x03key\x18\x01 \x01(\v2\x18.ticket.TicketSigningKeyR\x03key\"\x1e\n" +
+	"\x1cListTicketSigningKeysRequest\"M\n" +
+	"\x1dListTicketSigningKeysResponse\x12,\n" +
+	"\x04keys\x18\x01 \x03(\v2\x18.ticket.TicketSigningKeyR\x04keys\"/\n" +
+	"\x1dRevokeTicketSigningKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x1eRevokeTicketSigningKeyResponse\x12*\n" +
+	"\x03key\x18\x01 \x01(\v2\x18.ticket.TicketSigningKeyR\x03key\"\xe3\x02\n" +
+	"\aCheckIn\x12\x17\n" +
+	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\tR\bticketId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x17\n" +
+	"\agate_id\x18\x04 \x01(\tR\x06gateId\x12\x16\n" +
+	"\x06result\x18\x05 \x01(\tR\x06result\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x14\n" +
+	"\x05entry\x18\b \x01(\x05R\x05entry\x12\x18\n" +
+	"\aoffline\x18\t \x01(\bR\aoffline\x129\n" +
+	"\n" +
+	"scanned_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"y\n" +
+	"\x14CheckInTicketRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\agate_id\x18\x02 \x01(\tR\x06gateId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x17\n" +
+	"\ascan_id\x18\x04 \x01(\tR\x06scanId\"k\n" +
+	"\x15CheckInTicketResponse\x12*\n" +
+	"\bcheck_in\x18\x01 \x01(\v2\x0f.ticket.CheckInR\acheckIn\x12&\n" +
+	"\x06ticket\x18\x02 \x01(\v2\x0e.ticket.TicketR\x06ticket\"w\n" +
+	"\vOfflineScan\x12\x17\n" +
+	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"scanned_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\"t\n" +
+	"\x13SyncCheckInsRequest\x12\x17\n" +
+	"\agate_id\x18\x01 \x01(\tR\x06gateId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12)\n" +
+	"\x05scans\x18\x03 \x03(\v2\x13.ticket.OfflineScanR\x05scans\"D\n" +
+	"\x14SyncCheckInsResponse\x12,\n" +
+	"\tcheck_ins\x18\x01 \x03(\v2\x0f.ticket.CheckInR\bcheckIns\"\xa9\x01\n" +
+	"\vEntryPolicy\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12#\n" +
+	"\rallow_reentry\x18\x02 \x01(\bR\fallowReentry\x12\x1f\n" +
+	"\vmax_entries\x18\x03 \x01(\x05R\n" +
+	"maxEntries\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"j\n" +
+	"\x15SetEntryPolicyRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x126\n" +
+	"\fentry_policy\x18\x02 \x01(\v2\x13.ticket.EntryPolicyR\ventryPolicy\"P\n" +
+	"\x16SetEntryPolicyResponse\x126\n" +
+	"\fentry_policy\x18\x01 \x01(\v2\x13.ticket.EntryPolicyR\ventryPolicy\"2\n" +
+	"\x15GetEntryPolicyRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"P\n" +
+	"\x16GetEntryPolicyResponse\x126\n" +
+	"\fentry_policy\x18\x01 \x01(\v2\x13.ticket.EntryPolicyR\ventryPolicy\"\xa6\x02\n" +
+	"\x0eTicketTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\tR\bticketId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12 \n" +
+	"\ffrom_user_id\x18\x04 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x05 \x01(\tR\btoUserId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"|\n" +
+	"\x1dInitiateTicketTransferRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12 \n" +
+	"\ffrom_user_id\x18\x02 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x03 \x01(\tR\btoUserId\"T\n" +
+	"\x1eInitiateTicketTransferResponse\x122\n" +
+	"\btransfer\x18\x01 \x01(\v2\x16.ticket.TicketTransferR\btransfer\"F\n" +
+	"\x1bAcceptTicketTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xd4\x01\n" +
+	"\x1cAcceptTicketTransferResponse\x122\n" +
+	"\btransfer\x18\x01 \x01(\v2\x16.ticket.TicketTransferR\btransfer\x12&\n" +
+	"\x06ticket\x18\x02 \x01(\v2\x0e.ticket.TicketR\x06ticket\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12B\n" +
+	"\x0fcode_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rcodeExpiresAt\"F\n" +
+	"\x1bCancelTicketTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"R\n" +
+	"\x1cCancelTicketTransferResponse\x122\n" +
+	"\btransfer\x18\x01 \x01(\v2\x16.ticket.TicketTransferR\btransfer\"*\n" +
+	"\x18GetTicketTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x19GetTicketTransferResponse\x122\n" +
+	"\btransfer\x18\x01 \x01(\v2\x16.ticket.TicketTransferR\btransfer\"\x89\x01\n" +
+	"\x1aListTicketTransfersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"{\n" +
+	"\x1bListTicketTransfersResponse\x124\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x16.ticket.TicketTransferR\ttransfers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb6\x04\n" +
+	"\aListing\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tticket_id\x18\x02 \x01(\tR\bticketId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12$\n" +
+	"\x0eticket_type_id\x18\x04 \x01(\tR\fticketTypeId\x12\x19\n" +
+	"\bseat_ids\x18\x05 \x03(\tR\aseatIds\x12\x1b\n" +
+	"\tseller_id\x18\x06 \x01(\tR\bsellerId\x12\x14\n" +
+	"\x05price\x18\a \x01(\x03R\x05price\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"face_value\x18\t \x01(\x03R\tfaceValue\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\v \x01(\tR\x06reason\x12\x19\n" +
+	"\bbuyer_id\x18\f \x01(\tR\abuyerId\x12\x19\n" +
+	"\border_id\x18\r \x01(\tR\aorderId\x12#\n" +
+	"\rseller_payout\x18\x0e \x01(\x03R\fsellerPayout\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x123\n" +
+	"\asold_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\x06soldAt\"\xb0\x01\n" +
+	"\fResalePolicy\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12$\n" +
+	"\x0emax_markup_bps\x18\x02 \x01(\x05R\fmaxMarkupBps\x12$\n" +
+	"\x0eseller_fee_bps\x18\x03 \x01(\x05R\fsellerFeeBps\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"f\n" +
+	"\x14CreateListingRequest\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\tR\bsellerId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\"B\n" +
+	"\x15CreateListingResponse\x12)\n" +
+	"\alisting\x18\x01 \x01(\v2\x0f.ticket.ListingR\alisting\"#\n" +
+	"\x11GetListingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x12GetListingResponse\x12)\n" +
+	"\alisting\x18\x01 \x01(\v2\x0f.ticket.ListingR\alisting\"\xa1\x01\n" +
+	"\x13ListListingsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\tR\bsellerId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"k\n" +
+	"\x14ListListingsResponse\x12+\n" +
+	"\blistings\x18\x01 \x03(\v2\x0f.ticket.ListingR\blistings\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"E\n" +
+	"\x16WithdrawListingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tseller_id\x18\x02 \x01(\tR\bsellerId\"D\n" +
+	"\x17WithdrawListingResponse\x12)\n" +
+	"\alisting\x18\x01 \x01(\v2\x0f.ticket.ListingR\alisting\"\x8e\x01\n" +
+	"\x11BuyListingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bbuyer_id\x18\x02 \x01(\tR\abuyerId\x12%\n" +
+	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"\xe6\x01\n" +
+	"\x12BuyListingResponse\x12)\n" +
+	"\alisting\x18\x01 \x01(\v2\x0f.ticket.ListingR\alisting\x12#\n" +
+	"\x05order\x18\x02 \x01(\v2\r.ticket.OrderR\x05order\x12&\n" +
+	"\x06ticket\x18\x03 \x01(\v2\x0e.ticket.TicketR\x06ticket\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12B\n" +
+	"\x0fcode_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rcodeExpiresAt\"n\n" +
+	"\x16SetResalePolicyRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x129\n" +
+	"\rresale_policy\x18\x02 \x01(\v2\x14.ticket.ResalePolicyR\fresalePolicy\"T\n" +
+	"\x17SetResalePolicyResponse\x129\n" +
+	"\rresale_policy\x18\x01 \x01(\v2\x14.ticket.ResalePolicyR\fresalePolicy\"3\n" +
+	"\x16GetResalePolicyRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"T\n" +
+	"\x17GetResalePolicyResponse\x129\n" +
+	"\rresale_policy\x18\x01 \x01(\v2\x14.ticket.ResalePolicyR\fresalePolicy\"\xf3\x03\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12$\n" +
+	"\x0eticket_type_id\x18\x03 \x01(\tR\fticketTypeId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"offered_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tofferedAt\x12D\n" +
+	"\x10offer_expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0eofferExpiresAt\x12\x19\n" +
+	"\border_id\x18\n" +
+	" \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\v \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8b\x01\n" +
+	"\x13JoinWaitlistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12$\n" +
+	"\x0eticket_type_id\x18\x04 \x01(\tR\fticketTypeId\"C\n" +
+	"\x14JoinWaitlistResponse\x12+\n" +
+	"\x05entry\x18\x01 \x01(\v2\x15.ticket.WaitlistEntryR\x05entry\")\n" +
+	"\x17GetWaitlistEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\x18GetWaitlistEntryResponse\x12+\n" +
+	"\x05entry\x18\x01 \x01(\v2\x15.ticket.WaitlistEntryR\x05entry\"\xa4\x01\n" +
+	"\x1aListWaitlistEntriesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"v\n" +
+	"\x1bListWaitlistEntriesResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.ticket.WaitlistEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"?\n" +
+	"\x14LeaveWaitlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"D\n" +
+	"\x15LeaveWaitlistResponse\x12+\n" +
+	"\x05entry\x18\x01 \x01(\v2\x15.ticket.WaitlistEntryR\x05entry\"\x8c\x01\n" +
+	"\x19ClaimWaitlistOfferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpromo_codes\x18\x03 \x03(\tR\n" +
+	"promoCodes\x12%\n" +
+	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\"\x98\x01\n" +
+	"\x1aClaimWaitlistOfferResponse\x12+\n" +
+	"\x05entry\x18\x01 \x01(\v2\x15.ticket.WaitlistEntryR\x05entry\x12#\n" +
+	"\x05order\x18\x02 \x01(\v2\r.ticket.OrderR\x05order\x12(\n" +
+	"\atickets\x18\x03 \x03(\v2\x0e.ticket.TicketR\atickets\"\xb6\x03\n" +
+	"\x0fCancellationJob\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\brefunded\x18\x04 \x01(\x05R\brefunded\x12\x1c\n" +
+	"\tcancelled\x18\x05 \x01(\x05R\tcancelled\x12\x18\n" +
+	"\askipped\x18\x06 \x01(\x05R\askipped\x12\x16\n" +
+	"\x06failed\x18\a \x01(\x05R\x06failed\x127\n" +
+	"\bfailures\x18\b \x03(\v2\x1b.ticket.CancellationFailureR\bfailures\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"H\n" +
+	"\x13CancellationFailure\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xc1\x04\n" +
+	"\tPromoCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12#\n" +
+	"\rdiscount_type\x18\x03 \x01(\tR\fdiscountType\x12\x1f\n" +
+	"\vpercent_off\x18\x04 \x01(\x05R\n" +
+	"percentOff\x12\x1d\n" +
+	"\n" +
+	"amount_off\x18\x05 \x01(\x03R\tamountOff\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x19\n" +
+	"\bevent_id\x18\a \x01(\tR\aeventId\x12&\n" +
+	"\x0fticket_type_ids\x18\b \x03(\tR\rticketTypeIds\x12\x1c\n" +
+	"\tstackable\x18\t \x01(\bR\tstackable\x12'\n" +
+	"\x0fmax_redemptions\x18\n" +
+	" \x01(\x03R\x0emaxRedemptions\x12 \n" +
+	"\fmax_per_user\x18\v \x01(\x03R\n" +
+	"maxPerUser\x12 \n" +
+	"\vredemptions\x18\f \x01(\x03R\vredemptions\x129\n" +
+	"\n" +
+	"expires_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\x16CreatePromoCodeRequest\x120\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\v2\x11.ticket.PromoCodeR\tpromoCode\"K\n" +
+	"\x17CreatePromoCodeResponse\x120\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\v2\x11.ticket.PromoCodeR\tpromoCode\")\n" +
+	"\x13GetPromoCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"H\n" +
+	"\x14GetPromoCodeResponse\x120\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\v2\x11.ticket.PromoCodeR\tpromoCode\"n\n" +
+	"\x15ListPromoCodesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"t\n" +
+	"\x16ListPromoCodesResponse\x122\n" +
+	"\vpromo_codes\x18\x01 \x03(\v2\x11.ticket.PromoCodeR\n" +
+	"promoCodes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"^\n" +
+	"\x16UpdatePromoCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x120\n" +
+	"\n" +
+	"promo_code\x18\x02 \x01(\v2\x11.ticket.PromoCodeR\tpromoCode\"K\n" +
+	"\x17UpdatePromoCodeResponse\x120\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\v2\x11.ticket.PromoCodeR\tpromoCode\",\n" +
+	"\x16DeletePromoCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x19\n" +
+	"\x17DeletePromoCodeResponse\"\xaa\x01\n" +
+	"\x11QuoteOrderRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\x0eticket_type_id\x18\x03 \x01(\tR\fticketTypeId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vpromo_codes\x18\x05 \x03(\tR\n" +
+	"promoCodes\"9\n" +
+	"\x12QuoteOrderResponse\x12#\n" +
+	"\x05quote\x18\x01 \x01(\v2\r.ticket.QuoteR\x05quote\"\x9d\x02\n" +
+	"\x05Quote\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x124\n" +
+	"\n" +
+	"line_items\x18\x02 \x03(\v2\x15.ticket.QuoteLineItemR\tlineItems\x123\n" +
+	"\tdiscounts\x18\x03 \x03(\v2\x15.ticket.QuoteDiscountR\tdiscounts\x12\x1a\n" +
+	"\bsubtotal\x18\x04 \x01(\x03R\bsubtotal\x12%\n" +
+	"\x0ediscount_total\x18\x05 \x01(\x03R\rdiscountTotal\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x03R\x05total\x124\n" +
+	"\tbreakdown\x18\a \x01(\v2\x16.ticket.PriceBreakdownR\tbreakdown\"\xc5\x01\n" +
+	"\rQuoteLineItem\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12$\n" +
+	"\x0eticket_type_id\x18\x03 \x01(\tR\fticketTypeId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x03R\tunitPrice\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\"]\n" +
+	"\rQuoteDiscount\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\"\xb7\x02\n" +
+	"\vFeeSchedule\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12&\n" +
+	"\x0fservice_fee_bps\x18\x03 \x01(\x05R\rserviceFeeBps\x123\n" +
+	"\x16service_fee_per_ticket\x18\x04 \x01(\x03R\x13serviceFeePerTicket\x125\n" +
+	"\x17facility_fee_per_ticket\x18\x05 \x01(\x03R\x14facilityFeePerTicket\x12\"\n" +
+	"\fjurisdiction\x18\x06 \x01(\tR\fjurisdiction\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"j\n" +
+	"\x15SetFeeScheduleRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x126\n" +
+	"\ffee_schedule\x18\x02 \x01(\v2\x13.ticket.FeeScheduleR\vfeeSchedule\"P\n" +
+	"\x16SetFeeScheduleResponse\x126\n" +
+	"\ffee_schedule\x18\x01 \x01(\v2\x13.ticket.FeeScheduleR\vfeeSchedule\"2\n" +
+	"\x15GetFeeScheduleRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"P\n" +
+	"\x16GetFeeScheduleResponse\x126\n" +
+	"\ffee_schedule\x18\x01 \x01(\v2\x13.ticket.FeeScheduleR\vfeeSchedule\"\x97\x01\n" +
+	"\aTaxRate\x12\"\n" +
+	"\fjurisdiction\x18\x01 \x01(\tR\fjurisdiction\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\brate_bps\x18\x03 \x01(\x05R\arateBps\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"c\n" +
+	"\x11SetTaxRateRequest\x12\"\n" +
+	"\fjurisdiction\x18\x01 \x01(\tR\fjurisdiction\x12*\n" +
+	"\btax_rate\x18\x02 \x01(\v2\x0f.ticket.TaxRateR\ataxRate\"@\n" +
+	"\x12SetTaxRateResponse\x12*\n" +
+	"\btax_rate\x18\x01 \x01(\v2\x0f.ticket.TaxRateR\ataxRate\"\x15\n" +
+	"\x13ListTaxRatesRequest\"D\n" +
+	"\x14ListTaxRatesResponse\x12,\n" +
+	"\ttax_rates\x18\x01 \x03(\v2\x0f.ticket.TaxRateR\btaxRates\"\xb7\x03\n" +
+	"\vTicketEvent\x12\x1b\n" +
+	"\tticket_id\x18\x01 \x01(\tR\bticketId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vtransfer_id\x18\a \x01(\tR\n" +
+	"transferId\x12 \n" +
+	"\ffrom_user_id\x18\b \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\t \x01(\tR\btoUserId\x12\x1d\n" +
+	"\n" +
+	"listing_id\x18\n" +
+	" \x01(\tR\tlistingId\x12*\n" +
+	"\x11waitlist_entry_id\x18\v \x01(\tR\x0fwaitlistEntryId\x12D\n" +
+	"\x10offer_expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0eofferExpiresAt\x12\x19\n" +
+	"\border_id\x18\r \x01(\tR\aorderId2\xac)\n" +
 	"\rTicketService\x12g\n" +
-	"\x0ePurchaseTicket\x12\x1d.ticket.PurchaseTicketRequest\x1a\x1e.ticket.PurchaseTicketResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/tickets\x12Z\n" +
-	"\tGetTicket\x12\x18.ticket.GetTicketRequest\x1a\x19.ticket.GetTicketResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/tickets/{id}B:Z8github.com/doniiel/event-ticketing-platform/proto/ticketb\x06proto3"
+	"\x0ePurchaseTicket\x12\x1d.ticket.PurchaseTicketRequest\x1a\x1e.ticket.PurchaseTicketResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/tickets\x12]\n" +
+	"\vCreateOrder\x12\x1a.ticket.CreateOrderRequest\x1a\x1b.ticket.CreateOrderResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/orders\x12V\n" +
+	"\bGetOrder\x12\x17.ticket.GetOrderRequest\x1a\x18.ticket.GetOrderResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/orders/{id}\x12W\n" +
+	"\n" +
+	"ListOrders\x12\x19.ticket.ListOrdersRequest\x1a\x1a.ticket.ListOrdersResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/orders\x12Z\n" +
+	"\tGetTicket\x12\x18.ticket.GetTicketRequest\x1a\x19.ticket.GetTicketResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/tickets/{id}\x12[\n" +
+	"\vListTickets\x12\x1a.ticket.ListTicketsRequest\x1a\x1b.ticket.ListTicketsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/tickets\x12k\n" +
+	"\rGetTicketCode\x12\x1c.ticket.GetTicketCodeRequest\x1a\x1d.ticket.GetTicketCodeResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/tickets/{id}/code\x12q\n" +
+	"\rConfirmTicket\x12\x1c.ticket.ConfirmTicketRequest\x1a\x1d.ticket.ConfirmTicketResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/tickets/{id}/confirm\x12m\n" +
+	"\fCancelTicket\x12\x1b.ticket.CancelTicketRequest\x1a\x1c.ticket.CancelTicketResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/tickets/{id}/cancel\x12m\n" +
+	"\fRefundTicket\x12\x1b.ticket.RefundTicketRequest\x1a\x1c.ticket.RefundTicketResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/tickets/{id}/refund\x12\x87\x01\n" +
+	"\x12GetCancellationJob\x12!.ticket.GetCancellationJobRequest\x1a\".ticket.GetCancellationJobResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/events/{event_id}/cancellation\x12w\n" +
+	"\x0fCreatePromoCode\x12\x1e.ticket.CreatePromoCodeRequest\x1a\x1f.ticket.CreatePromoCodeResponse\"#\x82\xd3\xe4\x93\x02\x1d:\n" +
+	"promo_code\"\x0f/v1/promo-codes\x12i\n" +
+	"\fGetPromoCode\x12\x1b.ticket.GetPromoCodeRequest\x1a\x1c.ticket.GetPromoCodeResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/promo-codes/{code}\x12h\n" +
+	"\x0eListPromoCodes\x12\x1d.ticket.ListPromoCodesRequest\x1a\x1e.ticket.ListPromoCodesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/promo-codes\x12~\n" +
+	"\x0fUpdatePromoCode\x12\x1e.ticket.UpdatePromoCodeRequest\x1a\x1f.ticket.UpdatePromoCodeResponse\"*\x82\xd3\xe4\x93\x02$:\n" +
+	"promo_code\x1a\x16/v1/promo-codes/{code}\x12r\n" +
+	"\x0fDeletePromoCode\x12\x1e.ticket.DeletePromoCodeRequest\x1a\x1f.ticket.DeletePromoCodeResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/promo-codes/{code}\x12Z\n" +
+	"\n" +
+	"QuoteOrder\x12\x19.ticket.QuoteOrderRequest\x1a\x1a.ticket.QuoteOrderResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/quotes\x12\x89\x01\n" +
+	"\x0eSetFeeSchedule\x12\x1d.ticket.SetFeeScheduleRequest\x1a\x1e.ticket.SetFeeScheduleResponse\"8\x82\xd3\xe4\x93\x022:\ffee_schedule\x1a\"/v1/events/{event_id}/fee-schedule\x12{\n" +
+	"\x0eGetFeeSchedule\x12\x1d.ticket.GetFeeScheduleRequest\x1a\x1e.ticket.GetFeeScheduleResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/events/{event_id}/fee-schedule\x12s\n" +
+	"\n" +
+	"SetTaxRate\x12\x19.ticket.SetTaxRateRequest\x1a\x1a.ticket.SetTaxRateResponse\".\x82\xd3\xe4\x93\x02(:\btax_rate\x1a\x1c/v1/tax-rates/{jurisdiction}\x12`\n" +
+	"\fListTaxRates\x12\x1b.ticket.ListTaxRatesRequest\x1a\x1c.ticket.ListTaxRatesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/tax-rates\x12\x8b\x01\n" +
+	"\x16RotateTicketSigningKey\x12%.ticket.RotateTicketSigningKeyRequest\x1a&.ticket.RotateTicketSigningKeyResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/ticket-signing-keys\x12\x85\x01\n" +
+	"\x15ListTicketSigningKeys\x12$.ticket.ListTicketSigningKeysRequest\x1a%.ticket.ListTicketSigningKeysResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/ticket-signing-keys\x12\x97\x01\n" +
+	"\x16RevokeTicketSigningKey\x12%.ticket.RevokeTicketSigningKeyRequest\x1a&.ticket.RevokeTicketSigningKeyResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/ticket-signing-keys/{id}/revoke\x12f\n" +
+	"\rCheckInTicket\x12\x1c.ticket.CheckInTicketRequest\x1a\x1d.ticket.CheckInTicketResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/check-ins\x12h\n" +
+	"\fSyncCheckIns\x12\x1b.ticket.SyncCheckInsRequest\x1a\x1c.ticket.SyncCheckInsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/check-ins/sync\x12\x89\x01\n" +
+	"\x0eSetEntryPolicy\x12\x1d.ticket.SetEntryPolicyRequest\x1a\x1e.ticket.SetEntryPolicyResponse\"8\x82\xd3\xe4\x93\x022:\fentry_policy\x1a\"/v1/events/{event_id}/entry-policy\x12{\n" +
+	"\x0eGetEntryPolicy\x12\x1d.ticket.GetEntryPolicyRequest\x1a\x1e.ticket.GetEntryPolicyResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/events/{event_id}/entry-policy\x12\x95\x01\n" +
+	"\x16InitiateTicketTransfer\x12%.ticket.InitiateTicketTransferRequest\x1a&.ticket.InitiateTicketTransferResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/tickets/{ticket_id}/transfers\x12\x87\x01\n" +
+	"\x14AcceptTicketTransfer\x12#.ticket.AcceptTicketTransferRequest\x1a$.ticket.AcceptTicketTransferResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/transfers/{id}/accept\x12\x87\x01\n" +
+	"\x14CancelTicketTransfer\x12#.ticket.CancelTicketTransferRequest\x1a$.ticket.CancelTicketTransferResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/transfers/{id}/cancel\x12t\n" +
+	"\x11GetTicketTransfer\x12 .ticket.GetTicketTransferRequest\x1a!.ticket.GetTicketTransferResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/transfers/{id}\x12u\n" +
+	"\x13ListTicketTransfers\x12\".ticket.ListTicketTransfersRequest\x1a#.ticket.ListTicketTransfersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/transfers\x12y\n" +
+	"\rCreateListing\x12\x1c.ticket.CreateListingRequest\x1a\x1d.ticket.CreateListingResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/tickets/{ticket_id}/listings\x12^\n" +
+	"\n" +
+	"GetListing\x12\x19.ticket.GetListingRequest\x1a\x1a.ticket.GetListingResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/listings/{id}\x12_\n" +
+	"\fListListings\x12\x1b.ticket.ListListingsRequest\x1a\x1c.ticket.ListListingsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/listings\x12y\n" +
+	"\x0fWithdrawListing\x12\x1e.ticket.WithdrawListingRequest\x1a\x1f.ticket.WithdrawListingResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/listings/{id}/withdraw\x12e\n" +
+	"\n" +
+	"BuyListing\x12\x19.ticket.BuyListingRequest\x1a\x1a.ticket.BuyListingResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/listings/{id}/buy\x12\x8e\x01\n" +
+	"\x0fSetResalePolicy\x12\x1e.ticket.SetResalePolicyRequest\x1a\x1f.ticket.SetResalePolicyResponse\":\x82\xd3\xe4\x93\x024:\rresale_policy\x1a#/v1/events/{event_id}/resale-policy\x12\x7f\n" +
+	"\x0fGetResalePolicy\x12\x1e.ticket.GetResalePolicyRequest\x1a\x1f.ticket.GetResalePolicyResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/events/{event_id}/resale-policy\x12t\n" +
+	"\fJoinWaitlist\x12\x1b.ticket.JoinWaitlistRequest\x1a\x1c.ticket.JoinWaitlistResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/events/{event_id}/waitlist\x12p\n" +
+	"\x10GetWaitlistEntry\x12\x1f.ticket.GetWaitlistEntryRequest\x1a .ticket.GetWaitlistEntryResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/waitlist/{id}\x12t\n" +
+	"\x13ListWaitlistEntries\x12\".ticket.ListWaitlistEntriesRequest\x1a#.ticket.ListWaitlistEntriesResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/waitlist\x12p\n" +
+	"\rLeaveWaitlist\x12\x1c.ticket.LeaveWaitlistRequest\x1a\x1d.ticket.LeaveWaitlistResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/waitlist/{id}/leave\x12\x7f\n" +
+	"\x12ClaimWaitlistOffer\x12!.ticket.ClaimWaitlistOfferRequest\x1a\".ticket.ClaimWaitlistOfferResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/waitlist/{id}/claimB\xcd\x01\x92A\x8f\x01\x12f\n" +
+	"\x12Ticket Service API\x12'Handles ticket purchasing and tracking.\"\"\n" +
+	"\vTicket Team\x1a\x13support@example.com2\x031.0*\x01\x012\x10application/json:\x10application/jsonZ8github.com/doniiel/event-ticketing-platform/proto/ticketb\x06proto3"
 
 var (
 	file_ticket_ticket_proto_rawDescOnce sync.Once
@@ -318,26 +7852,325 @@ func file_ticket_ticket_proto_rawDescGZIP() []byte {
 	return file_ticket_ticket_proto_rawDescData
 }
 
-var file_ticket_ticket_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ticket_ticket_proto_msgTypes = make([]protoimpl.MessageInfo, 113)
 var file_ticket_ticket_proto_goTypes = []any{
-	(*Ticket)(nil),                 // 0: ticket.Ticket
-	(*PurchaseTicketRequest)(nil),  // 1: ticket.PurchaseTicketRequest
-	(*PurchaseTicketResponse)(nil), // 2: ticket.PurchaseTicketResponse
-	(*GetTicketRequest)(nil),       // 3: ticket.GetTicketRequest
-	(*GetTicketResponse)(nil),      // 4: ticket.GetTicketResponse
+	(*Ticket)(nil),                         // 0: ticket.Ticket
+	(*TicketTransferRecord)(nil),           // 1: ticket.TicketTransferRecord
+	(*PriceBreakdown)(nil),                 // 2: ticket.PriceBreakdown
+	(*PurchaseTicketRequest)(nil),          // 3: ticket.PurchaseTicketRequest
+	(*PurchaseTicketResponse)(nil),         // 4: ticket.PurchaseTicketResponse
+	(*GetTicketRequest)(nil),               // 5: ticket.GetTicketRequest
+	(*GetTicketResponse)(nil),              // 6: ticket.GetTicketResponse
+	(*ListTicketsRequest)(nil),             // 7: ticket.ListTicketsRequest
+	(*ListTicketsResponse)(nil),            // 8: ticket.ListTicketsResponse
+	(*ConfirmTicketRequest)(nil),           // 9: ticket.ConfirmTicketRequest
+	(*ConfirmTicketResponse)(nil),          // 10: ticket.ConfirmTicketResponse
+	(*CancelTicketRequest)(nil),            // 11: ticket.CancelTicketRequest
+	(*CancelTicketResponse)(nil),           // 12: ticket.CancelTicketResponse
+	(*RefundTicketRequest)(nil),            // 13: ticket.RefundTicketRequest
+	(*RefundTicketResponse)(nil),           // 14: ticket.RefundTicketResponse
+	(*GetCancellationJobRequest)(nil),      // 15: ticket.GetCancellationJobRequest
+	(*GetCancellationJobResponse)(nil),     // 16: ticket.GetCancellationJobResponse
+	(*Order)(nil),                          // 17: ticket.Order
+	(*OrderItem)(nil),                      // 18: ticket.OrderItem
+	(*CreateOrderRequest)(nil),             // 19: ticket.CreateOrderRequest
+	(*CreateOrderItem)(nil),                // 20: ticket.CreateOrderItem
+	(*CreateOrderResponse)(nil),            // 21: ticket.CreateOrderResponse
+	(*GetOrderRequest)(nil),                // 22: ticket.GetOrderRequest
+	(*GetOrderResponse)(nil),               // 23: ticket.GetOrderResponse
+	(*ListOrdersRequest)(nil),              // 24: ticket.ListOrdersRequest
+	(*ListOrdersResponse)(nil),             // 25: ticket.ListOrdersResponse
+	(*GetTicketCodeRequest)(nil),           // 26: ticket.GetTicketCodeRequest
+	(*GetTicketCodeResponse)(nil),          // 27: ticket.GetTicketCodeResponse
+	(*TicketSigningKey)(nil),               // 28: ticket.TicketSigningKey
+	(*RotateTicketSigningKeyRequest)(nil),  // 29: ticket.RotateTicketSigningKeyRequest
+	(*RotateTicketSigningKeyResponse)(nil), // 30: ticket.RotateTicketSigningKeyResponse
+	(*ListTicketSigningKeysRequest)(nil),   // 31: ticket.ListTicketSigningKeysRequest
+	(*ListTicketSigningKeysResponse)(nil),  // 32: ticket.ListTicketSigningKeysResponse
+	(*RevokeTicketSigningKeyRequest)(nil),  // 33: ticket.RevokeTicketSigningKeyRequest
+	(*RevokeTicketSigningKeyResponse)(nil), // 34: ticket.RevokeTicketSigningKeyResponse
+	(*CheckIn)(nil),                        // 35: ticket.CheckIn
+	(*CheckInTicketRequest)(nil),           // 36: ticket.CheckInTicketRequest
+	(*CheckInTicketResponse)(nil),          // 37: ticket.CheckInTicketResponse
+	(*OfflineScan)(nil),                    // 38: ticket.OfflineScan
+	(*SyncCheckInsRequest)(nil),            // 39: ticket.SyncCheckInsRequest
+	(*SyncCheckInsResponse)(nil),           // 40: ticket.SyncCheckInsResponse
+	(*EntryPolicy)(nil),                    // 41: ticket.EntryPolicy
+	(*SetEntryPolicyRequest)(nil),          // 42: ticket.SetEntryPolicyRequest
+	(*SetEntryPolicyResponse)(nil),         // 43: ticket.SetEntryPolicyResponse
+	(*GetEntryPolicyRequest)(nil),          // 44: ticket.GetEntryPolicyRequest
+	(*GetEntryPolicyResponse)(nil),         // 45: ticket.GetEntryPolicyResponse
+	(*TicketTransfer)(nil),                 // 46: ticket.TicketTransfer
+	(*InitiateTicketTransferRequest)(nil),  // 47: ticket.InitiateTicketTransferRequest
+	(*InitiateTicketTransferResponse)(nil), // 48: ticket.InitiateTicketTransferResponse
+	(*AcceptTicketTransferRequest)(nil),    // 49: ticket.AcceptTicketTransferRequest
+	(*AcceptTicketTransferResponse)(nil),   // 50: ticket.AcceptTicketTransferResponse
+	(*CancelTicketTransferRequest)(nil),    // 51: ticket.CancelTicketTransferRequest
+	(*CancelTicketTransferResponse)(nil),   // 52: ticket.CancelTicketTransferResponse
+	(*GetTicketTransferRequest)(nil),       // 53: ticket.GetTicketTransferRequest
+	(*GetTicketTransferResponse)(nil),      // 54: ticket.GetTicketTransferResponse
+	(*ListTicketTransfersRequest)(nil),     // 55: ticket.ListTicketTransfersRequest
+	(*ListTicketTransfersResponse)(nil),    // 56: ticket.ListTicketTransfersResponse
+	(*Listing)(nil),                        // 57: ticket.Listing
+	(*ResalePolicy)(nil),                   // 58: ticket.ResalePolicy
+	(*CreateListingRequest)(nil),           // 59: ticket.CreateListingRequest
+	(*CreateListingResponse)(nil),          // 60: ticket.CreateListingResponse
+	(*GetListingRequest)(nil),              // 61: ticket.GetListingRequest
+	(*GetListingResponse)(nil),             // 62: ticket.GetListingResponse
+	(*ListListingsRequest)(nil),            // 63: ticket.ListListingsRequest
+	(*ListListingsResponse)(nil),           // 64: ticket.ListListingsResponse
+	(*WithdrawListingRequest)(nil),         // 65: ticket.WithdrawListingRequest
+	(*WithdrawListingResponse)(nil),        // 66: ticket.WithdrawListingResponse
+	(*BuyListingRequest)(nil),              // 67: ticket.BuyListingRequest
+	(*BuyListingResponse)(nil),             // 68: ticket.BuyListingResponse
+	(*SetResalePolicyRequest)(nil),         // 69: ticket.SetResalePolicyRequest
+	(*SetResalePolicyResponse)(nil),        // 70: ticket.SetResalePolicyResponse
+	(*GetResalePolicyRequest)(nil),         // 71: ticket.GetResalePolicyRequest
+	(*GetResalePolicyResponse)(nil),        // 72: ticket.GetResalePolicyResponse
+	(*WaitlistEntry)(nil),                  // 73: ticket.WaitlistEntry
+	(*JoinWaitlistRequest)(nil),            // 74: ticket.JoinWaitlistRequest
+	(*JoinWaitlistResponse)(nil),           // 75: ticket.JoinWaitlistResponse
+	(*GetWaitlistEntryRequest)(nil),        // 76: ticket.GetWaitlistEntryRequest
+	(*GetWaitlistEntryResponse)(nil),       // 77: ticket.GetWaitlistEntryResponse
+	(*ListWaitlistEntriesRequest)(nil),     // 78: ticket.ListWaitlistEntriesRequest
+	(*ListWaitlistEntriesResponse)(nil),    // 79: ticket.ListWaitlistEntriesResponse
+	(*LeaveWaitlistRequest)(nil),           // 80: ticket.LeaveWaitlistRequest
+	(*LeaveWaitlistResponse)(nil),          // 81: ticket.LeaveWaitlistResponse
+	(*ClaimWaitlistOfferRequest)(nil),      // 82: ticket.ClaimWaitlistOfferRequest
+	(*ClaimWaitlistOfferResponse)(nil),     // 83: ticket.ClaimWaitlistOfferResponse
+	(*CancellationJob)(nil),                // 84: ticket.CancellationJob
+	(*CancellationFailure)(nil),            // 85: ticket.CancellationFailure
+	(*PromoCode)(nil),                      // 86: ticket.PromoCode
+	(*CreatePromoCodeRequest)(nil),         // 87: ticket.CreatePromoCodeRequest
+	(*CreatePromoCodeResponse)(nil),        // 88: ticket.CreatePromoCodeResponse
+	(*GetPromoCodeRequest)(nil),            // 89: ticket.GetPromoCodeRequest
+	(*GetPromoCodeResponse)(nil),           // 90: ticket.GetPromoCodeResponse
+	(*ListPromoCodesRequest)(nil),          // 91: ticket.ListPromoCodesRequest
+	(*ListPromoCodesResponse)(nil),         // 92: ticket.ListPromoCodesResponse
+	(*UpdatePromoCodeRequest)(nil),         // 93: ticket.UpdatePromoCodeRequest
+	(*UpdatePromoCodeResponse)(nil),        // 94: ticket.UpdatePromoCodeResponse
+	(*DeletePromoCodeRequest)(nil),         // 95: ticket.DeletePromoCodeRequest
+	(*DeletePromoCodeResponse)(nil),        // 96: ticket.DeletePromoCodeResponse
+	(*QuoteOrderRequest)(nil),              // 97: ticket.QuoteOrderRequest
+	(*QuoteOrderResponse)(nil),             // 98: ticket.QuoteOrderResponse
+	(*Quote)(nil),                          // 99: ticket.Quote
+	(*QuoteLineItem)(nil),                  // 100: ticket.QuoteLineItem
+	(*QuoteDiscount)(nil),                  // 101: ticket.QuoteDiscount
+	(*FeeSchedule)(nil),                    // 102: ticket.FeeSchedule
+	(*SetFeeScheduleRequest)(nil),          // 103: ticket.SetFeeScheduleRequest
+	(*SetFeeScheduleResponse)(nil),         // 104: ticket.SetFeeScheduleResponse
+	(*GetFeeScheduleRequest)(nil),          // 105: ticket.GetFeeScheduleRequest
+	(*GetFeeScheduleResponse)(nil),         // 106: ticket.GetFeeScheduleResponse
+	(*TaxRate)(nil),                        // 107: ticket.TaxRate
+	(*SetTaxRateRequest)(nil),              // 108: ticket.SetTaxRateRequest
+	(*SetTaxRateResponse)(nil),             // 109: ticket.SetTaxRateResponse
+	(*ListTaxRatesRequest)(nil),            // 110: ticket.ListTaxRatesRequest
+	(*ListTaxRatesResponse)(nil),           // 111: ticket.ListTaxRatesResponse
+	(*TicketEvent)(nil),                    // 112: ticket.TicketEvent
+	(*timestamppb.Timestamp)(nil),          // 113: google.protobuf.Timestamp
 }
 var file_ticket_ticket_proto_depIdxs = []int32{
-	0, // 0: ticket.PurchaseTicketResponse.ticket:type_name -> ticket.Ticket
-	0, // 1: ticket.GetTicketResponse.ticket:type_name -> ticket.Ticket
-	1, // 2: ticket.TicketService.PurchaseTicket:input_type -> ticket.PurchaseTicketRequest
-	3, // 3: ticket.TicketService.GetTicket:input_type -> ticket.GetTicketRequest
-	2, // 4: ticket.TicketService.PurchaseTicket:output_type -> ticket.PurchaseTicketResponse
-	4, // 5: ticket.TicketService.GetTicket:output_type -> ticket.GetTicketResponse
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	113, // 0: ticket.Ticket.expires_at:type_name -> google.protobuf.Timestamp
+	113, // 1: ticket.Ticket.created_at:type_name -> google.protobuf.Timestamp
+	113, // 2: ticket.Ticket.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 3: ticket.Ticket.breakdown:type_name -> ticket.PriceBreakdown
+	113, // 4: ticket.Ticket.checked_in_at:type_name -> google.protobuf.Timestamp
+	113, // 5: ticket.Ticket.last_entry_at:type_name -> google.protobuf.Timestamp
+	1,   // 6: ticket.Ticket.transfers:type_name -> ticket.TicketTransferRecord
+	113, // 7: ticket.TicketTransferRecord.transferred_at:type_name -> google.protobuf.Timestamp
+	0,   // 8: ticket.PurchaseTicketResponse.ticket:type_name -> ticket.Ticket
+	0,   // 9: ticket.PurchaseTicketResponse.tickets:type_name -> ticket.Ticket
+	17,  // 10: ticket.PurchaseTicketResponse.order:type_name -> ticket.Order
+	0,   // 11: ticket.GetTicketResponse.ticket:type_name -> ticket.Ticket
+	0,   // 12: ticket.ListTicketsResponse.tickets:type_name -> ticket.Ticket
+	0,   // 13: ticket.ConfirmTicketResponse.ticket:type_name -> ticket.Ticket
+	0,   // 14: ticket.CancelTicketResponse.ticket:type_name -> ticket.Ticket
+	0,   // 15: ticket.RefundTicketResponse.ticket:type_name -> ticket.Ticket
+	84,  // 16: ticket.GetCancellationJobResponse.job:type_name -> ticket.CancellationJob
+	18,  // 17: ticket.Order.items:type_name -> ticket.OrderItem
+	2,   // 18: ticket.Order.breakdown:type_name -> ticket.PriceBreakdown
+	113, // 19: ticket.Order.created_at:type_name -> google.protobuf.Timestamp
+	113, // 20: ticket.Order.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 21: ticket.OrderItem.breakdown:type_name -> ticket.PriceBreakdown
+	20,  // 22: ticket.CreateOrderRequest.items:type_name -> ticket.CreateOrderItem
+	17,  // 23: ticket.CreateOrderResponse.order:type_name -> ticket.Order
+	0,   // 24: ticket.CreateOrderResponse.tickets:type_name -> ticket.Ticket
+	17,  // 25: ticket.GetOrderResponse.order:type_name -> ticket.Order
+	0,   // 26: ticket.GetOrderResponse.tickets:type_name -> ticket.Ticket
+	17,  // 27: ticket.ListOrdersResponse.orders:type_name -> ticket.Order
+	113, // 28: ticket.GetTicketCodeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	113, // 29: ticket.TicketSigningKey.created_at:type_name -> google.protobuf.Timestamp
+	113, // 30: ticket.TicketSigningKey.retired_at:type_name -> google.protobuf.Timestamp
+	28,  // 31: ticket.RotateTicketSigningKeyResponse.key:type_name -> ticket.TicketSigningKey
+	28,  // 32: ticket.ListTicketSigningKeysResponse.keys:type_name -> ticket.TicketSigningKey
+	28,  // 33: ticket.RevokeTicketSigningKeyResponse.key:type_name -> ticket.TicketSigningKey
+	113, // 34: ticket.CheckIn.scanned_at:type_name -> google.protobuf.Timestamp
+	113, // 35: ticket.CheckIn.created_at:type_name -> google.protobuf.Timestamp
+	35,  // 36: ticket.CheckInTicketResponse.check_in:type_name -> ticket.CheckIn
+	0,   // 37: ticket.CheckInTicketResponse.ticket:type_name -> ticket.Ticket
+	113, // 38: ticket.OfflineScan.scanned_at:type_name -> google.protobuf.Timestamp
+	38,  // 39: ticket.SyncCheckInsRequest.scans:type_name -> ticket.OfflineScan
+	35,  // 40: ticket.SyncCheckInsResponse.check_ins:type_name -> ticket.CheckIn
+	113, // 41: ticket.EntryPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	41,  // 42: ticket.SetEntryPolicyRequest.entry_policy:type_name -> ticket.EntryPolicy
+	41,  // 43: ticket.SetEntryPolicyResponse.entry_policy:type_name -> ticket.EntryPolicy
+	41,  // 44: ticket.GetEntryPolicyResponse.entry_policy:type_name -> ticket.EntryPolicy
+	113, // 45: ticket.TicketTransfer.created_at:type_name -> google.protobuf.Timestamp
+	113, // 46: ticket.TicketTransfer.updated_at:type_name -> google.protobuf.Timestamp
+	46,  // 47: ticket.InitiateTicketTransferResponse.transfer:type_name -> ticket.TicketTransfer
+	46,  // 48: ticket.AcceptTicketTransferResponse.transfer:type_name -> ticket.TicketTransfer
+	0,   // 49: ticket.AcceptTicketTransferResponse.ticket:type_name -> ticket.Ticket
+	113, // 50: ticket.AcceptTicketTransferResponse.code_expires_at:type_name -> google.protobuf.Timestamp
+	46,  // 51: ticket.CancelTicketTransferResponse.transfer:type_name -> ticket.TicketTransfer
+	46,  // 52: ticket.GetTicketTransferResponse.transfer:type_name -> ticket.TicketTransfer
+	46,  // 53: ticket.ListTicketTransfersResponse.transfers:type_name -> ticket.TicketTransfer
+	113, // 54: ticket.Listing.created_at:type_name -> google.protobuf.Timestamp
+	113, // 55: ticket.Listing.updated_at:type_name -> google.protobuf.Timestamp
+	113, // 56: ticket.Listing.sold_at:type_name -> google.protobuf.Timestamp
+	113, // 57: ticket.ResalePolicy.updated_at:type_name -> google.protobuf.Timestamp
+	57,  // 58: ticket.CreateListingResponse.listing:type_name -> ticket.Listing
+	57,  // 59: ticket.GetListingResponse.listing:type_name -> ticket.Listing
+	57,  // 60: ticket.ListListingsResponse.listings:type_name -> ticket.Listing
+	57,  // 61: ticket.WithdrawListingResponse.listing:type_name -> ticket.Listing
+	57,  // 62: ticket.BuyListingResponse.listing:type_name -> ticket.Listing
+	17,  // 63: ticket.BuyListingResponse.order:type_name -> ticket.Order
+	0,   // 64: ticket.BuyListingResponse.ticket:type_name -> ticket.Ticket
+	113, // 65: ticket.BuyListingResponse.code_expires_at:type_name -> google.protobuf.Timestamp
+	58,  // 66: ticket.SetResalePolicyRequest.resale_policy:type_name -> ticket.ResalePolicy
+	58,  // 67: ticket.SetResalePolicyResponse.resale_policy:type_name -> ticket.ResalePolicy
+	58,  // 68: ticket.GetResalePolicyResponse.resale_policy:type_name -> ticket.ResalePolicy
+	113, // 69: ticket.WaitlistEntry.offered_at:type_name -> google.protobuf.Timestamp
+	113, // 70: ticket.WaitlistEntry.offer_expires_at:type_name -> google.protobuf.Timestamp
+	113, // 71: ticket.WaitlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	113, // 72: ticket.WaitlistEntry.updated_at:type_name -> google.protobuf.Timestamp
+	73,  // 73: ticket.JoinWaitlistResponse.entry:type_name -> ticket.WaitlistEntry
+	73,  // 74: ticket.GetWaitlistEntryResponse.entry:type_name -> ticket.WaitlistEntry
+	73,  // 75: ticket.ListWaitlistEntriesResponse.entries:type_name -> ticket.WaitlistEntry
+	73,  // 76: ticket.LeaveWaitlistResponse.entry:type_name -> ticket.WaitlistEntry
+	73,  // 77: ticket.ClaimWaitlistOfferResponse.entry:type_name -> ticket.WaitlistEntry
+	17,  // 78: ticket.ClaimWaitlistOfferResponse.order:type_name -> ticket.Order
+	0,   // 79: ticket.ClaimWaitlistOfferResponse.tickets:type_name -> ticket.Ticket
+	85,  // 80: ticket.CancellationJob.failures:type_name -> ticket.CancellationFailure
+	113, // 81: ticket.CancellationJob.created_at:type_name -> google.protobuf.Timestamp
+	113, // 82: ticket.CancellationJob.updated_at:type_name -> google.protobuf.Timestamp
+	113, // 83: ticket.CancellationJob.completed_at:type_name -> google.protobuf.Timestamp
+	113, // 84: ticket.PromoCode.expires_at:type_name -> google.protobuf.Timestamp
+	113, // 85: ticket.PromoCode.created_at:type_name -> google.protobuf.Timestamp
+	113, // 86: ticket.PromoCode.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 87: ticket.CreatePromoCodeRequest.promo_code:type_name -> ticket.PromoCode
+	86,  // 88: ticket.CreatePromoCodeResponse.promo_code:type_name -> ticket.PromoCode
+	86,  // 89: ticket.GetPromoCodeResponse.promo_code:type_name -> ticket.PromoCode
+	86,  // 90: ticket.ListPromoCodesResponse.promo_codes:type_name -> ticket.PromoCode
+	86,  // 91: ticket.UpdatePromoCodeRequest.promo_code:type_name -> ticket.PromoCode
+	86,  // 92: ticket.UpdatePromoCodeResponse.promo_code:type_name -> ticket.PromoCode
+	99,  // 93: ticket.QuoteOrderResponse.quote:type_name -> ticket.Quote
+	100, // 94: ticket.Quote.line_items:type_name -> ticket.QuoteLineItem
+	101, // 95: ticket.Quote.discounts:type_name -> ticket.QuoteDiscount
+	2,   // 96: ticket.Quote.breakdown:type_name -> ticket.PriceBreakdown
+	113, // 97: ticket.FeeSchedule.updated_at:type_name -> google.protobuf.Timestamp
+	102, // 98: ticket.SetFeeScheduleRequest.fee_schedule:type_name -> ticket.FeeSchedule
+	102, // 99: ticket.SetFeeScheduleResponse.fee_schedule:type_name -> ticket.FeeSchedule
+	102, // 100: ticket.GetFeeScheduleResponse.fee_schedule:type_name -> ticket.FeeSchedule
+	113, // 101: ticket.TaxRate.updated_at:type_name -> google.protobuf.Timestamp
+	107, // 102: ticket.SetTaxRateRequest.tax_rate:type_name -> ticket.TaxRate
+	107, // 103: ticket.SetTaxRateResponse.tax_rate:type_name -> ticket.TaxRate
+	107, // 104: ticket.ListTaxRatesResponse.tax_rates:type_name -> ticket.TaxRate
+	113, // 105: ticket.TicketEvent.offer_expires_at:type_name -> google.protobuf.Timestamp
+	3,   // 106: ticket.TicketService.PurchaseTicket:input_type -> ticket.PurchaseTicketRequest
+	19,  // 107: ticket.TicketService.CreateOrder:input_type -> ticket.CreateOrderRequest
+	22,  // 108: ticket.TicketService.GetOrder:input_type -> ticket.GetOrderRequest
+	24,  // 109: ticket.TicketService.ListOrders:input_type -> ticket.ListOrdersRequest
+	5,   // 110: ticket.TicketService.GetTicket:input_type -> ticket.GetTicketRequest
+	7,   // 111: ticket.TicketService.ListTickets:input_type -> ticket.ListTicketsRequest
+	26,  // 112: ticket.TicketService.GetTicketCode:input_type -> ticket.GetTicketCodeRequest
+	9,   // 113: ticket.TicketService.ConfirmTicket:input_type -> ticket.ConfirmTicketRequest
+	11,  // 114: ticket.TicketService.CancelTicket:input_type -> ticket.CancelTicketRequest
+	13,  // 115: ticket.TicketService.RefundTicket:input_type -> ticket.RefundTicketRequest
+	15,  // 116: ticket.TicketService.GetCancellationJob:input_type -> ticket.GetCancellationJobRequest
+	87,  // 117: ticket.TicketService.CreatePromoCode:input_type -> ticket.CreatePromoCodeRequest
+	89,  // 118: ticket.TicketService.GetPromoCode:input_type -> ticket.GetPromoCodeRequest
+	91,  // 119: ticket.TicketService.ListPromoCodes:input_type -> ticket.ListPromoCodesRequest
+	93,  // 120: ticket.TicketService.UpdatePromoCode:input_type -> ticket.UpdatePromoCodeRequest
+	95,  // 121: ticket.TicketService.DeletePromoCode:input_type -> ticket.DeletePromoCodeRequest
+	97,  // 122: ticket.TicketService.QuoteOrder:input_type -> ticket.QuoteOrderRequest
+	103, // 123: ticket.TicketService.SetFeeSchedule:input_type -> ticket.SetFeeScheduleRequest
+	105, // 124: ticket.TicketService.GetFeeSchedule:input_type -> ticket.GetFeeScheduleRequest
+	108, // 125: ticket.TicketService.SetTaxRate:input_type -> ticket.SetTaxRateRequest
+	110, // 126: ticket.TicketService.ListTaxRates:input_type -> ticket.ListTaxRatesRequest
+	29,  // 127: ticket.TicketService.RotateTicketSigningKey:input_type -> ticket.RotateTicketSigningKeyRequest
+	31,  // 128: ticket.TicketService.ListTicketSigningKeys:input_type -> ticket.ListTicketSigningKeysRequest
+	33,  // 129: ticket.TicketService.RevokeTicketSigningKey:input_type -> ticket.RevokeTicketSigningKeyRequest
+	36,  // 130: ticket.TicketService.CheckInTicket:input_type -> ticket.CheckInTicketRequest
+	39,  // 131: ticket.TicketService.SyncCheckIns:input_type -> ticket.SyncCheckInsRequest
+	42,  // 132: ticket.TicketService.SetEntryPolicy:input_type -> ticket.SetEntryPolicyRequest
+	44,  // 133: ticket.TicketService.GetEntryPolicy:input_type -> ticket.GetEntryPolicyRequest
+	47,  // 134: ticket.TicketService.InitiateTicketTransfer:input_type -> ticket.InitiateTicketTransferRequest
+	49,  // 135: ticket.TicketService.AcceptTicketTransfer:input_type -> ticket.AcceptTicketTransferRequest
+	51,  // 136: ticket.TicketService.CancelTicketTransfer:input_type -> ticket.CancelTicketTransferRequest
+	53,  // 137: ticket.TicketService.GetTicketTransfer:input_type -> ticket.GetTicketTransferRequest
+	55,  // 138: ticket.TicketService.ListTicketTransfers:input_type -> ticket.ListTicketTransfersRequest
+	59,  // 139: ticket.TicketService.CreateListing:input_type -> ticket.CreateListingRequest
+	61,  // 140: ticket.TicketService.GetListing:input_type -> ticket.GetListingRequest
+	63,  // 141: ticket.TicketService.ListListings:input_type -> ticket.ListListingsRequest
+	65,  // 142: ticket.TicketService.WithdrawListing:input_type -> ticket.WithdrawListingRequest
+	67,  // 143: ticket.TicketService.BuyListing:input_type -> ticket.BuyListingRequest
+	69,  // 144: ticket.TicketService.SetResalePolicy:input_type -> ticket.SetResalePolicyRequest
+	71,  // 145: ticket.TicketService.GetResalePolicy:input_type -> ticket.GetResalePolicyRequest
+	74,  // 146: ticket.TicketService.JoinWaitlist:input_type -> ticket.JoinWaitlistRequest
+	76,  // 147: ticket.TicketService.GetWaitlistEntry:input_type -> ticket.GetWaitlistEntryRequest
+	78,  // 148: ticket.TicketService.ListWaitlistEntries:input_type -> ticket.ListWaitlistEntriesRequest
+	80,  // 149: ticket.TicketService.LeaveWaitlist:input_type -> ticket.LeaveWaitlistRequest
+	82,  // 150: ticket.TicketService.ClaimWaitlistOffer:input_type -> ticket.ClaimWaitlistOfferRequest
+	4,   // 151: ticket.TicketService.PurchaseTicket:output_type -> ticket.PurchaseTicketResponse
+	21,  // 152: ticket.TicketService.CreateOrder:output_type -> ticket.CreateOrderResponse
+	23,  // 153: ticket.TicketService.GetOrder:output_type -> ticket.GetOrderResponse
+	25,  // 154: ticket.TicketService.ListOrders:output_type -> ticket.ListOrdersResponse
+	6,   // 155: ticket.TicketService.GetTicket:output_type -> ticket.GetTicketResponse
+	8,   // 156: ticket.TicketService.ListTickets:output_type -> ticket.ListTicketsResponse
+	27,  // 157: ticket.TicketService.GetTicketCode:output_type -> ticket.GetTicketCodeResponse
+	10,  // 158: ticket.TicketService.ConfirmTicket:output_type -> ticket.ConfirmTicketResponse
+	12,  // 159: ticket.TicketService.CancelTicket:output_type -> ticket.CancelTicketResponse
+	14,  // 160: ticket.TicketService.RefundTicket:output_type -> ticket.RefundTicketResponse
+	16,  // 161: ticket.TicketService.GetCancellationJob:output_type -> ticket.GetCancellationJobResponse
+	88,  // 162: ticket.TicketService.CreatePromoCode:output_type -> ticket.CreatePromoCodeResponse
+	90,  // 163: ticket.TicketService.GetPromoCode:output_type -> ticket.GetPromoCodeResponse
+	92,  // 164: ticket.TicketService.ListPromoCodes:output_type -> ticket.ListPromoCodesResponse
+	94,  // 165: ticket.TicketService.UpdatePromoCode:output_type -> ticket.UpdatePromoCodeResponse
+	96,  // 166: ticket.TicketService.DeletePromoCode:output_type -> ticket.DeletePromoCodeResponse
+	98,  // 167: ticket.TicketService.QuoteOrder:output_type -> ticket.QuoteOrderResponse
+	104, // 168: ticket.TicketService.SetFeeSchedule:output_type -> ticket.SetFeeScheduleResponse
+	106, // 169: ticket.TicketService.GetFeeSchedule:output_type -> ticket.GetFeeScheduleResponse
+	109, // 170: ticket.TicketService.SetTaxRate:output_type -> ticket.SetTaxRateResponse
+	111, // 171: ticket.TicketService.ListTaxRates:output_type -> ticket.ListTaxRatesResponse
+	30,  // 172: ticket.TicketService.RotateTicketSigningKey:output_type -> ticket.RotateTicketSigningKeyResponse
+	32,  // 173: ticket.TicketService.ListTicketSigningKeys:output_type -> ticket.ListTicketSigningKeysResponse
+	34,  // 174: ticket.TicketService.RevokeTicketSigningKey:output_type -> ticket.RevokeTicketSigningKeyResponse
+	37,  // 175: ticket.TicketService.CheckInTicket:output_type -> ticket.CheckInTicketResponse
+	40,  // 176: ticket.TicketService.SyncCheckIns:output_type -> ticket.SyncCheckInsResponse
+	43,  // 177: ticket.TicketService.SetEntryPolicy:output_type -> ticket.SetEntryPolicyResponse
+	45,  // 178: ticket.TicketService.GetEntryPolicy:output_type -> ticket.GetEntryPolicyResponse
+	48,  // 179: ticket.TicketService.InitiateTicketTransfer:output_type -> ticket.InitiateTicketTransferResponse
+	50,  // 180: ticket.TicketService.AcceptTicketTransfer:output_type -> ticket.AcceptTicketTransferResponse
+	52,  // 181: ticket.TicketService.CancelTicketTransfer:output_type -> ticket.CancelTicketTransferResponse
+	54,  // 182: ticket.TicketService.GetTicketTransfer:output_type -> ticket.GetTicketTransferResponse
+	56,  // 183: ticket.TicketService.ListTicketTransfers:output_type -> ticket.ListTicketTransfersResponse
+	60,  // 184: ticket.TicketService.CreateListing:output_type -> ticket.CreateListingResponse
+	62,  // 185: ticket.TicketService.GetListing:output_type -> ticket.GetListingResponse
+	64,  // 186: ticket.TicketService.ListListings:output_type -> ticket.ListListingsResponse
+	66,  // 187: ticket.TicketService.WithdrawListing:output_type -> ticket.WithdrawListingResponse
+	68,  // 188: ticket.TicketService.BuyListing:output_type -> ticket.BuyListingResponse
+	70,  // 189: ticket.TicketService.SetResalePolicy:output_type -> ticket.SetResalePolicyResponse
+	72,  // 190: ticket.TicketService.GetResalePolicy:output_type -> ticket.GetResalePolicyResponse
+	75,  // 191: ticket.TicketService.JoinWaitlist:output_type -> ticket.JoinWaitlistResponse
+	77,  // 192: ticket.TicketService.GetWaitlistEntry:output_type -> ticket.GetWaitlistEntryResponse
+	79,  // 193: ticket.TicketService.ListWaitlistEntries:output_type -> ticket.ListWaitlistEntriesResponse
+	81,  // 194: ticket.TicketService.LeaveWaitlist:output_type -> ticket.LeaveWaitlistResponse
+	83,  // 195: ticket.TicketService.ClaimWaitlistOffer:output_type -> ticket.ClaimWaitlistOfferResponse
+	151, // [151:196] is the sub-list for method output_type
+	106, // [106:151] is the sub-list for method input_type
+	106, // [106:106] is the sub-list for extension type_name
+	106, // [106:106] is the sub-list for extension extendee
+	0,   // [0:106] is the sub-list for field type_name
 }
 
 func init() { file_ticket_ticket_proto_init() }
@@ -351,7 +8184,7 @@ func file_ticket_ticket_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ticket_ticket_proto_rawDesc), len(file_ticket_ticket_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   113,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,51 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TicketService_PurchaseTicket_FullMethodName = "/ticket.TicketService/PurchaseTicket"
-	TicketService_GetTicket_FullMethodName      = "/ticket.TicketService/GetTicket"
+	TicketService_PurchaseTicket_FullMethodName         = "/ticket.TicketService/PurchaseTicket"
+	TicketService_CreateOrder_FullMethodName            = "/ticket.TicketService/CreateOrder"
+	TicketService_GetOrder_FullMethodName               = "/ticket.TicketService/GetOrder"
+	TicketService_ListOrders_FullMethodName             = "/ticket.TicketService/ListOrders"
+	TicketService_GetTicket_FullMethodName              = "/ticket.TicketService/GetTicket"
+	TicketService_ListTickets_FullMethodName            = "/ticket.TicketService/ListTickets"
+	TicketService_GetTicketCode_FullMethodName          = "/ticket.TicketService/GetTicketCode"
+	TicketService_ConfirmTicket_FullMethodName          = "/ticket.TicketService/ConfirmTicket"
+	TicketService_CancelTicket_FullMethodName           = "/ticket.TicketService/CancelTicket"
+	TicketService_RefundTicket_FullMethodName           = "/ticket.TicketService/RefundTicket"
+	TicketService_GetCancellationJob_FullMethodName     = "/ticket.TicketService/GetCancellationJob"
+	TicketService_CreatePromoCode_FullMethodName        = "/ticket.TicketService/CreatePromoCode"
+	TicketService_GetPromoCode_FullMethodName           = "/ticket.TicketService/GetPromoCode"
+	TicketService_ListPromoCodes_FullMethodName         = "/ticket.TicketService/ListPromoCodes"
+	TicketService_UpdatePromoCode_FullMethodName        = "/ticket.TicketService/UpdatePromoCode"
+	TicketService_DeletePromoCode_FullMethodName        = "/ticket.TicketService/DeletePromoCode"
+	TicketService_QuoteOrder_FullMethodName             = "/ticket.TicketService/QuoteOrder"
+	TicketService_SetFeeSchedule_FullMethodName         = "/ticket.TicketService/SetFeeSchedule"
+	TicketService_GetFeeSchedule_FullMethodName         = "/ticket.TicketService/GetFeeSchedule"
+	TicketService_SetTaxRate_FullMethodName             = "/ticket.TicketService/SetTaxRate"
+	TicketService_ListTaxRates_FullMethodName           = "/ticket.TicketService/ListTaxRates"
+	TicketService_RotateTicketSigningKey_FullMethodName = "/ticket.TicketService/RotateTicketSigningKey"
+	TicketService_ListTicketSigningKeys_FullMethodName  = "/ticket.TicketService/ListTicketSigningKeys"
+	TicketService_RevokeTicketSigningKey_FullMethodName = "/ticket.TicketService/RevokeTicketSigningKey"
+	TicketService_CheckInTicket_FullMethodName          = "/ticket.TicketService/CheckInTicket"
+	TicketService_SyncCheckIns_FullMethodName           = "/ticket.TicketService/SyncCheckIns"
+	TicketService_SetEntryPolicy_FullMethodName         = "/ticket.TicketService/SetEntryPolicy"
+	TicketService_GetEntryPolicy_FullMethodName         = "/ticket.TicketService/GetEntryPolicy"
+	TicketService_InitiateTicketTransfer_FullMethodName = "/ticket.TicketService/InitiateTicketTransfer"
+	TicketService_AcceptTicketTransfer_FullMethodName   = "/ticket.TicketService/AcceptTicketTransfer"
+	TicketService_CancelTicketTransfer_FullMethodName   = "/ticket.TicketService/CancelTicketTransfer"
+	TicketService_GetTicketTransfer_FullMethodName      = "/ticket.TicketService/GetTicketTransfer"
+	TicketService_ListTicketTransfers_FullMethodName    = "/ticket.TicketService/ListTicketTransfers"
+	TicketService_CreateListing_FullMethodName          = "/ticket.TicketService/CreateListing"
+	TicketService_GetListing_FullMethodName             = "/ticket.TicketService/GetListing"
+	TicketService_ListListings_FullMethodName           = "/ticket.TicketService/ListListings"
+	TicketService_WithdrawListing_FullMethodName        = "/ticket.TicketService/WithdrawListing"
+	TicketService_BuyListing_FullMethodName             = "/ticket.TicketService/BuyListing"
+	TicketService_SetResalePolicy_FullMethodName        = "/ticket.TicketService/SetResalePolicy"
+	TicketService_GetResalePolicy_FullMethodName        = "/ticket.TicketService/GetResalePolicy"
+	TicketService_JoinWaitlist_FullMethodName           = "/ticket.TicketService/JoinWaitlist"
+	TicketService_GetWaitlistEntry_FullMethodName       = "/ticket.TicketService/GetWaitlistEntry"
+	TicketService_ListWaitlistEntries_FullMethodName    = "/ticket.TicketService/ListWaitlistEntries"
+	TicketService_LeaveWaitlist_FullMethodName          = "/ticket.TicketService/LeaveWaitlist"
+	TicketService_ClaimWaitlistOffer_FullMethodName     = "/ticket.TicketService/ClaimWaitlistOffer"
 )
 
 // TicketServiceClient is the client API for TicketService service.
@@ -28,7 +71,50 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TicketServiceClient interface {
 	PurchaseTicket(ctx context.Context, in *PurchaseTicketRequest, opts ...grpc.CallOption) (*PurchaseTicketResponse, error)
+	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*GetTicketResponse, error)
+	ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error)
+	GetTicketCode(ctx context.Context, in *GetTicketCodeRequest, opts ...grpc.CallOption) (*GetTicketCodeResponse, error)
+	ConfirmTicket(ctx context.Context, in *ConfirmTicketRequest, opts ...grpc.CallOption) (*ConfirmTicketResponse, error)
+	CancelTicket(ctx context.Context, in *CancelTicketRequest, opts ...grpc.CallOption) (*CancelTicketResponse, error)
+	RefundTicket(ctx context.Context, in *RefundTicketRequest, opts ...grpc.CallOption) (*RefundTicketResponse, error)
+	GetCancellationJob(ctx context.Context, in *GetCancellationJobRequest, opts ...grpc.CallOption) (*GetCancellationJobResponse, error)
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error)
+	GetPromoCode(ctx context.Context, in *GetPromoCodeRequest, opts ...grpc.CallOption) (*GetPromoCodeResponse, error)
+	ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*ListPromoCodesResponse, error)
+	UpdatePromoCode(ctx context.Context, in *UpdatePromoCodeRequest, opts ...grpc.CallOption) (*UpdatePromoCodeResponse, error)
+	DeletePromoCode(ctx context.Context, in *DeletePromoCodeRequest, opts ...grpc.CallOption) (*DeletePromoCodeResponse, error)
+	QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error)
+	SetFeeSchedule(ctx context.Context, in *SetFeeScheduleRequest, opts ...grpc.CallOption) (*SetFeeScheduleResponse, error)
+	GetFeeSchedule(ctx context.Context, in *GetFeeScheduleRequest, opts ...grpc.CallOption) (*GetFeeScheduleResponse, error)
+	SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*SetTaxRateResponse, error)
+	ListTaxRates(ctx context.Context, in *ListTaxRatesRequest, opts ...grpc.CallOption) (*ListTaxRatesResponse, error)
+	RotateTicketSigningKey(ctx context.Context, in *RotateTicketSigningKeyRequest, opts ...grpc.CallOption) (*RotateTicketSigningKeyResponse, error)
+	ListTicketSigningKeys(ctx context.Context, in *ListTicketSigningKeysRequest, opts ...grpc.CallOption) (*ListTicketSigningKeysResponse, error)
+	RevokeTicketSigningKey(ctx context.Context, in *RevokeTicketSigningKeyRequest, opts ...grpc.CallOption) (*RevokeTicketSigningKeyResponse, error)
+	CheckInTicket(ctx context.Context, in *CheckInTicketRequest, opts ...grpc.CallOption) (*CheckInTicketResponse, error)
+	SyncCheckIns(ctx context.Context, in *SyncCheckInsRequest, opts ...grpc.CallOption) (*SyncCheckInsResponse, error)
+	SetEntryPolicy(ctx context.Context, in *SetEntryPolicyRequest, opts ...grpc.CallOption) (*SetEntryPolicyResponse, error)
+	GetEntryPolicy(ctx context.Context, in *GetEntryPolicyRequest, opts ...grpc.CallOption) (*GetEntryPolicyResponse, error)
+	InitiateTicketTransfer(ctx context.Context, in *InitiateTicketTransferRequest, opts ...grpc.CallOption) (*InitiateTicketTransferResponse, error)
+	AcceptTicketTransfer(ctx context.Context, in *AcceptTicketTransferRequest, opts ...grpc.CallOption) (*AcceptTicketTransferResponse, error)
+	CancelTicketTransfer(ctx context.Context, in *CancelTicketTransferRequest, opts ...grpc.CallOption) (*CancelTicketTransferResponse, error)
+	GetTicketTransfer(ctx context.Context, in *GetTicketTransferRequest, opts ...grpc.CallOption) (*GetTicketTransferResponse, error)
+	ListTicketTransfers(ctx context.Context, in *ListTicketTransfersRequest, opts ...grpc.CallOption) (*ListTicketTransfersResponse, error)
+	CreateListing(ctx context.Context, in *CreateListingRequest, opts ...grpc.CallOption) (*CreateListingResponse, error)
+	GetListing(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*GetListingResponse, error)
+	ListListings(ctx context.Context, in *ListListingsRequest, opts ...grpc.CallOption) (*ListListingsResponse, error)
+	WithdrawListing(ctx context.Context, in *WithdrawListingRequest, opts ...grpc.CallOption) (*WithdrawListingResponse, error)
+	BuyListing(ctx context.Context, in *BuyListingRequest, opts ...grpc.CallOption) (*BuyListingResponse, error)
+	SetResalePolicy(ctx context.Context, in *SetResalePolicyRequest, opts ...grpc.CallOption) (*SetResalePolicyResponse, error)
+	GetResalePolicy(ctx context.Context, in *GetResalePolicyRequest, opts ...grpc.CallOption) (*GetResalePolicyResponse, error)
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
+	GetWaitlistEntry(ctx context.Context, in *GetWaitlistEntryRequest, opts ...grpc.CallOption) (*GetWaitlistEntryResponse, error)
+	ListWaitlistEntries(ctx context.Context, in *ListWaitlistEntriesRequest, opts ...grpc.CallOption) (*ListWaitlistEntriesResponse, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	ClaimWaitlistOffer(ctx context.Context, in *ClaimWaitlistOfferRequest, opts ...grpc.CallOption) (*ClaimWaitlistOfferResponse, error)
 }
 
 type ticketServiceClient struct {
@@ -49,6 +135,36 @@ func (c *ticketServiceClient) PurchaseTicket(ctx context.Context, in *PurchaseTi
 	return out, nil
 }
 
+func (c *ticketServiceClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrderResponse)
+	err := c.cc.Invoke(ctx, TicketService_CreateOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ticketServiceClient) GetTicket(ctx context.Context, in *GetTicketRequest, opts ...grpc.CallOption) (*GetTicketResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTicketResponse)
@@ -56,84 +172,1430 @@ func (c *ticketServiceClient) GetTicket(ctx context.Context, in *GetTicketReques
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketsResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetTicketCode(ctx context.Context, in *GetTicketCodeRequest, opts ...grpc.CallOption) (*GetTicketCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketCodeResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetTicketCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ConfirmTicket(ctx context.Context, in *ConfirmTicketRequest, opts ...grpc.CallOption) (*ConfirmTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_ConfirmTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CancelTicket(ctx context.Context, in *CancelTicketRequest, opts ...grpc.CallOption) (*CancelTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_CancelTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) RefundTicket(ctx context.Context, in *RefundTicketRequest, opts ...grpc.CallOption) (*RefundTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_RefundTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetCancellationJob(ctx context.Context, in *GetCancellationJobRequest, opts ...grpc.CallOption) (*GetCancellationJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCancellationJobResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetCancellationJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromoCodeResponse)
+	err := c.cc.Invoke(ctx, TicketService_CreatePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetPromoCode(ctx context.Context, in *GetPromoCodeRequest, opts ...grpc.CallOption) (*GetPromoCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPromoCodeResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetPromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*ListPromoCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromoCodesResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListPromoCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) UpdatePromoCode(ctx context.Context, in *UpdatePromoCodeRequest, opts ...grpc.CallOption) (*UpdatePromoCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePromoCodeResponse)
+	err := c.cc.Invoke(ctx, TicketService_UpdatePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) DeletePromoCode(ctx context.Context, in *DeletePromoCodeRequest, opts ...grpc.CallOption) (*DeletePromoCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePromoCodeResponse)
+	err := c.cc.Invoke(ctx, TicketService_DeletePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteOrderResponse)
+	err := c.cc.Invoke(ctx, TicketService_QuoteOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) SetFeeSchedule(ctx context.Context, in *SetFeeScheduleRequest, opts ...grpc.CallOption) (*SetFeeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, TicketService_SetFeeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetFeeSchedule(ctx context.Context, in *GetFeeScheduleRequest, opts ...grpc.CallOption) (*GetFeeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetFeeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) SetTaxRate(ctx context.Context, in *SetTaxRateRequest, opts ...grpc.CallOption) (*SetTaxRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTaxRateResponse)
+	err := c.cc.Invoke(ctx, TicketService_SetTaxRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListTaxRates(ctx context.Context, in *ListTaxRatesRequest, opts ...grpc.CallOption) (*ListTaxRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaxRatesResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListTaxRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) RotateTicketSigningKey(ctx context.Context, in *RotateTicketSigningKeyRequest, opts ...grpc.CallOption) (*RotateTicketSigningKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateTicketSigningKeyResponse)
+	err := c.cc.Invoke(ctx, TicketService_RotateTicketSigningKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListTicketSigningKeys(ctx context.Context, in *ListTicketSigningKeysRequest, opts ...grpc.CallOption) (*ListTicketSigningKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketSigningKeysResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListTicketSigningKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) RevokeTicketSigningKey(ctx context.Context, in *RevokeTicketSigningKeyRequest, opts ...grpc.CallOption) (*RevokeTicketSigningKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTicketSigningKeyResponse)
+	err := c.cc.Invoke(ctx, TicketService_RevokeTicketSigningKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CheckInTicket(ctx context.Context, in *CheckInTicketRequest, opts ...grpc.CallOption) (*CheckInTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInTicketResponse)
+	err := c.cc.Invoke(ctx, TicketService_CheckInTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) SyncCheckIns(ctx context.Context, in *SyncCheckInsRequest, opts ...grpc.CallOption) (*SyncCheckInsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncCheckInsResponse)
+	err := c.cc.Invoke(ctx, TicketService_SyncCheckIns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) SetEntryPolicy(ctx context.Context, in *SetEntryPolicyRequest, opts ...grpc.CallOption) (*SetEntryPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEntryPolicyResponse)
+	err := c.cc.Invoke(ctx, TicketService_SetEntryPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetEntryPolicy(ctx context.Context, in *GetEntryPolicyRequest, opts ...grpc.CallOption) (*GetEntryPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEntryPolicyResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetEntryPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) InitiateTicketTransfer(ctx context.Context, in *InitiateTicketTransferRequest, opts ...grpc.CallOption) (*InitiateTicketTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InitiateTicketTransferResponse)
+	err := c.cc.Invoke(ctx, TicketService_InitiateTicketTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) AcceptTicketTransfer(ctx context.Context, in *AcceptTicketTransferRequest, opts ...grpc.CallOption) (*AcceptTicketTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptTicketTransferResponse)
+	err := c.cc.Invoke(ctx, TicketService_AcceptTicketTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CancelTicketTransfer(ctx context.Context, in *CancelTicketTransferRequest, opts ...grpc.CallOption) (*CancelTicketTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelTicketTransferResponse)
+	err := c.cc.Invoke(ctx, TicketService_CancelTicketTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetTicketTransfer(ctx context.Context, in *GetTicketTransferRequest, opts ...grpc.CallOption) (*GetTicketTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketTransferResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetTicketTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListTicketTransfers(ctx context.Context, in *ListTicketTransfersRequest, opts ...grpc.CallOption) (*ListTicketTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketTransfersResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListTicketTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CreateListing(ctx context.Context, in *CreateListingRequest, opts ...grpc.CallOption) (*CreateListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateListingResponse)
+	err := c.cc.Invoke(ctx, TicketService_CreateListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetListing(ctx context.Context, in *GetListingRequest, opts ...grpc.CallOption) (*GetListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListingResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListListings(ctx context.Context, in *ListListingsRequest, opts ...grpc.CallOption) (*ListListingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListListingsResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListListings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) WithdrawListing(ctx context.Context, in *WithdrawListingRequest, opts ...grpc.CallOption) (*WithdrawListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawListingResponse)
+	err := c.cc.Invoke(ctx, TicketService_WithdrawListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) BuyListing(ctx context.Context, in *BuyListingRequest, opts ...grpc.CallOption) (*BuyListingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuyListingResponse)
+	err := c.cc.Invoke(ctx, TicketService_BuyListing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) SetResalePolicy(ctx context.Context, in *SetResalePolicyRequest, opts ...grpc.CallOption) (*SetResalePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetResalePolicyResponse)
+	err := c.cc.Invoke(ctx, TicketService_SetResalePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetResalePolicy(ctx context.Context, in *GetResalePolicyRequest, opts ...grpc.CallOption) (*GetResalePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResalePolicyResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetResalePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, TicketService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetWaitlistEntry(ctx context.Context, in *GetWaitlistEntryRequest, opts ...grpc.CallOption) (*GetWaitlistEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWaitlistEntryResponse)
+	err := c.cc.Invoke(ctx, TicketService_GetWaitlistEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListWaitlistEntries(ctx context.Context, in *ListWaitlistEntriesRequest, opts ...grpc.CallOption) (*ListWaitlistEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWaitlistEntriesResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListWaitlistEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, TicketService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ClaimWaitlistOffer(ctx context.Context, in *ClaimWaitlistOfferRequest, opts ...grpc.CallOption) (*ClaimWaitlistOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimWaitlistOfferResponse)
+	err := c.cc.Invoke(ctx, TicketService_ClaimWaitlistOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TicketServiceServer is the server API for TicketService service.
+// All implementations must embed UnimplementedTicketServiceServer
+// for forward compatibility.
+type TicketServiceServer interface {
+	PurchaseTicket(context.Context, *PurchaseTicketRequest) (*PurchaseTicketResponse, error)
+	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	GetTicket(context.Context, *GetTicketRequest) (*GetTicketResponse, error)
+	ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error)
+	GetTicketCode(context.Context, *GetTicketCodeRequest) (*GetTicketCodeResponse, error)
+	ConfirmTicket(context.Context, *ConfirmTicketRequest) (*ConfirmTicketResponse, error)
+	CancelTicket(context.Context, *CancelTicketRequest) (*CancelTicketResponse, error)
+	RefundTicket(context.Context, *RefundTicketRequest) (*RefundTicketResponse, error)
+	GetCancellationJob(context.Context, *GetCancellationJobRequest) (*GetCancellationJobResponse, error)
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error)
+	GetPromoCode(context.Context, *GetPromoCodeRequest) (*GetPromoCodeResponse, error)
+	ListPromoCodes(context.Context, *ListPromoCodesRequest) (*ListPromoCodesResponse, error)
+	UpdatePromoCode(context.Context, *UpdatePromoCodeRequest) (*UpdatePromoCodeResponse, error)
+	DeletePromoCode(context.Context, *DeletePromoCodeRequest) (*DeletePromoCodeResponse, error)
+	QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error)
+	SetFeeSchedule(context.Context, *SetFeeScheduleRequest) (*SetFeeScheduleResponse, error)
+	GetFeeSchedule(context.Context, *GetFeeScheduleRequest) (*GetFeeScheduleResponse, error)
+	SetTaxRate(context.Context, *SetTaxRateRequest) (*SetTaxRateResponse, error)
+	ListTaxRates(context.Context, *ListTaxRatesRequest) (*ListTaxRatesResponse, error)
+	RotateTicketSigningKey(context.Context, *RotateTicketSigningKeyRequest) (*RotateTicketSigningKeyResponse, error)
+	ListTicketSigningKeys(context.Context, *ListTicketSigningKeysRequest) (*ListTicketSigningKeysResponse, error)
+	RevokeTicketSigningKey(context.Context, *RevokeTicketSigningKeyRequest) (*RevokeTicketSigningKeyResponse, error)
+	CheckInTicket(context.Context, *CheckInTicketRequest) (*CheckInTicketResponse, error)
+	SyncCheckIns(context.Context, *SyncCheckInsRequest) (*SyncCheckInsResponse, error)
+	SetEntryPolicy(context.Context, *SetEntryPolicyRequest) (*SetEntryPolicyResponse, error)
+	GetEntryPolicy(context.Context, *GetEntryPolicyRequest) (*GetEntryPolicyResponse, error)
+	InitiateTicketTransfer(context.Context, *InitiateTicketTransferRequest) (*InitiateTicketTransferResponse, error)
+	AcceptTicketTransfer(context.Context, *AcceptTicketTransferRequest) (*AcceptTicketTransferResponse, error)
+	CancelTicketTransfer(context.Context, *CancelTicketTransferRequest) (*CancelTicketTransferResponse, error)
+	GetTicketTransfer(context.Context, *GetTicketTransferRequest) (*GetTicketTransferResponse, error)
+	ListTicketTransfers(context.Context, *ListTicketTransfersRequest) (*ListTicketTransfersResponse, error)
+	CreateListing(context.Context, *CreateListingRequest) (*CreateListingResponse, error)
+	GetListing(context.Context, *GetListingRequest) (*GetListingResponse, error)
+	ListListings(context.Context, *ListListingsRequest) (*ListListingsResponse, error)
+	WithdrawListing(context.Context, *WithdrawListingRequest) (*WithdrawListingResponse, error)
+	BuyListing(context.Context, *BuyListingRequest) (*BuyListingResponse, error)
+	SetResalePolicy(context.Context, *SetResalePolicyRequest) (*SetResalePolicyResponse, error)
+	GetResalePolicy(context.Context, *GetResalePolicyRequest) (*GetResalePolicyResponse, error)
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+	GetWaitlistEntry(context.Context, *GetWaitlistEntryRequest) (*GetWaitlistEntryResponse, error)
+	ListWaitlistEntries(context.Context, *ListWaitlistEntriesRequest) (*ListWaitlistEntriesResponse, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	ClaimWaitlistOffer(context.Context, *ClaimWaitlistOfferRequest) (*ClaimWaitlistOfferResponse, error)
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+// UnimplementedTicketServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTicketServiceServer struct{}
+
+func (UnimplementedTicketServiceServer) PurchaseTicket(context.Context, *PurchaseTicketRequest) (*PurchaseTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
+}
+func (UnimplementedTicketServiceServer) GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedTicketServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedTicketServiceServer) GetTicket(context.Context, *GetTicketRequest) (*GetTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickets not implemented")
+}
+func (UnimplementedTicketServiceServer) GetTicketCode(context.Context, *GetTicketCodeRequest) (*GetTicketCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketCode not implemented")
+}
+func (UnimplementedTicketServiceServer) ConfirmTicket(context.Context, *ConfirmTicketRequest) (*ConfirmTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelTicket(context.Context, *CancelTicketRequest) (*CancelTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) RefundTicket(context.Context, *RefundTicketRequest) (*RefundTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) GetCancellationJob(context.Context, *GetCancellationJobRequest) (*GetCancellationJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCancellationJob not implemented")
+}
+func (UnimplementedTicketServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedTicketServiceServer) GetPromoCode(context.Context, *GetPromoCodeRequest) (*GetPromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromoCode not implemented")
+}
+func (UnimplementedTicketServiceServer) ListPromoCodes(context.Context, *ListPromoCodesRequest) (*ListPromoCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromoCodes not implemented")
+}
+func (UnimplementedTicketServiceServer) UpdatePromoCode(context.Context, *UpdatePromoCodeRequest) (*UpdatePromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePromoCode not implemented")
+}
+func (UnimplementedTicketServiceServer) DeletePromoCode(context.Context, *DeletePromoCodeRequest) (*DeletePromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromoCode not implemented")
+}
+func (UnimplementedTicketServiceServer) QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteOrder not implemented")
+}
+func (UnimplementedTicketServiceServer) SetFeeSchedule(context.Context, *SetFeeScheduleRequest) (*SetFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSchedule not implemented")
+}
+func (UnimplementedTicketServiceServer) GetFeeSchedule(context.Context, *GetFeeScheduleRequest) (*GetFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeSchedule not implemented")
+}
+func (UnimplementedTicketServiceServer) SetTaxRate(context.Context, *SetTaxRateRequest) (*SetTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaxRate not implemented")
+}
+func (UnimplementedTicketServiceServer) ListTaxRates(context.Context, *ListTaxRatesRequest) (*ListTaxRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaxRates not implemented")
+}
+func (UnimplementedTicketServiceServer) RotateTicketSigningKey(context.Context, *RotateTicketSigningKeyRequest) (*RotateTicketSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateTicketSigningKey not implemented")
+}
+func (UnimplementedTicketServiceServer) ListTicketSigningKeys(context.Context, *ListTicketSigningKeysRequest) (*ListTicketSigningKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketSigningKeys not implemented")
+}
+func (UnimplementedTicketServiceServer) RevokeTicketSigningKey(context.Context, *RevokeTicketSigningKeyRequest) (*RevokeTicketSigningKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTicketSigningKey not implemented")
+}
+func (UnimplementedTicketServiceServer) CheckInTicket(context.Context, *CheckInTicketRequest) (*CheckInTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) SyncCheckIns(context.Context, *SyncCheckInsRequest) (*SyncCheckInsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncCheckIns not implemented")
+}
+func (UnimplementedTicketServiceServer) SetEntryPolicy(context.Context, *SetEntryPolicyRequest) (*SetEntryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEntryPolicy not implemented")
+}
+func (UnimplementedTicketServiceServer) GetEntryPolicy(context.Context, *GetEntryPolicyRequest) (*GetEntryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntryPolicy not implemented")
+}
+func (UnimplementedTicketServiceServer) InitiateTicketTransfer(context.Context, *InitiateTicketTransferRequest) (*InitiateTicketTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateTicketTransfer not implemented")
+}
+func (UnimplementedTicketServiceServer) AcceptTicketTransfer(context.Context, *AcceptTicketTransferRequest) (*AcceptTicketTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTicketTransfer not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelTicketTransfer(context.Context, *CancelTicketTransferRequest) (*CancelTicketTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTicketTransfer not implemented")
+}
+func (UnimplementedTicketServiceServer) GetTicketTransfer(context.Context, *GetTicketTransferRequest) (*GetTicketTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketTransfer not implemented")
+}
+func (UnimplementedTicketServiceServer) ListTicketTransfers(context.Context, *ListTicketTransfersRequest) (*ListTicketTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketTransfers not implemented")
+}
+func (UnimplementedTicketServiceServer) CreateListing(context.Context, *CreateListingRequest) (*CreateListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateListing not implemented")
+}
+func (UnimplementedTicketServiceServer) GetListing(context.Context, *GetListingRequest) (*GetListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListing not implemented")
+}
+func (UnimplementedTicketServiceServer) ListListings(context.Context, *ListListingsRequest) (*ListListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListListings not implemented")
+}
+func (UnimplementedTicketServiceServer) WithdrawListing(context.Context, *WithdrawListingRequest) (*WithdrawListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawListing not implemented")
+}
+func (UnimplementedTicketServiceServer) BuyListing(context.Context, *BuyListingRequest) (*BuyListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyListing not implemented")
+}
+func (UnimplementedTicketServiceServer) SetResalePolicy(context.Context, *SetResalePolicyRequest) (*SetResalePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetResalePolicy not implemented")
+}
+func (UnimplementedTicketServiceServer) GetResalePolicy(context.Context, *GetResalePolicyRequest) (*GetResalePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResalePolicy not implemented")
+}
+func (UnimplementedTicketServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedTicketServiceServer) GetWaitlistEntry(context.Context, *GetWaitlistEntryRequest) (*GetWaitlistEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlistEntry not implemented")
+}
+func (UnimplementedTicketServiceServer) ListWaitlistEntries(context.Context, *ListWaitlistEntriesRequest) (*ListWaitlistEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWaitlistEntries not implemented")
+}
+func (UnimplementedTicketServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedTicketServiceServer) ClaimWaitlistOffer(context.Context, *ClaimWaitlistOfferRequest) (*ClaimWaitlistOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimWaitlistOffer not implemented")
+}
+func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
+func (UnimplementedTicketServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeTicketServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TicketServiceServer will
+// result in compilation errors.
+type UnsafeTicketServiceServer interface {
+	mustEmbedUnimplementedTicketServiceServer()
+}
+
+func RegisterTicketServiceServer(s grpc.ServiceRegistrar, srv TicketServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTicketServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TicketService_ServiceDesc, srv)
+}
+
+func _TicketService_PurchaseTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).PurchaseTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_PurchaseTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).PurchaseTicket(ctx, req.(*PurchaseTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CreateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CreateOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CreateOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetTicket(ctx, req.(*GetTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListTickets(ctx, req.(*ListTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetTicketCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetTicketCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetTicketCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetTicketCode(ctx, req.(*GetTicketCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ConfirmTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ConfirmTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ConfirmTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ConfirmTicket(ctx, req.(*ConfirmTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CancelTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CancelTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelTicket(ctx, req.(*CancelTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_RefundTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).RefundTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_RefundTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).RefundTicket(ctx, req.(*RefundTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetCancellationJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCancellationJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetCancellationJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetCancellationJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetCancellationJob(ctx, req.(*GetCancellationJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CreatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetPromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetPromoCode(ctx, req.(*GetPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromoCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListPromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListPromoCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListPromoCodes(ctx, req.(*ListPromoCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_UpdatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).UpdatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_UpdatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).UpdatePromoCode(ctx, req.(*UpdatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_DeletePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).DeletePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_DeletePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).DeletePromoCode(ctx, req.(*DeletePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).QuoteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_QuoteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).QuoteOrder(ctx, req.(*QuoteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_SetFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SetFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_SetFeeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SetFeeSchedule(ctx, req.(*SetFeeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetFeeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetFeeSchedule(ctx, req.(*GetFeeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_SetTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SetTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_SetTaxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SetTaxRate(ctx, req.(*SetTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListTaxRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListTaxRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListTaxRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListTaxRates(ctx, req.(*ListTaxRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_RotateTicketSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTicketSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).RotateTicketSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_RotateTicketSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).RotateTicketSigningKey(ctx, req.(*RotateTicketSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListTicketSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketSigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListTicketSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListTicketSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListTicketSigningKeys(ctx, req.(*ListTicketSigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_RevokeTicketSigningKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTicketSigningKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).RevokeTicketSigningKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_RevokeTicketSigningKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).RevokeTicketSigningKey(ctx, req.(*RevokeTicketSigningKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CheckInTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CheckInTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CheckInTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CheckInTicket(ctx, req.(*CheckInTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_SyncCheckIns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncCheckInsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SyncCheckIns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_SyncCheckIns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SyncCheckIns(ctx, req.(*SyncCheckInsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_SetEntryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEntryPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SetEntryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_SetEntryPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SetEntryPolicy(ctx, req.(*SetEntryPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetEntryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntryPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetEntryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetEntryPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetEntryPolicy(ctx, req.(*GetEntryPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_InitiateTicketTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateTicketTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).InitiateTicketTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_InitiateTicketTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).InitiateTicketTransfer(ctx, req.(*InitiateTicketTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_AcceptTicketTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTicketTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).AcceptTicketTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_AcceptTicketTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).AcceptTicketTransfer(ctx, req.(*AcceptTicketTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CancelTicketTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTicketTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelTicketTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CancelTicketTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelTicketTransfer(ctx, req.(*CancelTicketTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetTicketTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetTicketTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetTicketTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetTicketTransfer(ctx, req.(*GetTicketTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListTicketTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListTicketTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListTicketTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListTicketTransfers(ctx, req.(*ListTicketTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// TicketServiceServer is the server API for TicketService service.
-// All implementations must embed UnimplementedTicketServiceServer
-// for forward compatibility.
-type TicketServiceServer interface {
-	PurchaseTicket(context.Context, *PurchaseTicketRequest) (*PurchaseTicketResponse, error)
-	GetTicket(context.Context, *GetTicketRequest) (*GetTicketResponse, error)
-	mustEmbedUnimplementedTicketServiceServer()
+func _TicketService_CreateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CreateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_CreateListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CreateListing(ctx, req.(*CreateListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedTicketServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedTicketServiceServer struct{}
+func _TicketService_GetListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetListing(ctx, req.(*GetListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedTicketServiceServer) PurchaseTicket(context.Context, *PurchaseTicketRequest) (*PurchaseTicketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurchaseTicket not implemented")
+func _TicketService_ListListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListListings(ctx, req.(*ListListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTicketServiceServer) GetTicket(context.Context, *GetTicketRequest) (*GetTicketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTicket not implemented")
+
+func _TicketService_WithdrawListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).WithdrawListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_WithdrawListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).WithdrawListing(ctx, req.(*WithdrawListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
-func (UnimplementedTicketServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeTicketServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TicketServiceServer will
-// result in compilation errors.
-type UnsafeTicketServiceServer interface {
-	mustEmbedUnimplementedTicketServiceServer()
+func _TicketService_BuyListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).BuyListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_BuyListing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).BuyListing(ctx, req.(*BuyListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTicketServiceServer(s grpc.ServiceRegistrar, srv TicketServiceServer) {
-	// If the following call pancis, it indicates UnimplementedTicketServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _TicketService_SetResalePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetResalePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&TicketService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SetResalePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_SetResalePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SetResalePolicy(ctx, req.(*SetResalePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TicketService_PurchaseTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PurchaseTicketRequest)
+func _TicketService_GetResalePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResalePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TicketServiceServer).PurchaseTicket(ctx, in)
+		return srv.(TicketServiceServer).GetResalePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TicketService_PurchaseTicket_FullMethodName,
+		FullMethod: TicketService_GetResalePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TicketServiceServer).PurchaseTicket(ctx, req.(*PurchaseTicketRequest))
+		return srv.(TicketServiceServer).GetResalePolicy(ctx, req.(*GetResalePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TicketService_GetTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTicketRequest)
+func _TicketService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TicketServiceServer).GetTicket(ctx, in)
+		return srv.(TicketServiceServer).JoinWaitlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TicketService_GetTicket_FullMethodName,
+		FullMethod: TicketService_JoinWaitlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TicketServiceServer).GetTicket(ctx, req.(*GetTicketRequest))
+		return srv.(TicketServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetWaitlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetWaitlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetWaitlistEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetWaitlistEntry(ctx, req.(*GetWaitlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListWaitlistEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWaitlistEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListWaitlistEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListWaitlistEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListWaitlistEntries(ctx, req.(*ListWaitlistEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ClaimWaitlistOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimWaitlistOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ClaimWaitlistOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ClaimWaitlistOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ClaimWaitlistOffer(ctx, req.(*ClaimWaitlistOfferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -149,10 +1611,182 @@ var TicketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PurchaseTicket",
 			Handler:    _TicketService_PurchaseTicket_Handler,
 		},
+		{
+			MethodName: "CreateOrder",
+			Handler:    _TicketService_CreateOrder_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _TicketService_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _TicketService_ListOrders_Handler,
+		},
 		{
 			MethodName: "GetTicket",
 			Handler:    _TicketService_GetTicket_Handler,
 		},
+		{
+			MethodName: "ListTickets",
+			Handler:    _TicketService_ListTickets_Handler,
+		},
+		{
+			MethodName: "GetTicketCode",
+			Handler:    _TicketService_GetTicketCode_Handler,
+		},
+		{
+			MethodName: "ConfirmTicket",
+			Handler:    _TicketService_ConfirmTicket_Handler,
+		},
+		{
+			MethodName: "CancelTicket",
+			Handler:    _TicketService_CancelTicket_Handler,
+		},
+		{
+			MethodName: "RefundTicket",
+			Handler:    _TicketService_RefundTicket_Handler,
+		},
+		{
+			MethodName: "GetCancellationJob",
+			Handler:    _TicketService_GetCancellationJob_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _TicketService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "GetPromoCode",
+			Handler:    _TicketService_GetPromoCode_Handler,
+		},
+		{
+			MethodName: "ListPromoCodes",
+			Handler:    _TicketService_ListPromoCodes_Handler,
+		},
+		{
+			MethodName: "UpdatePromoCode",
+			Handler:    _TicketService_UpdatePromoCode_Handler,
+		},
+		{
+			MethodName: "DeletePromoCode",
+			Handler:    _TicketService_DeletePromoCode_Handler,
+		},
+		{
+			MethodName: "QuoteOrder",
+			Handler:    _TicketService_QuoteOrder_Handler,
+		},
+		{
+			MethodName: "SetFeeSchedule",
+			Handler:    _TicketService_SetFeeSchedule_Handler,
+		},
+		{
+			MethodName: "GetFeeSchedule",
+			Handler:    _TicketService_GetFeeSchedule_Handler,
+		},
+		{
+			MethodName: "SetTaxRate",
+			Handler:    _TicketService_SetTaxRate_Handler,
+		},
+		{
+			MethodName: "ListTaxRates",
+			Handler:    _TicketService_ListTaxRates_Handler,
+		},
+		{
+			MethodName: "RotateTicketSigningKey",
+			Handler:    _TicketService_RotateTicketSigningKey_Handler,
+		},
+		{
+			MethodName: "ListTicketSigningKeys",
+			Handler:    _TicketService_ListTicketSigningKeys_Handler,
+		},
+		{
+			MethodName: "RevokeTicketSigningKey",
+			Handler:    _TicketService_RevokeTicketSigningKey_Handler,
+		},
+		{
+			MethodName: "CheckInTicket",
+			Handler:    _TicketService_CheckInTicket_Handler,
+		},
+		{
+			MethodName: "SyncCheckIns",
+			Handler:    _TicketService_SyncCheckIns_Handler,
+		},
+		{
+			MethodName: "SetEntryPolicy",
+			Handler:    _TicketService_SetEntryPolicy_Handler,
+		},
+		{
+			MethodName: "GetEntryPolicy",
+			Handler:    _TicketService_GetEntryPolicy_Handler,
+		},
+		{
+			MethodName: "InitiateTicketTransfer",
+			Handler:    _TicketService_InitiateTicketTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptTicketTransfer",
+			Handler:    _TicketService_AcceptTicketTransfer_Handler,
+		},
+		{
+			MethodName: "CancelTicketTransfer",
+			Handler:    _TicketService_CancelTicketTransfer_Handler,
+		},
+		{
+			MethodName: "GetTicketTransfer",
+			Handler:    _TicketService_GetTicketTransfer_Handler,
+		},
+		{
+			MethodName: "ListTicketTransfers",
+			Handler:    _TicketService_ListTicketTransfers_Handler,
+		},
+		{
+			MethodName: "CreateListing",
+			Handler:    _TicketService_CreateListing_Handler,
+		},
+		{
+			MethodName: "GetListing",
+			Handler:    _TicketService_GetListing_Handler,
+		},
+		{
+			MethodName: "ListListings",
+			Handler:    _TicketService_ListListings_Handler,
+		},
+		{
+			MethodName: "WithdrawListing",
+			Handler:    _TicketService_WithdrawListing_Handler,
+		},
+		{
+			MethodName: "BuyListing",
+			Handler:    _TicketService_BuyListing_Handler,
+		},
+		{
+			MethodName: "SetResalePolicy",
+			Handler:    _TicketService_SetResalePolicy_Handler,
+		},
+		{
+			MethodName: "GetResalePolicy",
+			Handler:    _TicketService_GetResalePolicy_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _TicketService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "GetWaitlistEntry",
+			Handler:    _TicketService_GetWaitlistEntry_Handler,
+		},
+		{
+			MethodName: "ListWaitlistEntries",
+			Handler:    _TicketService_ListWaitlistEntries_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _TicketService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "ClaimWaitlistOffer",
+			Handler:    _TicketService_ClaimWaitlistOffer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ticket/ticket.proto",
@@ -15,14 +15,27 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
 	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/cancellation"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/checkin"
 	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/config"
 	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/database"
 	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/handler"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/outbox"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/payment"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/pricing"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/promo"
 	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/resale"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/saga"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/sweeper"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/ticketcode"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/waitlist"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -45,6 +58,21 @@ func main() {
 	db := client.Database(cfg.DatabaseName)
 
 	ticketRepo := repository.NewTicketRepository(db)
+	idempotencyRepo := repository.NewIdempotencyRepository(db, cfg.IdempotencyTTL)
+	outboxRepo := repository.NewOutboxRepository(db)
+	sagaRepo := repository.NewSagaRepository(db)
+	orderRepo := repository.NewOrderRepository(db)
+	paymentRepo := repository.NewPaymentRepository(db)
+	jobRepo := repository.NewCancellationJobRepository(db)
+	offsetRepo := repository.NewOffsetRepository(db)
+	promoRepo := repository.NewPromoCodeRepository(db)
+	pricingRepo := repository.NewPricingRepository(db)
+	signingKeyRepo := repository.NewSigningKeyRepository(db)
+	checkInRepo := repository.NewCheckInRepository(db)
+	transferRepo := repository.NewTransferRepository(db)
+	listingRepo := repository.NewListingRepository(db)
+	waitlistRepo := repository.NewWaitlistRepository(db)
+	transactor := repository.NewTransactor(client)
 
 	eventConn, err := grpc.Dial(
 		cfg.EventServiceAddr,
@@ -60,21 +88,72 @@ func main() {
 		}
 	}(eventConn)
 
-	notifConn, err := grpc.Dial(
-		cfg.NotificationServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	if err != nil {
-		log.Fatalf("Failed to connect to notification service: %v", err)
+	if cfg.PaymentWebhookSecret == "" {
+		log.Fatal("PAYMENT_WEBHOOK_SECRET is required")
 	}
-	defer func(notifConn *grpc.ClientConn) {
-		err := notifConn.Close()
-		if err != nil {
+	var provider payment.Provider
+	switch cfg.PaymentProvider {
+	case "":
+		log.Fatal("PAYMENT_PROVIDER is required")
+	case "fake":
+		provider = payment.NewFakeProvider(cfg.PaymentWebhookSecret)
+	default:
+		log.Fatalf("Unknown payment provider %q", cfg.PaymentProvider)
+	}
+	payments := payment.NewService(provider, paymentRepo)
+	promos := promo.NewService(promoRepo, transactor)
+	prices := pricing.NewService(pricingRepo, promos)
+
+	ticketCodes := ticketcode.NewService(signingKeyRepo, transactor)
+	if err := ticketCodes.EnsureKey(context.Background()); err != nil {
+		log.Fatalf("Failed to set up ticket signing key: %v", err)
+	}
+	checkIns := checkin.NewService(ticketRepo, checkInRepo, listingRepo, ticketCodes, transactor)
 
+	purchases := saga.NewOrchestrator(sagaRepo, orderRepo, ticketRepo, outboxRepo, jobRepo, transactor, eventConn, payments, promos, prices, cfg.SagaStepTimeout, cfg.SagaResumeInterval)
+	purchases.Start()
+	defer purchases.Stop()
+
+	waitlists := waitlist.NewService(waitlistRepo, outboxRepo, transactor, eventConn, cfg.WaitlistOfferTTL, cfg.WaitlistInterval)
+	waitlists.Start()
+	defer waitlists.Stop()
+
+	cancellations := cancellation.NewRunner(jobRepo, ticketRepo, listingRepo, waitlistRepo, outboxRepo, transactor, payments, cfg.CancellationInterval)
+	cancellations.Start()
+	defer cancellations.Stop()
+
+	resales := resale.NewService(ticketRepo, listingRepo, orderRepo, transferRepo, outboxRepo, transactor, payments, cfg.ResaleHoldTTL, cfg.SweepInterval)
+	resales.Start()
+	defer resales.Stop()
+
+	ticketHandler := handler.NewTicketHandler(ticketRepo, orderRepo, transferRepo, listingRepo, waitlistRepo, idempotencyRepo, outboxRepo, transactor, jobRepo, purchases, payments, promos, prices, ticketCodes, checkIns, resales, waitlists, eventConn, cfg.HoldTTL, cfg.TicketCodeGrace)
+
+	if cfg.NatsURL == "" {
+		log.Println("Warning: NATS_URL is not set, ticket events and event cancellations will only go through an in-memory bus")
+	}
+	eventBus, err := bus.Open(cfg.NatsURL, outbox.TicketEventsStreamConfig, cancellation.EventsStreamConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to message bus: %v", err)
+	}
+	defer func() {
+		if err := eventBus.Close(); err != nil {
+			log.Printf("Failed to close message bus: %v", err)
 		}
-	}(notifConn)
+	}()
+
+	cancellationConsumer := cancellation.NewConsumer(eventBus, offsetRepo, cancellations)
+	if err := cancellationConsumer.Start(); err != nil {
+		log.Fatalf("Failed to start event cancellation consumer: %v", err)
+	}
+	defer cancellationConsumer.Stop()
+
+	outboxRelay := outbox.NewRelay(outboxRepo, outbox.NewBusPublisher(eventBus), cfg.OutboxPollInterval)
+	outboxRelay.Start()
+	defer outboxRelay.Stop()
 
-	ticketHandler := handler.NewTicketHandler(ticketRepo, eventConn, notifConn)
+	holdSweeper := sweeper.NewSweeper(ticketRepo, outboxRepo, transactor, eventConn, waitlists, cfg.SweepInterval)
+	holdSweeper.Start()
+	defer holdSweeper.Stop()
 
 	grpcServer := grpc.NewServer()
 	ticketpb.RegisterTicketServiceServer(grpcServer, ticketHandler)
@@ -96,7 +175,9 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+	)
 
 	mux.HandlePath("GET", "/swagger.json", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		http.ServeFile(w, r, "docs/ticket.swagger.json")
@@ -110,6 +191,10 @@ func main() {
 	}
 	registerHealthCheckEndpoint(mux)
 
+	if err := mux.HandlePath("POST", "/v1/payments/webhook", handler.NewPaymentWebhookHandler(payments)); err != nil {
+		log.Fatalf("Failed to register payment webhook handler: %v", err)
+	}
+
 	err = mux.HandlePath("GET", "/metrics", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		promhttp.Handler().ServeHTTP(w, r)
 	})
@@ -146,6 +231,13 @@ func main() {
 	log.Println("Servers gracefully stopped")
 }
 
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, handler.IdempotencyKeyHeader) {
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func registerHealthCheckEndpoint(mux *runtime.ServeMux) {
 	err := mux.HandlePath("GET", "/health", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
 		w.Header().Set("Content-Type", "application/json")
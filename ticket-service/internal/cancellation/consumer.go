@@ -0,0 +1,101 @@
+package cancellation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+)
+
+const (
+	// EventCancelledSubject must match what event-service publishes
+	// cancellations to.
+	EventCancelledSubject = "events.EventCancelled"
+
+	consumerName = "ticket-service-cancellations"
+	maxBackoff   = time.Minute
+)
+
+// EventsStreamConfig is the stream event-service publishes to. The consumer
+// declares it as well so that it can subscribe before event-service has
+// published anything.
+var EventsStreamConfig = bus.Stream{
+	Name:     "EVENTS",
+	Subjects: []string{"events.>"},
+}
+
+// Consumer starts a cancellation job for every cancelled event. It commits the
+// sequence of every processed message so that a restart resumes after the
+// last one.
+type Consumer struct {
+	subscriber bus.Subscriber
+	offsetRepo *repository.OffsetRepository
+	runner     *Runner
+	cancel     context.CancelFunc
+}
+
+func NewConsumer(subscriber bus.Subscriber, offsetRepo *repository.OffsetRepository, runner *Runner) *Consumer {
+	return &Consumer{
+		subscriber: subscriber,
+		offsetRepo: offsetRepo,
+		runner:     runner,
+	}
+}
+
+func (c *Consumer) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	offset, err := c.offsetRepo.GetOffset(ctx, consumerName, EventCancelledSubject)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to load consumer offset: %w", err)
+	}
+
+	c.cancel = cancel
+	if err := c.subscriber.Subscribe(ctx, EventCancelledSubject, offset, c.handle); err != nil {
+		cancel()
+		return fmt.Errorf("failed to subscribe to event cancellations: %w", err)
+	}
+
+	log.Printf("Starting event cancellation consumer after offset %d", offset)
+	return nil
+}
+
+func (c *Consumer) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	log.Println("Event cancellation consumer stopped")
+}
+
+func (c *Consumer) handle(ctx context.Context, msg *bus.Message) error {
+	var event eventpb.Event
+	if err := msg.Envelope.GetPayload().UnmarshalTo(&event); err != nil {
+		log.Printf("Skipping malformed message %d on %s: %v", msg.Sequence, msg.Subject, err)
+		return c.offsetRepo.CommitOffset(ctx, consumerName, EventCancelledSubject, msg.Sequence)
+	}
+
+	delay := time.Second
+	for {
+		err := c.runner.Enqueue(ctx, event.Id, event.StatusReason)
+		if err == nil {
+			break
+		}
+
+		log.Printf("Failed to queue cancellation of event %s, retrying in %v: %v", event.Id, delay, err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if delay < maxBackoff {
+			delay *= 2
+		}
+	}
+
+	return c.offsetRepo.CommitOffset(ctx, consumerName, EventCancelledSubject, msg.Sequence)
+}
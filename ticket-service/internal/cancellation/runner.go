@@ -0,0 +1,273 @@
+// Package cancellation closes out the tickets of cancelled events.
+package cancellation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/payment"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+)
+
+const (
+	// lease is how long a job stays owned by the process working on it. It is
+	// renewed after every ticket.
+	lease     = time.Minute
+	batchSize = 100
+	// settle is how long after a job is created a purchase that started
+	// before it can still create tickets: purchases check for the job in the
+	// transaction that creates their tickets, and MongoDB aborts
+	// transactions older than a minute.
+	settle = time.Minute
+	// maxPasses is how many times a job walks the event's tickets before it
+	// gives up on the ones it cannot close.
+	maxPasses = 20
+)
+
+// Runner works through cancellation jobs. Each job walks the event's active
+// tickets in batches: confirmed tickets are refunded and held ones cancelled,
+// their payments are returned and their holders notified through the
+// outbox. The event's resale listings are taken off sale and its waitlist is
+// closed. None of the stock goes back on sale. Progress is saved after every
+// ticket, so a job interrupted by a restart is resumed where it stopped.
+//
+// A job walks the tickets again until a pass started after settle finds none
+// left, which also catches tickets that purchases in flight when the event
+// was cancelled created behind the cursor. Tickets that could not be closed
+// are retried every interval, up to maxPasses passes.
+type Runner struct {
+	jobRepo      *repository.CancellationJobRepository
+	ticketRepo   *repository.TicketRepository
+	listingRepo  *repository.ListingRepository
+	waitlistRepo *repository.WaitlistRepository
+	outboxRepo   *repository.OutboxRepository
+	transactor   *repository.Transactor
+	payments     *payment.Service
+	interval     time.Duration
+	wakeCh       chan struct{}
+	stopCh       chan struct{}
+}
+
+func NewRunner(
+	jobRepo *repository.CancellationJobRepository,
+	ticketRepo *repository.TicketRepository,
+	listingRepo *repository.ListingRepository,
+	waitlistRepo *repository.WaitlistRepository,
+	outboxRepo *repository.OutboxRepository,
+	transactor *repository.Transactor,
+	payments *payment.Service,
+	interval time.Duration,
+) *Runner {
+	return &Runner{
+		jobRepo:      jobRepo,
+		ticketRepo:   ticketRepo,
+		listingRepo:  listingRepo,
+		waitlistRepo: waitlistRepo,
+		outboxRepo:   outboxRepo,
+		transactor:   transactor,
+		payments:     payments,
+		interval:     interval,
+		wakeCh:       make(chan struct{}, 1),
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Enqueue starts the cancellation job of an event. Enqueueing an event that
+// already has a job does nothing.
+func (r *Runner) Enqueue(ctx context.Context, eventID, reason string) error {
+	created, err := r.jobRepo.Create(ctx, model.NewCancellationJob(eventID, reason))
+	if err != nil {
+		return fmt.Errorf("failed to create cancellation job: %w", err)
+	}
+	if !created {
+		return nil
+	}
+
+	log.Printf("Queued cancellation of tickets for event %s", eventID)
+	select {
+	case r.wakeCh <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (r *Runner) Start() {
+	log.Printf("Starting cancellation runner, running every %v", r.interval)
+	go r.loop()
+}
+
+func (r *Runner) Stop() {
+	close(r.stopCh)
+}
+
+func (r *Runner) loop() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.RunPending(context.Background())
+
+		select {
+		case <-ticker.C:
+		case <-r.wakeCh:
+		case <-r.stopCh:
+			log.Println("Cancellation runner stopped")
+			return
+		}
+	}
+}
+
+// RunPending works on every job that nobody else holds until it is finished
+// or the runner is stopped.
+func (r *Runner) RunPending(ctx context.Context) {
+	for {
+		job, err := r.jobRepo.ClaimStale(ctx, time.Now(), lease)
+		if err != nil {
+			log.Printf("Failed to claim cancellation job: %v", err)
+			return
+		}
+		if job == nil {
+			return
+		}
+
+		if err := r.run(ctx, job); err != nil {
+			log.Printf("Cancellation job for event %s stopped: %v", job.EventID, err)
+			return
+		}
+	}
+}
+
+func (r *Runner) run(ctx context.Context, job *model.CancellationJob) error {
+	// Take the event's tickets off the resale market before working through
+	// them; closeTicket catches any listed in the meantime.
+	delisted, err := r.listingRepo.DelistEvent(ctx, job.EventID, model.DelistEventCancelled)
+	if err != nil {
+		return err
+	}
+	if delisted > 0 {
+		log.Printf("Delisted %d resale listings of cancelled event %s", delisted, job.EventID)
+	}
+
+	// The waitlist service gives back the stock the closed entries hold.
+	closed, err := r.waitlistRepo.CancelEvent(ctx, job.EventID, "event was cancelled")
+	if err != nil {
+		return err
+	}
+	if closed > 0 {
+		log.Printf("Closed %d waitlist entries of cancelled event %s", closed, job.EventID)
+	}
+
+	for {
+		tickets, err := r.ticketRepo.GetActiveTicketsForEvent(ctx, job.EventID, job.Cursor, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list tickets: %w", err)
+		}
+
+		if len(tickets) == 0 {
+			return r.endPass(ctx, job)
+		}
+
+		for _, ticket := range tickets {
+			select {
+			case <-r.stopCh:
+				return errors.New("runner stopped")
+			default:
+			}
+
+			job.PassFound = true
+			r.closeTicket(ctx, job, ticket)
+
+			job.Cursor = ticket.ID
+			job.LeaseUntil = time.Now().Add(lease)
+			if err := r.jobRepo.Save(ctx, job); err != nil {
+				return fmt.Errorf("failed to save job: %w", err)
+			}
+		}
+	}
+}
+
+// endPass finishes the job once a pass that started after settle found no
+// active tickets, and otherwise starts another pass: right away if every
+// ticket was closed, or after the interval if some failed.
+func (r *Runner) endPass(ctx context.Context, job *model.CancellationJob) error {
+	now := time.Now()
+	settled := job.PassStartedAt.Sub(job.CreatedAt) >= settle
+
+	if (settled && !job.PassFound) || job.Pass >= maxPasses {
+		job.Finish(now)
+		if err := r.jobRepo.Save(ctx, job); err != nil {
+			return fmt.Errorf("failed to save job: %w", err)
+		}
+		log.Printf("Cancellation job for event %s finished after %d passes: %d refunded, %d cancelled, %d skipped, %d failed",
+			job.EventID, job.Pass, job.Refunded, job.Cancelled, job.Skipped, job.Failed)
+		return nil
+	}
+
+	next := now
+	if job.Failed > 0 {
+		next = now.Add(r.interval)
+		log.Printf("Cancellation job for event %s failed to close %d tickets, retrying at %v",
+			job.EventID, job.Failed, next.Format(time.RFC3339))
+	}
+	if settleAt := job.CreatedAt.Add(settle); next.Before(settleAt) {
+		next = settleAt
+	}
+
+	job.StartPass(next)
+	job.LeaseUntil = next
+	if err := r.jobRepo.Save(ctx, job); err != nil {
+		return fmt.Errorf("failed to save job: %w", err)
+	}
+	return nil
+}
+
+// closeTicket returns the ticket's payment before changing its status, so a
+// ticket whose payment cannot be returned stays active and is reported as
+// failed until a later pass closes it. Returning a payment twice is a no-op, which makes it safe to redo a
+// ticket the job was working on when it was interrupted.
+func (r *Runner) closeTicket(ctx context.Context, job *model.CancellationJob, ticket *model.Ticket) {
+	to, eventType := model.TicketStatusCancelled, model.EventTicketCancelled
+	if ticket.Status == model.TicketStatusConfirmed {
+		to, eventType = model.TicketStatusRefunded, model.EventTicketRefunded
+	}
+
+	if err := r.payments.RefundTicket(ctx, ticket); err != nil {
+		log.Printf("Failed to return payment for ticket %s of cancelled event %s: %v", ticket.ID.Hex(), job.EventID, err)
+		job.RecordFailure(ticket.ID.Hex(), err)
+		return
+	}
+
+	reason := "the event was cancelled"
+	if job.Reason != "" {
+		reason = fmt.Sprintf("the event was cancelled (%s)", job.Reason)
+	}
+
+	err := r.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		updated, err := r.ticketRepo.UpdateStatusWithoutStock(ctx, ticket.ID.Hex(), to)
+		if err != nil {
+			return err
+		}
+		if err := r.listingRepo.DelistTicket(ctx, ticket.ID.Hex(), model.DelistEventCancelled); err != nil {
+			return err
+		}
+		return r.outboxRepo.Add(ctx, model.NewTicketEvent(eventType, updated, reason))
+	})
+
+	switch {
+	case errors.Is(err, repository.ErrInvalidStatus):
+		// The ticket was cancelled or refunded since it was listed, and
+		// whatever changed it has taken care of its payment.
+		job.Skipped++
+	case err != nil:
+		log.Printf("Failed to close ticket %s of cancelled event %s: %v", ticket.ID.Hex(), job.EventID, err)
+		job.RecordFailure(ticket.ID.Hex(), err)
+	case to == model.TicketStatusRefunded:
+		job.Refunded++
+	default:
+		job.Cancelled++
+	}
+}
@@ -0,0 +1,76 @@
+package cancellation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestRunner_EndPass(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	tests := []struct {
+		name       string
+		age        time.Duration
+		found      bool
+		failed     bool
+		pass       int32
+		wantStatus model.CancellationJobStatus
+		wantWait   time.Duration
+	}{
+		{name: "clean pass before settle", age: 10 * time.Second, wantStatus: model.CancellationJobRunning, wantWait: settle - 10*time.Second},
+		{name: "clean pass after settle", age: 2 * settle, wantStatus: model.CancellationJobCompleted},
+		{name: "closed tickets", age: 2 * settle, found: true, wantStatus: model.CancellationJobRunning},
+		{name: "failed tickets", age: 2 * settle, found: true, failed: true, wantStatus: model.CancellationJobRunning, wantWait: time.Hour},
+		{name: "last pass", age: 2 * settle, found: true, failed: true, pass: maxPasses, wantStatus: model.CancellationJobCompletedWithErrors},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			r := &Runner{jobRepo: repository.NewCancellationJobRepository(mt.DB), interval: time.Hour}
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+
+			job := model.NewCancellationJob("event1", "")
+			job.CreatedAt = time.Now().Add(-tt.age)
+			job.PassStartedAt = job.CreatedAt
+			if tt.age >= settle {
+				job.PassStartedAt = time.Now()
+			}
+			if tt.pass > 0 {
+				job.Pass = tt.pass
+			}
+			job.Cursor = primitive.NewObjectID()
+			job.PassFound = tt.found
+			if tt.failed {
+				job.RecordFailure("ticket1", errors.New("provider unavailable"))
+			}
+
+			if err := r.endPass(context.Background(), job); err != nil {
+				mt.Fatalf("endPass() error = %v", err)
+			}
+			if job.Status != tt.wantStatus {
+				mt.Fatalf("Status = %s, want %s", job.Status, tt.wantStatus)
+			}
+			if job.Status != model.CancellationJobRunning {
+				return
+			}
+
+			if !job.Cursor.IsZero() {
+				mt.Errorf("endPass() kept cursor %v, want a pass from the first ticket", job.Cursor)
+			}
+			if wait := time.Until(job.LeaseUntil); wait > tt.wantWait || wait < tt.wantWait-time.Second {
+				mt.Errorf("next pass in %v, want %v", wait, tt.wantWait)
+			}
+			if !job.PassStartedAt.Equal(job.LeaseUntil) {
+				mt.Errorf("PassStartedAt = %v, want %v", job.PassStartedAt, job.LeaseUntil)
+			}
+		})
+	}
+}
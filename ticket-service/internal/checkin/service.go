@@ -0,0 +1,249 @@
+// Package checkin admits ticket holders at the door. Every scan is recorded
+// under the scanner's scan ID, and letting a holder in and recording the scan
+// happen in one transaction, so a scan retried or uploaded twice is counted
+// once and no ticket is let in more often than its event's entry policy
+// allows. A scan ID reused for a different code is refused rather than
+// answered with the outcome of the earlier scan.
+package checkin
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/ticketcode"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrScanIDReused is returned for a scan whose ID was already recorded for a
+// different ticket code.
+var ErrScanIDReused = errors.New("scan ID was already used for a different ticket code")
+
+// Scan is a ticket code read at a gate. EventID, if set, is the event the gate
+// admits to.
+type Scan struct {
+	ID        string
+	Token     string
+	GateID    string
+	EventID   string
+	ScannedAt time.Time
+	Offline   bool
+}
+
+type Service struct {
+	tickets    *repository.TicketRepository
+	checkIns   *repository.CheckInRepository
+	listings   *repository.ListingRepository
+	codes      *ticketcode.Service
+	transactor *repository.Transactor
+}
+
+func NewService(
+	tickets *repository.TicketRepository,
+	checkIns *repository.CheckInRepository,
+	listings *repository.ListingRepository,
+	codes *ticketcode.Service,
+	transactor *repository.Transactor,
+) *Service {
+	return &Service{
+		tickets:    tickets,
+		checkIns:   checkIns,
+		listings:   listings,
+		codes:      codes,
+		transactor: transactor,
+	}
+}
+
+// CheckIn decides whether to let in the holder of a scanned ticket and
+// records the outcome. The ticket is nil when the code names no ticket.
+func (s *Service) CheckIn(ctx context.Context, scan Scan) (*model.CheckIn, *model.Ticket, error) {
+	keys, err := s.codes.PublicKeys(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.record(ctx, scan, keys)
+}
+
+// Sync records scans a gate made while offline, in the order they were made.
+// The gate has already let people in or turned them away; the outcomes
+// returned are what the service makes of each scan, so a scan the gate
+// accepted that comes back REJECTED let someone in who should not have been.
+func (s *Service) Sync(ctx context.Context, scans []Scan) ([]*model.CheckIn, error) {
+	keys, err := s.codes.PublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(scans, func(i, j int) bool {
+		return scans[i].ScannedAt.Before(scans[j].ScannedAt)
+	})
+
+	checkIns := make([]*model.CheckIn, 0, len(scans))
+	for _, scan := range scans {
+		checkIn, _, err := s.record(ctx, scan, keys)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record scan %s: %w", scan.ID, err)
+		}
+		checkIns = append(checkIns, checkIn)
+	}
+	return checkIns, nil
+}
+
+// SetEntryPolicy creates or replaces the entry policy of an event.
+func (s *Service) SetEntryPolicy(ctx context.Context, policy *model.EntryPolicy) error {
+	return s.checkIns.SaveEntryPolicy(ctx, policy)
+}
+
+// EntryPolicy returns the entry policy of an event, which is a single entry
+// per ticket if none was set.
+func (s *Service) EntryPolicy(ctx context.Context, eventID string) (*model.EntryPolicy, error) {
+	policy, err := s.checkIns.GetEntryPolicy(ctx, eventID)
+	if errors.Is(err, repository.ErrEntryPolicyNotFound) {
+		return model.DefaultEntryPolicy(eventID), nil
+	}
+	return policy, err
+}
+
+func (s *Service) record(ctx context.Context, scan Scan, keys map[string]ed25519.PublicKey) (*model.CheckIn, *model.Ticket, error) {
+	if scan.ID == "" {
+		scan.ID = primitive.NewObjectID().Hex()
+	}
+	claims, verifyErr := ticketcode.Verify(scan.Token, keys, scan.ScannedAt)
+	tokenHash := hashToken(scan.Token)
+
+	var (
+		checkIn *model.CheckIn
+		ticket  *model.Ticket
+	)
+	err := s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		existing, err := s.checkIns.Get(ctx, scan.ID)
+		if err == nil {
+			checkIn, ticket = existing, nil
+			return nil
+		}
+		if !errors.Is(err, repository.ErrCheckInNotFound) {
+			return err
+		}
+
+		checkIn = &model.CheckIn{
+			ID:        scan.ID,
+			TokenHash: tokenHash,
+			GateID:    scan.GateID,
+			Offline:   scan.Offline,
+			ScannedAt: scan.ScannedAt,
+			CreatedAt: time.Now(),
+		}
+		ticket, err = s.admit(ctx, checkIn, scan, claims, verifyErr)
+		if err != nil {
+			return err
+		}
+		return s.checkIns.Create(ctx, checkIn)
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// The same scan was recorded concurrently.
+		checkIn, err = s.checkIns.Get(ctx, scan.ID)
+		ticket = nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if checkIn.TokenHash != tokenHash {
+		return nil, nil, ErrScanIDReused
+	}
+
+	if ticket == nil && checkIn.TicketID != "" {
+		ticket, err = s.tickets.GetByID(ctx, checkIn.TicketID)
+		if err != nil && !errors.Is(err, repository.ErrTicketNotFound) {
+			return nil, nil, err
+		}
+	}
+	return checkIn, ticket, nil
+}
+
+// hashToken fingerprints a scanned ticket code.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// admit accepts or rejects checkIn and, if it is accepted, lets the ticket's
+// holder in. It returns the ticket the code names, if there is one.
+func (s *Service) admit(ctx context.Context, checkIn *model.CheckIn, scan Scan, claims *ticketcode.Claims, verifyErr error) (*model.Ticket, error) {
+	if claims == nil {
+		checkIn.Reject(model.RejectInvalidCode, verifyErr.Error())
+		return nil, nil
+	}
+	checkIn.TicketID = claims.TicketID
+	checkIn.EventID = claims.EventID
+
+	if errors.Is(verifyErr, ticketcode.ErrExpired) {
+		checkIn.Reject(model.RejectExpired, fmt.Sprintf("code expired at %s", time.Unix(claims.ExpiresAt, 0).UTC().Format(time.RFC3339)))
+		return nil, nil
+	}
+	if scan.EventID != "" && claims.EventID != scan.EventID {
+		checkIn.Reject(model.RejectWrongEvent, fmt.Sprintf("ticket is for event %s", claims.EventID))
+		return nil, nil
+	}
+
+	ticket, err := s.tickets.GetByID(ctx, claims.TicketID)
+	if errors.Is(err, repository.ErrTicketNotFound) {
+		checkIn.Reject(model.RejectInvalidCode, "ticket not found")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if claims.Version != ticket.CodeVersion {
+		checkIn.Reject(model.RejectCodeReplaced, "ticket was transferred and this code replaced")
+		return ticket, nil
+	}
+
+	switch ticket.Status {
+	case model.TicketStatusConfirmed:
+		return s.enter(ctx, checkIn, ticket)
+	case model.TicketStatusUsed:
+		policy, err := s.EntryPolicy(ctx, ticket.EventID)
+		if err != nil {
+			return nil, err
+		}
+		if policy.Admits(ticket.Entries) {
+			return s.enter(ctx, checkIn, ticket)
+		}
+		if policy.AllowReentry {
+			checkIn.Reject(model.RejectEntryLimitReached, fmt.Sprintf("ticket has been let in %d of %d times", ticket.Entries, policy.MaxEntries))
+		} else {
+			checkIn.Reject(model.RejectAlreadyUsed, fmt.Sprintf("ticket was checked in at gate %s at %s", ticket.GateID, ticket.CheckedInAt.UTC().Format(time.RFC3339)))
+		}
+	case model.TicketStatusCancelled:
+		checkIn.Reject(model.RejectCancelled, "ticket was cancelled")
+	case model.TicketStatusRefunded:
+		checkIn.Reject(model.RejectRefunded, "ticket was refunded")
+	default:
+		checkIn.Reject(model.RejectNotConfirmed, fmt.Sprintf("ticket is %s", ticket.Status))
+	}
+	return ticket, nil
+}
+
+// enter lets the ticket's holder in. A ticket let in for the first time comes
+// off the resale market.
+func (s *Service) enter(ctx context.Context, checkIn *model.CheckIn, ticket *model.Ticket) (*model.Ticket, error) {
+	updated, err := s.tickets.RecordEntry(ctx, ticket.ID, ticket.Entries, checkIn.GateID, checkIn.ScannedAt)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.Entries == 0 {
+		if err := s.listings.DelistTicket(ctx, ticket.ID.Hex(), model.DelistTicketUsed); err != nil {
+			return nil, err
+		}
+	}
+	checkIn.Accept(updated.Entries)
+	return updated, nil
+}
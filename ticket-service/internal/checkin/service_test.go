@@ -0,0 +1,266 @@
+package checkin
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/ticketcode"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func newService(mt *mtest.T) *Service {
+	return NewService(
+		repository.NewTicketRepository(mt.DB),
+		repository.NewCheckInRepository(mt.DB),
+		repository.NewListingRepository(mt.DB),
+		nil,
+		repository.NewTransactor(mt.Client),
+	)
+}
+
+func document(mt *mtest.T, v interface{}) bson.D {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		mt.Fatalf("failed to marshal %T: %v", v, err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		mt.Fatalf("failed to unmarshal %T: %v", v, err)
+	}
+	return doc
+}
+
+func cursor(docs ...bson.D) bson.D {
+	return mtest.CreateCursorResponse(0, "test.coll", mtest.FirstBatch, docs...)
+}
+
+// sentTo returns how many name commands were sent to collection.
+func sentTo(mt *mtest.T, name, collection string) int {
+	count := 0
+	for _, event := range mt.GetAllStartedEvents() {
+		if event.CommandName == name && event.Command.Lookup(name).StringValue() == collection {
+			count++
+		}
+	}
+	return count
+}
+
+// signer issues ticket codes under key ID "k1".
+type signer struct {
+	keys map[string]ed25519.PublicKey
+	key  ed25519.PrivateKey
+}
+
+func newSigner(t *testing.T) *signer {
+	public, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return &signer{keys: map[string]ed25519.PublicKey{"k1": public}, key: private}
+}
+
+func (s *signer) sign(t *testing.T, ticket *model.Ticket, key ed25519.PrivateKey) string {
+	now := time.Now()
+	token, err := ticketcode.Sign(ticketcode.Claims{
+		KeyID:     "k1",
+		TicketID:  ticket.ID.Hex(),
+		EventID:   ticket.EventID,
+		Admits:    1,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	}, key)
+	if err != nil {
+		t.Fatalf("failed to sign ticket code: %v", err)
+	}
+	return token
+}
+
+func TestService_Record_Signature(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	signer := newSigner(t)
+	ticket := &model.Ticket{ID: primitive.NewObjectID(), EventID: "event1", Status: model.TicketStatusConfirmed}
+
+	_, forged, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "signed with another key", token: signer.sign(t, ticket, forged)},
+		{name: "malformed", token: "not-a-ticket-code"},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			s := newService(mt)
+			mt.AddMockResponses(cursor(), mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+
+			checkIn, got, err := s.record(context.Background(), Scan{ID: "scan1", Token: tt.token, GateID: "gate1", ScannedAt: time.Now()}, signer.keys)
+			if err != nil {
+				mt.Fatalf("record() error = %v", err)
+			}
+			if checkIn.Result != model.CheckInRejected || checkIn.Reason != model.RejectInvalidCode {
+				mt.Errorf("record() = %s %s, want REJECTED INVALID_CODE", checkIn.Result, checkIn.Reason)
+			}
+			if got != nil || sentTo(mt, "find", "tickets") != 0 {
+				mt.Errorf("record() looked up the ticket named by an unverified code")
+			}
+			if sentTo(mt, "insert", "check_ins") != 1 {
+				mt.Errorf("record() did not record the rejected scan")
+			}
+		})
+	}
+}
+
+func TestService_Record_EntryPolicy(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	signer := newSigner(t)
+	ok := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1})
+
+	tests := []struct {
+		name       string
+		status     model.TicketStatus
+		entries    int32
+		policy     *model.EntryPolicy
+		wantResult model.CheckInResult
+		wantReason model.RejectReason
+		wantEntry  int32
+	}{
+		{name: "first entry", status: model.TicketStatusConfirmed, wantResult: model.CheckInAccepted, wantEntry: 1},
+		{name: "single entry used", status: model.TicketStatusUsed, entries: 1, wantResult: model.CheckInRejected, wantReason: model.RejectAlreadyUsed},
+		{
+			name:       "re-entry under limit",
+			status:     model.TicketStatusUsed,
+			entries:    2,
+			policy:     &model.EntryPolicy{EventID: "event1", AllowReentry: true, MaxEntries: 3},
+			wantResult: model.CheckInAccepted,
+			wantEntry:  3,
+		},
+		{
+			name:       "re-entry at limit",
+			status:     model.TicketStatusUsed,
+			entries:    3,
+			policy:     &model.EntryPolicy{EventID: "event1", AllowReentry: true, MaxEntries: 3},
+			wantResult: model.CheckInRejected,
+			wantReason: model.RejectEntryLimitReached,
+		},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			s := newService(mt)
+			ticket := &model.Ticket{ID: primitive.NewObjectID(), EventID: "event1", Status: tt.status, Entries: tt.entries}
+			entered := *ticket
+			entered.Status = model.TicketStatusUsed
+			entered.Entries = tt.entries + 1
+
+			responses := []bson.D{cursor(), cursor(document(mt, ticket))}
+			if tt.status == model.TicketStatusUsed {
+				if tt.policy != nil {
+					responses = append(responses, cursor(document(mt, tt.policy)))
+				} else {
+					responses = append(responses, cursor())
+				}
+			}
+			if tt.wantResult == model.CheckInAccepted {
+				responses = append(responses, mtest.CreateSuccessResponse(bson.E{Key: "value", Value: document(mt, &entered)}))
+				if tt.entries == 0 {
+					responses = append(responses, ok)
+				}
+			}
+			responses = append(responses, mtest.CreateSuccessResponse(), mtest.CreateSuccessResponse())
+			mt.AddMockResponses(responses...)
+
+			scan := Scan{ID: "scan1", Token: signer.sign(t, ticket, signer.key), GateID: "gate1", ScannedAt: time.Now()}
+			checkIn, _, err := s.record(context.Background(), scan, signer.keys)
+			if err != nil {
+				mt.Fatalf("record() error = %v", err)
+			}
+			if checkIn.Result != tt.wantResult || checkIn.Reason != tt.wantReason || checkIn.Entry != tt.wantEntry {
+				mt.Errorf("record() = %s %s entry %d, want %s %s entry %d", checkIn.Result, checkIn.Reason, checkIn.Entry, tt.wantResult, tt.wantReason, tt.wantEntry)
+			}
+
+			// Only the first entry takes the ticket off the resale market.
+			if delisted := sentTo(mt, "update", "listings") == 1; delisted != (tt.wantResult == model.CheckInAccepted && tt.entries == 0) {
+				mt.Errorf("record() delisted the ticket = %v", delisted)
+			}
+		})
+	}
+}
+
+func TestService_Record_Replay(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	signer := newSigner(t)
+	ticket := &model.Ticket{ID: primitive.NewObjectID(), EventID: "event1", Status: model.TicketStatusUsed, Entries: 1}
+	other := &model.Ticket{ID: primitive.NewObjectID(), EventID: "event1", Status: model.TicketStatusConfirmed}
+	token := signer.sign(t, ticket, signer.key)
+
+	recorded := &model.CheckIn{
+		ID:        "scan1",
+		TokenHash: hashToken(token),
+		TicketID:  ticket.ID.Hex(),
+		EventID:   "event1",
+		GateID:    "gate1",
+		Result:    model.CheckInAccepted,
+		Entry:     1,
+	}
+
+	mt.Run("retried scan", func(mt *mtest.T) {
+		s := newService(mt)
+		mt.AddMockResponses(cursor(document(mt, recorded)), mtest.CreateSuccessResponse(), cursor(document(mt, ticket)))
+
+		checkIn, got, err := s.record(context.Background(), Scan{ID: "scan1", Token: token, GateID: "gate1", ScannedAt: time.Now()}, signer.keys)
+		if err != nil {
+			mt.Fatalf("record() error = %v", err)
+		}
+		if checkIn.Result != model.CheckInAccepted || got == nil || got.ID != ticket.ID {
+			mt.Errorf("record() = %s for %v, want the recorded admit of %s", checkIn.Result, got, ticket.ID.Hex())
+		}
+		if sentTo(mt, "insert", "check_ins") != 0 || sentTo(mt, "findAndModify", "tickets") != 0 {
+			mt.Errorf("record() let the holder in again for a retried scan")
+		}
+	})
+
+	mt.Run("scan ID reused for another ticket", func(mt *mtest.T) {
+		s := newService(mt)
+		mt.AddMockResponses(cursor(document(mt, recorded)), mtest.CreateSuccessResponse())
+
+		scan := Scan{ID: "scan1", Token: signer.sign(t, other, signer.key), GateID: "gate1", ScannedAt: time.Now()}
+		checkIn, _, err := s.record(context.Background(), scan, signer.keys)
+		if !errors.Is(err, ErrScanIDReused) {
+			mt.Fatalf("record() = %v, %v, want %v", checkIn, err, ErrScanIDReused)
+		}
+		if sentTo(mt, "find", "tickets") != 0 {
+			mt.Errorf("record() looked up a ticket for a reused scan ID")
+		}
+	})
+
+	mt.Run("scan ID recorded concurrently for another ticket", func(mt *mtest.T) {
+		s := newService(mt)
+		mt.AddMockResponses(
+			cursor(),
+			cursor(document(mt, other)),
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: document(mt, other)}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}),
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "duplicate key error"}),
+			mtest.CreateSuccessResponse(),
+			cursor(document(mt, recorded)),
+		)
+
+		scan := Scan{ID: "scan1", Token: signer.sign(t, other, signer.key), GateID: "gate1", ScannedAt: time.Now()}
+		_, _, err := s.record(context.Background(), scan, signer.keys)
+		if !errors.Is(err, ErrScanIDReused) {
+			mt.Fatalf("record() error = %v, want %v", err, ErrScanIDReused)
+		}
+	})
+}
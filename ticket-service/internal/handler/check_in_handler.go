@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/checkin"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSyncScans caps how many offline scans one SyncCheckIns call uploads.
+const maxSyncScans = 500
+
+// CheckInTicket checks a scanned ticket code and, if the ticket may still be
+// used, lets its holder in. Rejections are returned as a REJECTED check-in
+// with a reason rather than as errors.
+func (h *TicketHandler) CheckInTicket(ctx context.Context, req *ticketpb.CheckInTicketRequest) (*ticketpb.CheckInTicketResponse, error) {
+	if req.Token == "" || req.GateId == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and gate ID are required")
+	}
+
+	checkIn, ticket, err := h.checkIns.CheckIn(ctx, checkin.Scan{
+		ID:        req.ScanId,
+		Token:     req.Token,
+		GateID:    req.GateId,
+		EventID:   req.EventId,
+		ScannedAt: time.Now(),
+	})
+	if errors.Is(err, checkin.ErrScanIDReused) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check in ticket: %v", err)
+	}
+
+	resp := &ticketpb.CheckInTicketResponse{CheckIn: checkIn.ToProto()}
+	if ticket != nil {
+		resp.Ticket = ticket.ToProto()
+	}
+	return resp, nil
+}
+
+// SyncCheckIns records the scans a gate made while it was offline.
+func (h *TicketHandler) SyncCheckIns(ctx context.Context, req *ticketpb.SyncCheckInsRequest) (*ticketpb.SyncCheckInsResponse, error) {
+	if req.GateId == "" {
+		return nil, status.Error(codes.InvalidArgument, "gate ID is required")
+	}
+	if len(req.Scans) > maxSyncScans {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d scans can be synced at once", maxSyncScans)
+	}
+
+	now := time.Now()
+	scans := make([]checkin.Scan, 0, len(req.Scans))
+	for _, s := range req.Scans {
+		if s.ScanId == "" || s.Token == "" {
+			return nil, status.Error(codes.InvalidArgument, "every scan needs a scan ID and token")
+		}
+		// Scans can only have been made in the past; a gate whose clock is
+		// ahead is taken to have scanned just now.
+		scannedAt := now
+		if s.ScannedAt != nil && s.ScannedAt.AsTime().Before(now) {
+			scannedAt = s.ScannedAt.AsTime()
+		}
+		scans = append(scans, checkin.Scan{
+			ID:        s.ScanId,
+			Token:     s.Token,
+			GateID:    req.GateId,
+			EventID:   req.EventId,
+			ScannedAt: scannedAt,
+			Offline:   true,
+		})
+	}
+
+	checkIns, err := h.checkIns.Sync(ctx, scans)
+	if errors.Is(err, checkin.ErrScanIDReused) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sync check-ins: %v", err)
+	}
+
+	resp := &ticketpb.SyncCheckInsResponse{
+		CheckIns: make([]*ticketpb.CheckIn, 0, len(checkIns)),
+	}
+	for _, checkIn := range checkIns {
+		resp.CheckIns = append(resp.CheckIns, checkIn.ToProto())
+	}
+	return resp, nil
+}
+
+func (h *TicketHandler) SetEntryPolicy(ctx context.Context, req *ticketpb.SetEntryPolicyRequest) (*ticketpb.SetEntryPolicyResponse, error) {
+	if req.EventId == "" || req.EntryPolicy == nil {
+		return nil, status.Error(codes.InvalidArgument, "event ID and entry policy are required")
+	}
+
+	policy := model.EntryPolicyFromProto(req.EntryPolicy)
+	policy.EventID = req.EventId
+	if err := policy.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry policy: %v", err)
+	}
+
+	if err := h.checkIns.SetEntryPolicy(ctx, policy); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set entry policy: %v", err)
+	}
+
+	return &ticketpb.SetEntryPolicyResponse{EntryPolicy: policy.ToProto()}, nil
+}
+
+func (h *TicketHandler) GetEntryPolicy(ctx context.Context, req *ticketpb.GetEntryPolicyRequest) (*ticketpb.GetEntryPolicyResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	policy, err := h.checkIns.EntryPolicy(ctx, req.EventId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get entry policy: %v", err)
+	}
+
+	return &ticketpb.GetEntryPolicyResponse{EntryPolicy: policy.ToProto()}, nil
+}
@@ -2,13 +2,22 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log"
+	"time"
 
 	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
-	notificationpb "github.com/doniiel/event-ticketing-platform/proto/notification"
 	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/checkin"
 	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/payment"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/pricing"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/promo"
 	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/resale"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/saga"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/ticketcode"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/waitlist"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -17,71 +26,124 @@ import (
 
 type TicketHandler struct {
 	ticketpb.UnimplementedTicketServiceServer
-	repo               *repository.TicketRepository
-	eventClient        eventpb.EventServiceClient
-	notificationClient notificationpb.NotificationServiceClient
+	repo            *repository.TicketRepository
+	orderRepo       *repository.OrderRepository
+	transferRepo    *repository.TransferRepository
+	listingRepo     *repository.ListingRepository
+	waitlistRepo    *repository.WaitlistRepository
+	idempotencyRepo *repository.IdempotencyRepository
+	outboxRepo      *repository.OutboxRepository
+	transactor      *repository.Transactor
+	jobRepo         *repository.CancellationJobRepository
+	purchases       *saga.Orchestrator
+	payments        *payment.Service
+	promos          *promo.Service
+	prices          *pricing.Service
+	ticketCodes     *ticketcode.Service
+	checkIns        *checkin.Service
+	resale          *resale.Service
+	waitlist        *waitlist.Service
+	eventClient     eventpb.EventServiceClient
+	holdTTL         time.Duration
+	codeGrace       time.Duration
 }
 
 func NewTicketHandler(
 	repo *repository.TicketRepository,
+	orderRepo *repository.OrderRepository,
+	transferRepo *repository.TransferRepository,
+	listingRepo *repository.ListingRepository,
+	waitlistRepo *repository.WaitlistRepository,
+	idempotencyRepo *repository.IdempotencyRepository,
+	outboxRepo *repository.OutboxRepository,
+	transactor *repository.Transactor,
+	jobRepo *repository.CancellationJobRepository,
+	purchases *saga.Orchestrator,
+	payments *payment.Service,
+	promos *promo.Service,
+	prices *pricing.Service,
+	ticketCodes *ticketcode.Service,
+	checkIns *checkin.Service,
+	resale *resale.Service,
+	waitlist *waitlist.Service,
 	eventConn *grpc.ClientConn,
-	notifConn *grpc.ClientConn,
+	holdTTL time.Duration,
+	codeGrace time.Duration,
 ) *TicketHandler {
 	return &TicketHandler{
-		repo:               repo,
-		eventClient:        eventpb.NewEventServiceClient(eventConn),
-		notificationClient: notificationpb.NewNotificationServiceClient(notifConn),
+		repo:            repo,
+		orderRepo:       orderRepo,
+		transferRepo:    transferRepo,
+		listingRepo:     listingRepo,
+		waitlistRepo:    waitlistRepo,
+		idempotencyRepo: idempotencyRepo,
+		outboxRepo:      outboxRepo,
+		transactor:      transactor,
+		jobRepo:         jobRepo,
+		purchases:       purchases,
+		payments:        payments,
+		promos:          promos,
+		prices:          prices,
+		ticketCodes:     ticketCodes,
+		checkIns:        checkIns,
+		resale:          resale,
+		waitlist:        waitlist,
+		eventClient:     eventpb.NewEventServiceClient(eventConn),
+		holdTTL:         holdTTL,
+		codeGrace:       codeGrace,
 	}
 }
 
+// PurchaseTicket places an order of a single item.
 func (h *TicketHandler) PurchaseTicket(ctx context.Context, req *ticketpb.PurchaseTicketRequest) (*ticketpb.PurchaseTicketResponse, error) {
 	if req.EventId == "" || req.UserId == "" {
 		return nil, status.Error(codes.InvalidArgument, "event ID and user ID are required")
 	}
 
-	if req.Quantity <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than 0")
-	}
-
-	availRes, err := h.eventClient.CheckAvailability(ctx, &eventpb.CheckAvailabilityRequest{
-		EventId:  req.EventId,
-		Quantity: req.Quantity,
+	item, err := orderItem(&ticketpb.CreateOrderItem{
+		EventId:      req.EventId,
+		TicketTypeId: req.TicketTypeId,
+		Quantity:     req.Quantity,
+		SeatIds:      req.SeatIds,
+		AccessCode:   req.AccessCode,
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check ticket availability: %v", err)
+		return nil, err
 	}
 
-	if !availRes.Available {
-		return nil, status.Error(codes.ResourceExhausted, "not enough tickets available")
+	key := idempotencyKey(ctx, req)
+	if key == "" {
+		return h.purchaseTicket(ctx, req, item)
 	}
 
-	ticket := model.NewTicket(req.EventId, req.UserId, req.Quantity)
-	createdTicket, err := h.repo.Create(ctx, ticket)
+	resp := &ticketpb.PurchaseTicketResponse{}
+	claim, replayed, err := h.beginIdempotent(ctx, req.UserId, key, "PurchaseTicket", req, resp)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create ticket: %v", err)
+		return nil, err
+	}
+	if replayed {
+		return resp, nil
 	}
 
-	eventRes, err := h.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{
-		Id: req.EventId,
-	})
+	resp, err = h.purchaseTicket(ctx, req, item)
+	h.finishIdempotent(claim, resp, err)
+	return resp, err
+}
+
+func (h *TicketHandler) purchaseTicket(ctx context.Context, req *ticketpb.PurchaseTicketRequest, item model.OrderItem) (*ticketpb.PurchaseTicketResponse, error) {
+	order, tickets, err := h.placeOrder(ctx, req.UserId, []model.OrderItem{item}, req.PromoCodes, req.PaymentMethod)
 	if err != nil {
-		log.Printf("Failed to get event details: %v", err)
-	} else {
-		go func() {
-			message := "Thank you for purchasing tickets to " + eventRes.Event.Name
-			_, err2 := h.notificationClient.SendNotification(context.Background(), &notificationpb.SendNotificationRequest{
-				UserId:  req.UserId,
-				Message: message,
-			})
-			if err2 != nil {
-				return
-			}
-		}()
-	}
-
-	return &ticketpb.PurchaseTicketResponse{
-		Ticket: createdTicket.ToProto(),
-	}, nil
+		return nil, err
+	}
+
+	resp := &ticketpb.PurchaseTicketResponse{
+		Tickets: tickets,
+		Order:   order.ToProto(),
+	}
+	if len(tickets) > 0 {
+		resp.Ticket = tickets[0]
+	}
+	return resp, nil
 }
 
 func (h *TicketHandler) GetTicket(ctx context.Context, req *ticketpb.GetTicketRequest) (*ticketpb.GetTicketResponse, error) {
@@ -102,3 +164,252 @@ func (h *TicketHandler) GetTicket(ctx context.Context, req *ticketpb.GetTicketRe
 		Ticket: ticket.ToProto(),
 	}, nil
 }
+
+func (h *TicketHandler) ListTickets(ctx context.Context, req *ticketpb.ListTicketsRequest) (*ticketpb.ListTicketsResponse, error) {
+	filter := repository.TicketFilter{
+		UserID:  req.UserId,
+		EventID: req.EventId,
+		Status:  model.TicketStatus(req.Status),
+	}
+
+	if filter.Status != "" && !filter.Status.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ticket status: %s", req.Status)
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	after := primitive.NilObjectID
+	if req.PageToken != "" {
+		var err error
+		after, err = primitive.ObjectIDFromHex(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+	}
+
+	tickets, next, err := h.repo.List(ctx, filter, after, int64(pageSize))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tickets: %v", err)
+	}
+
+	protoTickets := make([]*ticketpb.Ticket, 0, len(tickets))
+	for _, ticket := range tickets {
+		protoTickets = append(protoTickets, ticket.ToProto())
+	}
+
+	resp := &ticketpb.ListTicketsResponse{Tickets: protoTickets}
+	if !next.IsZero() {
+		resp.NextPageToken = next.Hex()
+	}
+
+	return resp, nil
+}
+
+// ConfirmTicket confirms a held ticket whose order has been paid for, such as
+// one left held by a purchase that stopped after capturing its payment.
+// Tickets are only ever confirmed against a captured payment.
+func (h *TicketHandler) ConfirmTicket(ctx context.Context, req *ticketpb.ConfirmTicketRequest) (*ticketpb.ConfirmTicketResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticket ID is required")
+	}
+
+	if _, err := primitive.ObjectIDFromHex(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ticket ID format")
+	}
+
+	ticket, err := h.repo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, ticketStatusError("failed to get ticket", err)
+	}
+
+	paid, err := h.payments.Captured(ctx, ticket.OrderID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get payment: %v", err)
+	}
+	if !paid {
+		return nil, status.Error(codes.FailedPrecondition, "ticket's order has not been paid for")
+	}
+
+	ticket, err = h.repo.ConfirmHold(ctx, req.Id, time.Now())
+	if err != nil {
+		return nil, ticketStatusError("failed to confirm ticket", err)
+	}
+
+	if _, err := h.eventClient.CommitStock(ctx, &eventpb.CommitStockRequest{
+		ReservationId: ticket.ReservationID,
+	}); err != nil {
+		log.Printf("Failed to commit stock for reservation %s: %v", ticket.ReservationID, err)
+	}
+
+	return &ticketpb.ConfirmTicketResponse{
+		Ticket: ticket.ToProto(),
+	}, nil
+}
+
+func (h *TicketHandler) CancelTicket(ctx context.Context, req *ticketpb.CancelTicketRequest) (*ticketpb.CancelTicketResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticket ID is required")
+	}
+
+	if _, err := primitive.ObjectIDFromHex(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ticket ID format")
+	}
+
+	ticket, err := h.transitionTicket(ctx, req.Id, model.TicketStatusCancelled, model.EventTicketCancelled)
+	if err != nil {
+		return nil, ticketStatusError("failed to cancel ticket", err)
+	}
+
+	h.returnStock(ctx, ticket)
+	h.returnPayment(ctx, ticket)
+
+	return &ticketpb.CancelTicketResponse{
+		Ticket: ticket.ToProto(),
+	}, nil
+}
+
+func (h *TicketHandler) RefundTicket(ctx context.Context, req *ticketpb.RefundTicketRequest) (*ticketpb.RefundTicketResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticket ID is required")
+	}
+
+	if _, err := primitive.ObjectIDFromHex(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ticket ID format")
+	}
+
+	ticket, err := h.transitionTicket(ctx, req.Id, model.TicketStatusRefunded, model.EventTicketRefunded)
+	if err != nil {
+		return nil, ticketStatusError("failed to refund ticket", err)
+	}
+
+	h.returnStock(ctx, ticket)
+	h.returnPayment(ctx, ticket)
+
+	return &ticketpb.RefundTicketResponse{
+		Ticket: ticket.ToProto(),
+	}, nil
+}
+
+func (h *TicketHandler) GetCancellationJob(ctx context.Context, req *ticketpb.GetCancellationJobRequest) (*ticketpb.GetCancellationJobResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	job, err := h.jobRepo.Get(ctx, req.EventId)
+	if err != nil {
+		if errors.Is(err, repository.ErrCancellationJobNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no cancellation job for event %s", req.EventId)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get cancellation job: %v", err)
+	}
+
+	return &ticketpb.GetCancellationJobResponse{
+		Job: job.ToProto(),
+	}, nil
+}
+
+// transitionTicket changes a ticket's status, takes it off the resale market
+// and records the matching domain event in one transaction.
+func (h *TicketHandler) transitionTicket(ctx context.Context, id string, to model.TicketStatus, eventType model.OutboxEventType) (*model.Ticket, error) {
+	reason := model.DelistTicketCancelled
+	if to == model.TicketStatusRefunded {
+		reason = model.DelistTicketRefunded
+	}
+
+	var ticket *model.Ticket
+	err := h.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		ticket, err = h.repo.UpdateStatus(ctx, id, to)
+		if err != nil {
+			return err
+		}
+		if err := h.listingRepo.DelistTicket(ctx, id, reason); err != nil {
+			return err
+		}
+		return h.outboxRepo.Add(ctx, model.NewTicketEvent(eventType, ticket, ""))
+	})
+	return ticket, err
+}
+
+func purchaseError(err error) error {
+	var stepErr *saga.StepError
+	if !errors.As(err, &stepErr) {
+		return status.Errorf(codes.Internal, "failed to purchase ticket: %v", err)
+	}
+
+	switch stepErr.Step {
+	case model.SagaStepRedeemPromoCodes:
+		return promoError("failed to redeem promo codes", stepErr.Err)
+	case model.SagaStepPriceOrder:
+		return pricingError("failed to price order", stepErr.Err)
+	}
+
+	var limitErr *model.LimitError
+	if errors.As(stepErr.Err, &limitErr) {
+		return status.Error(codes.FailedPrecondition, limitErr.Error())
+	}
+	if errors.Is(stepErr.Err, payment.ErrDeclined) {
+		return status.Error(codes.FailedPrecondition, "payment declined")
+	}
+	if errors.Is(stepErr.Err, repository.ErrEventCancelled) {
+		return status.Error(codes.FailedPrecondition, "event was cancelled")
+	}
+	if errors.Is(stepErr.Err, context.DeadlineExceeded) || status.Code(stepErr.Err) == codes.DeadlineExceeded {
+		return status.Errorf(codes.DeadlineExceeded, "purchase timed out at %s", stepErr.Step)
+	}
+
+	switch status.Code(stepErr.Err) {
+	case codes.ResourceExhausted:
+		return status.Error(codes.ResourceExhausted, "not enough tickets available; join the event's waitlist to be offered returned tickets")
+	case codes.NotFound:
+		return status.Errorf(codes.NotFound, "event or ticket type not found: %v", stepErr.Err)
+	case codes.FailedPrecondition:
+		return status.Errorf(codes.FailedPrecondition, "tickets cannot be sold: %s", status.Convert(stepErr.Err).Message())
+	case codes.Aborted:
+		return status.Errorf(codes.Aborted, "seats are no longer available: %s", status.Convert(stepErr.Err).Message())
+	case codes.PermissionDenied:
+		return status.Errorf(codes.PermissionDenied, "presale access denied: %s", status.Convert(stepErr.Err).Message())
+	case codes.InvalidArgument:
+		return status.Errorf(codes.InvalidArgument, "invalid purchase: %s", status.Convert(stepErr.Err).Message())
+	}
+	return status.Errorf(codes.Internal, "failed to purchase ticket: %v", err)
+}
+
+func ticketStatusError(msg string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrTicketNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrHoldExpired), errors.Is(err, repository.ErrInvalidStatus):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
+
+// returnStock gives a cancelled or refunded ticket's quantity back to the
+// event and lets the waitlist offer it. If the event service cannot be
+// reached the ticket stays flagged and the hold sweeper retries the release.
+func (h *TicketHandler) returnStock(ctx context.Context, ticket *model.Ticket) {
+	if _, err := h.eventClient.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{
+		ReservationId: ticket.ReservationID,
+	}); err != nil {
+		log.Printf("Failed to release stock for ticket %s, leaving it to the sweeper: %v", ticket.ID.Hex(), err)
+		return
+	}
+
+	if err := h.repo.MarkStockReleased(ctx, ticket.ID); err != nil {
+		log.Printf("Failed to mark stock released for ticket %s: %v", ticket.ID.Hex(), err)
+	}
+	h.waitlist.Wake()
+}
+
+// returnPayment refunds a cancelled or refunded ticket's share of its order's
+// payment.
+func (h *TicketHandler) returnPayment(ctx context.Context, ticket *model.Ticket) {
+	if err := h.payments.RefundTicket(ctx, ticket); err != nil {
+		log.Printf("Failed to return payment for ticket %s: %v", ticket.ID.Hex(), err)
+	}
+}
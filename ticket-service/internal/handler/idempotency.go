@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotencyKeyHeader is the HTTP header clients may use instead of the
+// idempotency_key request field. The gateway forwards it as gRPC metadata
+// under its lower-cased name.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// idempotentRequest is a request that may carry an idempotency key.
+type idempotentRequest interface {
+	GetIdempotencyKey() string
+}
+
+func idempotencyKey(ctx context.Context, req idempotentRequest) string {
+	if key := req.GetIdempotencyKey(); key != "" {
+		return key
+	}
+
+	if values := metadata.ValueFromIncomingContext(ctx, "idempotency-key"); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// requestHash fingerprints the request payload, ignoring the idempotency key
+// itself, so that a reused key can be told apart from a genuine retry.
+func requestHash(req proto.Message) (string, error) {
+	clone := proto.Clone(req)
+	switch r := clone.(type) {
+	case *ticketpb.PurchaseTicketRequest:
+		r.IdempotencyKey = ""
+	case *ticketpb.CreateOrderRequest:
+		r.IdempotencyKey = ""
+	case *ticketpb.BuyListingRequest:
+		r.IdempotencyKey = ""
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// idempotencyLease is how long a request in progress holds its idempotency
+// key without renewing it. The lease is renewed while the request runs, so a
+// retry only takes the key over from a request whose process has died.
+const idempotencyLease = 30 * time.Second
+
+// idempotencyClaim is an idempotency key held by a request in progress.
+type idempotencyClaim struct {
+	record *model.IdempotencyRecord
+	done   chan struct{}
+}
+
+// beginIdempotent claims the user's key for req to method. When the key has
+// already completed with the same method and payload, the stored response is
+// decoded into resp and replayed is true.
+func (h *TicketHandler) beginIdempotent(ctx context.Context, userID, key, method string, req, resp proto.Message) (claim *idempotencyClaim, replayed bool, err error) {
+	hash, err := requestHash(req)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "failed to hash request: %v", err)
+	}
+
+	record, err := h.idempotencyRepo.Begin(ctx, userID, key, method, hash, time.Now(), idempotencyLease)
+	if err == nil {
+		claim = &idempotencyClaim{record: record, done: make(chan struct{})}
+		go h.renewIdempotent(claim)
+		return claim, false, nil
+	}
+	if !errors.Is(err, repository.ErrIdempotencyKeyExists) {
+		return nil, false, status.Errorf(codes.Internal, "failed to record idempotency key: %v", err)
+	}
+
+	if record.Method != method {
+		return nil, false, status.Errorf(codes.AlreadyExists, "idempotency key was already used with %s", record.Method)
+	}
+	if record.RequestHash != hash {
+		return nil, false, status.Error(codes.AlreadyExists, "idempotency key was already used with a different request")
+	}
+
+	if len(record.Response) == 0 {
+		return nil, false, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+	}
+
+	if err := proto.Unmarshal(record.Response, resp); err != nil {
+		return nil, false, status.Errorf(codes.Internal, "failed to decode stored response: %v", err)
+	}
+
+	return nil, true, nil
+}
+
+// renewIdempotent extends the lease of claim until the request finishes.
+func (h *TicketHandler) renewIdempotent(claim *idempotencyClaim) {
+	ticker := time.NewTicker(idempotencyLease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			err := h.idempotencyRepo.Renew(ctx, claim.record, time.Now().Add(idempotencyLease))
+			cancel()
+			if err != nil {
+				log.Printf("Failed to renew idempotency key %s: %v", claim.record.Key, err)
+			}
+		case <-claim.done:
+			return
+		}
+	}
+}
+
+// finishIdempotent stores the response for the claimed key, or releases the
+// key if the request failed so the client can retry it.
+func (h *TicketHandler) finishIdempotent(claim *idempotencyClaim, resp proto.Message, reqErr error) {
+	close(claim.done)
+	key := claim.record.Key
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if reqErr != nil {
+		if err := h.idempotencyRepo.Abandon(ctx, claim.record); err != nil {
+			log.Printf("Failed to release idempotency key %s: %v", key, err)
+		}
+		return
+	}
+
+	b, err := proto.Marshal(resp)
+	if err != nil {
+		log.Printf("Failed to encode response for idempotency key %s: %v", key, err)
+		return
+	}
+
+	if err := h.idempotencyRepo.Complete(ctx, claim.record, b); err != nil {
+		log.Printf("Failed to store response for idempotency key %s: %v", key, err)
+	}
+}
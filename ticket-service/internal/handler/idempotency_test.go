@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func recordDocument(mt *mtest.T, record *model.IdempotencyRecord) bson.D {
+	raw, err := bson.Marshal(record)
+	if err != nil {
+		mt.Fatalf("failed to marshal record: %v", err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		mt.Fatalf("failed to unmarshal record: %v", err)
+	}
+	return doc
+}
+
+// startedFilter returns the filter of the last command sent that is named
+// name.
+func startedFilter(mt *mtest.T, name string) bson.Raw {
+	var filter bson.Raw
+	for _, event := range mt.GetAllStartedEvents() {
+		if event.CommandName != name {
+			continue
+		}
+		switch name {
+		case "delete":
+			filter = event.Command.Lookup("deletes").Array().Index(0).Value().Document().Lookup("q").Document()
+		case "update":
+			filter = event.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("q").Document()
+		}
+	}
+	if filter == nil {
+		mt.Fatalf("no %s command was sent", name)
+	}
+	return filter
+}
+
+func TestTicketHandler_BeginIdempotent(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	req := &ticketpb.CreateOrderRequest{
+		UserId: "alice",
+		Items:  []*ticketpb.CreateOrderItem{{EventId: "event1", Quantity: 2}},
+	}
+	hash, err := requestHash(req)
+	if err != nil {
+		t.Fatalf("requestHash() error = %v", err)
+	}
+	stored, err := proto.Marshal(&ticketpb.CreateOrderResponse{Order: &ticketpb.Order{Id: "order1"}})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	duplicate := mtest.CreateWriteErrorsResponse(mtest.WriteError{Index: 0, Code: 11000, Message: "E11000 duplicate key error"})
+	notTakenOver := mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil})
+
+	tests := []struct {
+		name     string
+		record   model.IdempotencyRecord
+		wantCode codes.Code
+		replayed bool
+	}{
+		{
+			name:     "replay",
+			record:   model.IdempotencyRecord{UserID: "alice", Key: "key1", Method: "CreateOrder", RequestHash: hash, Response: stored},
+			wantCode: codes.OK,
+			replayed: true,
+		},
+		{
+			name:     "different RPC",
+			record:   model.IdempotencyRecord{UserID: "alice", Key: "key1", Method: "PurchaseTicket", RequestHash: hash, Response: stored},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "different request",
+			record:   model.IdempotencyRecord{UserID: "alice", Key: "key1", Method: "CreateOrder", RequestHash: "other", Response: stored},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "in progress",
+			record:   model.IdempotencyRecord{UserID: "alice", Key: "key1", Method: "CreateOrder", RequestHash: hash, Token: "other", LeaseUntil: time.Now().Add(time.Minute)},
+			wantCode: codes.Aborted,
+		},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			h := &TicketHandler{idempotencyRepo: repository.NewIdempotencyRepository(mt.DB, time.Hour)}
+			mt.AddMockResponses(
+				duplicate,
+				notTakenOver,
+				mtest.CreateCursorResponse(0, "test.idempotency_keys", mtest.FirstBatch, recordDocument(mt, &tt.record)),
+			)
+
+			resp := &ticketpb.CreateOrderResponse{}
+			claim, replayed, err := h.beginIdempotent(context.Background(), "alice", "key1", "CreateOrder", req, resp)
+			if status.Code(err) != tt.wantCode {
+				mt.Fatalf("beginIdempotent() error = %v, want %v", err, tt.wantCode)
+			}
+			if claim != nil || replayed != tt.replayed {
+				mt.Fatalf("beginIdempotent() = %v, %v, want no claim and replayed %v", claim, replayed, tt.replayed)
+			}
+			if tt.replayed && resp.GetOrder().GetId() != "order1" {
+				mt.Errorf("replayed response = %v, want order1", resp)
+			}
+		})
+	}
+
+	mt.Run("take over", func(mt *mtest.T) {
+		h := &TicketHandler{idempotencyRepo: repository.NewIdempotencyRepository(mt.DB, time.Hour)}
+		abandoned := model.IdempotencyRecord{UserID: "alice", Key: "key1", Method: "CreateOrder", RequestHash: hash, Token: "new", LeaseUntil: time.Now().Add(idempotencyLease)}
+		mt.AddMockResponses(
+			duplicate,
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: recordDocument(mt, &abandoned)}),
+			// The response is stored.
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+		)
+
+		claim, replayed, err := h.beginIdempotent(context.Background(), "alice", "key1", "CreateOrder", req, &ticketpb.CreateOrderResponse{})
+		if err != nil || claim == nil || replayed {
+			mt.Fatalf("beginIdempotent() = %v, %v, %v, want the key taken over", claim, replayed, err)
+		}
+
+		h.finishIdempotent(claim, &ticketpb.CreateOrderResponse{Order: &ticketpb.Order{Id: "order1"}}, nil)
+		if token := startedFilter(mt, "update").Lookup("token").StringValue(); token != "new" {
+			mt.Errorf("stored the response under token %q, want new", token)
+		}
+	})
+
+	mt.Run("abandon", func(mt *mtest.T) {
+		h := &TicketHandler{idempotencyRepo: repository.NewIdempotencyRepository(mt.DB, time.Hour)}
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+		)
+
+		claim, replayed, err := h.beginIdempotent(context.Background(), "alice", "key1", "CreateOrder", req, &ticketpb.CreateOrderResponse{})
+		if err != nil || claim == nil || replayed {
+			mt.Fatalf("beginIdempotent() = %v, %v, %v, want a new claim", claim, replayed, err)
+		}
+
+		h.finishIdempotent(claim, nil, errors.New("payment declined"))
+		filter := startedFilter(mt, "delete")
+		if filter.Lookup("token").StringValue() != claim.record.Token || filter.Lookup("user_id").StringValue() != "alice" {
+			mt.Errorf("released the key with filter %v, want alice's key under token %s", filter, claim.record.Token)
+		}
+	})
+}
@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *TicketHandler) CreateOrder(ctx context.Context, req *ticketpb.CreateOrderRequest) (*ticketpb.CreateOrderResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user ID is required")
+	}
+	if len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "an order needs at least one item")
+	}
+
+	items := make([]model.OrderItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		orderItem, err := orderItem(item)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, orderItem)
+	}
+
+	key := idempotencyKey(ctx, req)
+	if key == "" {
+		return h.createOrder(ctx, req, items)
+	}
+
+	resp := &ticketpb.CreateOrderResponse{}
+	claim, replayed, err := h.beginIdempotent(ctx, req.UserId, key, "CreateOrder", req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if replayed {
+		return resp, nil
+	}
+
+	resp, err = h.createOrder(ctx, req, items)
+	h.finishIdempotent(claim, resp, err)
+	return resp, err
+}
+
+func (h *TicketHandler) createOrder(ctx context.Context, req *ticketpb.CreateOrderRequest, items []model.OrderItem) (*ticketpb.CreateOrderResponse, error) {
+	order, tickets, err := h.placeOrder(ctx, req.UserId, items, req.PromoCodes, req.PaymentMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticketpb.CreateOrderResponse{
+		Order:   order.ToProto(),
+		Tickets: tickets,
+	}, nil
+}
+
+// placeOrder runs the purchase saga for a new order of items and returns the
+// confirmed order with its tickets.
+func (h *TicketHandler) placeOrder(ctx context.Context, userID string, items []model.OrderItem, promoCodes []string, paymentMethod string) (*model.Order, []*ticketpb.Ticket, error) {
+	normalized := make([]string, 0, len(promoCodes))
+	for _, code := range promoCodes {
+		normalized = append(normalized, model.NormalizePromoCode(code))
+	}
+	order := model.NewOrder(userID, items, normalized)
+
+	limits, err := h.checkPurchaseLimits(ctx, order)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := h.checkWaitlists(ctx, order); err != nil {
+		return nil, nil, err
+	}
+
+	order, err = h.purchases.Purchase(ctx, order, paymentMethod, limits, h.holdTTL)
+	if err != nil {
+		return nil, nil, purchaseError(err)
+	}
+
+	tickets, err := h.orderTickets(ctx, order)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, tickets, nil
+}
+
+// checkPurchaseLimits looks up the purchase limits of each event in the order
+// and rejects an order that would break them before any stock is reserved.
+// The purchase saga checks the per-user limits again when it creates the
+// tickets.
+func (h *TicketHandler) checkPurchaseLimits(ctx context.Context, order *model.Order) (map[string]model.PurchaseLimits, error) {
+	limits := make(map[string]model.PurchaseLimits)
+	for eventID, quantity := range order.EventQuantities() {
+		resp, err := h.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{Id: eventID})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Errorf(codes.NotFound, "event not found: %v", status.Convert(err).Message())
+			}
+			return nil, status.Errorf(codes.Unavailable, "failed to get event: %v", err)
+		}
+
+		eventLimits := model.PurchaseLimits{
+			MaxPerOrder: resp.Event.MaxPerOrder,
+			MaxPerUser:  resp.Event.MaxPerUser,
+		}
+		limits[eventID] = eventLimits
+
+		var held int32
+		if eventLimits.MaxPerUser > 0 {
+			held, err = h.repo.HeldQuantity(ctx, order.UserID, eventID, "")
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check purchase limits: %v", err)
+			}
+		}
+
+		if err := eventLimits.Check(quantity, held); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: %v", resp.Event.Name, err)
+		}
+	}
+	return limits, nil
+}
+
+// checkWaitlists turns away an order for tickets that users are waiting in
+// line for. Stock given back while a line is not empty belongs to the entry
+// at its head, so it is not sold to whoever asks first.
+func (h *TicketHandler) checkWaitlists(ctx context.Context, order *model.Order) error {
+	for _, item := range order.Items {
+		next, err := h.waitlistRepo.NextWaiting(ctx, repository.WaitlistQueue{
+			EventID:      item.EventID,
+			TicketTypeID: item.TicketTypeID,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check waitlist: %v", err)
+		}
+		if next != nil {
+			return status.Error(codes.ResourceExhausted, "tickets are held for the event's waitlist; join it to be offered returned tickets")
+		}
+	}
+	return nil
+}
+
+func (h *TicketHandler) GetOrder(ctx context.Context, req *ticketpb.GetOrderRequest) (*ticketpb.GetOrderResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "order ID is required")
+	}
+
+	if _, err := primitive.ObjectIDFromHex(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order ID format")
+	}
+
+	order, err := h.orderRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, repository.ErrOrderNotFound) {
+			return nil, status.Errorf(codes.NotFound, "failed to find order: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get order: %v", err)
+	}
+
+	tickets, err := h.orderTickets(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticketpb.GetOrderResponse{
+		Order:   order.ToProto(),
+		Tickets: tickets,
+	}, nil
+}
+
+func (h *TicketHandler) ListOrders(ctx context.Context, req *ticketpb.ListOrdersRequest) (*ticketpb.ListOrdersResponse, error) {
+	filter := repository.OrderFilter{
+		UserID: req.UserId,
+		Status: model.OrderStatus(req.Status),
+	}
+
+	if filter.Status != "" && !filter.Status.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order status: %s", req.Status)
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	after := primitive.NilObjectID
+	if req.PageToken != "" {
+		var err error
+		after, err = primitive.ObjectIDFromHex(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+	}
+
+	orders, next, err := h.orderRepo.List(ctx, filter, after, int64(pageSize))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list orders: %v", err)
+	}
+
+	resp := &ticketpb.ListOrdersResponse{
+		Orders: make([]*ticketpb.Order, 0, len(orders)),
+	}
+	for _, order := range orders {
+		resp.Orders = append(resp.Orders, order.ToProto())
+	}
+	if !next.IsZero() {
+		resp.NextPageToken = next.Hex()
+	}
+
+	return resp, nil
+}
+
+func (h *TicketHandler) orderTickets(ctx context.Context, order *model.Order) ([]*ticketpb.Ticket, error) {
+	tickets, err := h.repo.GetByOrderID(ctx, order.ID.Hex())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tickets of order: %v", err)
+	}
+
+	protoTickets := make([]*ticketpb.Ticket, 0, len(tickets))
+	for _, ticket := range tickets {
+		protoTickets = append(protoTickets, ticket.ToProto())
+	}
+	return protoTickets, nil
+}
+
+// orderItem validates a requested item. Quantity may be left out when seats
+// are given.
+func orderItem(item *ticketpb.CreateOrderItem) (model.OrderItem, error) {
+	if item.EventId == "" {
+		return model.OrderItem{}, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	quantity := item.Quantity
+	if quantity == 0 {
+		quantity = int32(len(item.SeatIds))
+	}
+
+	if quantity <= 0 {
+		return model.OrderItem{}, status.Error(codes.InvalidArgument, "quantity must be greater than 0")
+	}
+
+	if len(item.SeatIds) > 0 && int32(len(item.SeatIds)) != quantity {
+		return model.OrderItem{}, status.Error(codes.InvalidArgument, "one seat is required per ticket")
+	}
+
+	return model.OrderItem{
+		EventID:      item.EventId,
+		TicketTypeID: item.TicketTypeId,
+		SeatIDs:      item.SeatIds,
+		AccessCode:   item.AccessCode,
+		Quantity:     quantity,
+	}, nil
+}
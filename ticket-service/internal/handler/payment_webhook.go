@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/payment"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+const (
+	PaymentSignatureHeader = "Payment-Signature"
+
+	maxWebhookSize = 64 << 10
+)
+
+// NewPaymentWebhookHandler accepts payment provider notifications. Requests
+// whose signature does not verify are rejected.
+func NewPaymentWebhookHandler(payments *payment.Service) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookSize))
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+
+		err = payments.HandleWebhook(r.Context(), payload, r.Header.Get(PaymentSignatureHeader))
+		switch {
+		case err == nil:
+			w.WriteHeader(http.StatusNoContent)
+		case errors.Is(err, payment.ErrInvalidSignature):
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case errors.Is(err, repository.ErrPaymentNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			log.Printf("Failed to handle payment webhook: %v", err)
+			http.Error(w, "failed to handle webhook", http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/pricing"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/promo"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *TicketHandler) SetFeeSchedule(ctx context.Context, req *ticketpb.SetFeeScheduleRequest) (*ticketpb.SetFeeScheduleResponse, error) {
+	if req.EventId == "" || req.FeeSchedule == nil {
+		return nil, status.Error(codes.InvalidArgument, "event ID and fee schedule are required")
+	}
+
+	schedule := model.FeeScheduleFromProto(req.FeeSchedule)
+	schedule.EventID = req.EventId
+	if err := schedule.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid fee schedule: %v", err)
+	}
+
+	if err := h.prices.SetFeeSchedule(ctx, schedule); err != nil {
+		return nil, pricingError("failed to set fee schedule", err)
+	}
+
+	return &ticketpb.SetFeeScheduleResponse{FeeSchedule: schedule.ToProto()}, nil
+}
+
+func (h *TicketHandler) GetFeeSchedule(ctx context.Context, req *ticketpb.GetFeeScheduleRequest) (*ticketpb.GetFeeScheduleResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	schedule, err := h.prices.GetFeeSchedule(ctx, req.EventId)
+	if err != nil {
+		return nil, pricingError("failed to get fee schedule", err)
+	}
+
+	return &ticketpb.GetFeeScheduleResponse{FeeSchedule: schedule.ToProto()}, nil
+}
+
+func (h *TicketHandler) SetTaxRate(ctx context.Context, req *ticketpb.SetTaxRateRequest) (*ticketpb.SetTaxRateResponse, error) {
+	if req.Jurisdiction == "" || req.TaxRate == nil {
+		return nil, status.Error(codes.InvalidArgument, "jurisdiction and tax rate are required")
+	}
+
+	req.TaxRate.Jurisdiction = req.Jurisdiction
+	rate := model.TaxRateFromProto(req.TaxRate)
+	if err := rate.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tax rate: %v", err)
+	}
+
+	if err := h.prices.SetTaxRate(ctx, rate); err != nil {
+		return nil, pricingError("failed to set tax rate", err)
+	}
+
+	return &ticketpb.SetTaxRateResponse{TaxRate: rate.ToProto()}, nil
+}
+
+func (h *TicketHandler) ListTaxRates(ctx context.Context, req *ticketpb.ListTaxRatesRequest) (*ticketpb.ListTaxRatesResponse, error) {
+	rates, err := h.prices.ListTaxRates(ctx)
+	if err != nil {
+		return nil, pricingError("failed to list tax rates", err)
+	}
+
+	resp := &ticketpb.ListTaxRatesResponse{
+		TaxRates: make([]*ticketpb.TaxRate, 0, len(rates)),
+	}
+	for _, rate := range rates {
+		resp.TaxRates = append(resp.TaxRates, rate.ToProto())
+	}
+	return resp, nil
+}
+
+// QuoteOrder prices an order at the event's current prices, fees and tax with
+// the given promo codes applied, without reserving anything.
+func (h *TicketHandler) QuoteOrder(ctx context.Context, req *ticketpb.QuoteOrderRequest) (*ticketpb.QuoteOrderResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+	if req.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than 0")
+	}
+
+	resp, err := h.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{Id: req.EventId})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "event not found: %v", status.Convert(err).Message())
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to get event: %v", err)
+	}
+
+	line := promo.Line{
+		EventID:      req.EventId,
+		TicketTypeID: req.TicketTypeId,
+		Quantity:     req.Quantity,
+	}
+	description := resp.Event.Name
+
+	if len(resp.Event.TicketTypes) > 0 {
+		if req.TicketTypeId == "" {
+			return nil, status.Error(codes.InvalidArgument, "event has ticket types; a ticket type is required")
+		}
+
+		var ticketType *eventpb.TicketType
+		for _, tt := range resp.Event.TicketTypes {
+			if tt.Id == req.TicketTypeId {
+				ticketType = tt
+			}
+		}
+		if ticketType == nil {
+			return nil, status.Errorf(codes.NotFound, "ticket type %s not found", req.TicketTypeId)
+		}
+
+		line.UnitPrice = ticketType.Price
+		line.Currency = ticketType.Currency
+		description += " - " + ticketType.Name
+	}
+
+	quote, err := h.prices.Quote(ctx, line, req.UserId, req.PromoCodes)
+	if err != nil {
+		return nil, pricingError("failed to quote order", err)
+	}
+
+	return &ticketpb.QuoteOrderResponse{Quote: quoteToProto(quote, description)}, nil
+}
+
+func quoteToProto(quote *pricing.Quote, description string) *ticketpb.Quote {
+	breakdown := quote.Breakdown
+	pb := &ticketpb.Quote{
+		Currency: breakdown.Currency,
+		LineItems: []*ticketpb.QuoteLineItem{{
+			Description:  description,
+			EventId:      quote.Line.EventID,
+			TicketTypeId: quote.Line.TicketTypeID,
+			Quantity:     quote.Line.Quantity,
+			UnitPrice:    quote.Line.UnitPrice,
+			Amount:       quote.Line.Amount(),
+		}},
+		Subtotal:      breakdown.Base,
+		DiscountTotal: breakdown.Discount,
+		Total:         breakdown.Total,
+		Breakdown:     breakdown.ToProto(),
+	}
+
+	for _, discount := range quote.Discounts {
+		pb.Discounts = append(pb.Discounts, &ticketpb.QuoteDiscount{
+			Code:        discount.Code,
+			Description: discount.Description,
+			Amount:      discount.Amount,
+		})
+	}
+	return pb
+}
+
+// pricingError maps errors from pricing an order, which include those from
+// applying its promo codes.
+func pricingError(msg string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrFeeScheduleNotFound), errors.Is(err, repository.ErrTaxRateNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, pricing.ErrCurrencyMismatch), errors.Is(err, pricing.ErrMixedCurrencies):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return promoError(msg, err)
+	}
+}
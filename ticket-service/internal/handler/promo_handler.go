@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/promo"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *TicketHandler) CreatePromoCode(ctx context.Context, req *ticketpb.CreatePromoCodeRequest) (*ticketpb.CreatePromoCodeResponse, error) {
+	if req.PromoCode == nil {
+		return nil, status.Error(codes.InvalidArgument, "promo code is required")
+	}
+
+	code := model.PromoCodeFromProto(req.PromoCode)
+	if err := code.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid promo code: %v", err)
+	}
+
+	created, err := h.promos.Create(ctx, code)
+	if err != nil {
+		return nil, promoError("failed to create promo code", err)
+	}
+
+	return &ticketpb.CreatePromoCodeResponse{PromoCode: created.ToProto()}, nil
+}
+
+func (h *TicketHandler) GetPromoCode(ctx context.Context, req *ticketpb.GetPromoCodeRequest) (*ticketpb.GetPromoCodeResponse, error) {
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	code, err := h.promos.Get(ctx, req.Code)
+	if err != nil {
+		return nil, promoError("failed to get promo code", err)
+	}
+
+	return &ticketpb.GetPromoCodeResponse{PromoCode: code.ToProto()}, nil
+}
+
+func (h *TicketHandler) ListPromoCodes(ctx context.Context, req *ticketpb.ListPromoCodesRequest) (*ticketpb.ListPromoCodesResponse, error) {
+	pageSize := req.PageSize
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	promoCodes, err := h.promos.List(ctx, req.EventId, req.PageToken, int64(pageSize))
+	if err != nil {
+		return nil, promoError("failed to list promo codes", err)
+	}
+
+	resp := &ticketpb.ListPromoCodesResponse{
+		PromoCodes: make([]*ticketpb.PromoCode, 0, len(promoCodes)),
+	}
+	for _, code := range promoCodes {
+		resp.PromoCodes = append(resp.PromoCodes, code.ToProto())
+	}
+	if len(promoCodes) == int(pageSize) {
+		resp.NextPageToken = promoCodes[len(promoCodes)-1].Code
+	}
+
+	return resp, nil
+}
+
+func (h *TicketHandler) UpdatePromoCode(ctx context.Context, req *ticketpb.UpdatePromoCodeRequest) (*ticketpb.UpdatePromoCodeResponse, error) {
+	if req.Code == "" || req.PromoCode == nil {
+		return nil, status.Error(codes.InvalidArgument, "code and promo code are required")
+	}
+
+	code := model.PromoCodeFromProto(req.PromoCode)
+	code.Code = model.NormalizePromoCode(req.Code)
+	if err := code.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid promo code: %v", err)
+	}
+
+	updated, err := h.promos.Update(ctx, code)
+	if err != nil {
+		return nil, promoError("failed to update promo code", err)
+	}
+
+	return &ticketpb.UpdatePromoCodeResponse{PromoCode: updated.ToProto()}, nil
+}
+
+func (h *TicketHandler) DeletePromoCode(ctx context.Context, req *ticketpb.DeletePromoCodeRequest) (*ticketpb.DeletePromoCodeResponse, error) {
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	if err := h.promos.Delete(ctx, req.Code); err != nil {
+		return nil, promoError("failed to delete promo code", err)
+	}
+
+	return &ticketpb.DeletePromoCodeResponse{}, nil
+}
+
+func promoError(msg string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrPromoCodeNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrPromoCodeExists):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", msg, err)
+	case errors.Is(err, promo.ErrNotStackable), errors.Is(err, promo.ErrRepeated):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, promo.ErrExpired), errors.Is(err, promo.ErrNotApplicable),
+		errors.Is(err, repository.ErrPromoCodeUsedUp), errors.Is(err, repository.ErrPromoCodeUserLimit):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
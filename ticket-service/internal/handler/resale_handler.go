@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/payment"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/resale"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateListing puts a confirmed ticket on the resale market. The ticket
+// stays with its seller until it is bought.
+func (h *TicketHandler) CreateListing(ctx context.Context, req *ticketpb.CreateListingRequest) (*ticketpb.CreateListingResponse, error) {
+	if req.TicketId == "" || req.SellerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticket ID and seller ID are required")
+	}
+	if req.Price <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "price must be positive")
+	}
+
+	if _, err := primitive.ObjectIDFromHex(req.TicketId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ticket ID format")
+	}
+
+	ticket, err := h.repo.GetByID(ctx, req.TicketId)
+	if err != nil {
+		return nil, ticketStatusError("failed to get ticket", err)
+	}
+	if ticket.UserID != req.SellerId {
+		return nil, status.Error(codes.PermissionDenied, "ticket does not belong to the seller")
+	}
+	if ticket.Status != model.TicketStatusConfirmed {
+		return nil, status.Errorf(codes.FailedPrecondition, "only confirmed tickets can be resold; ticket is %s", ticket.Status)
+	}
+
+	if _, err := h.checkTransferable(ctx, ticket.EventID); err != nil {
+		return nil, err
+	}
+
+	listing, err := h.resale.Create(ctx, ticket, req.Price)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, status.Error(codes.AlreadyExists, "ticket is already listed for resale")
+	}
+	if err != nil {
+		return nil, resaleError("failed to create listing", err)
+	}
+
+	return &ticketpb.CreateListingResponse{Listing: listing.ToProto()}, nil
+}
+
+func (h *TicketHandler) GetListing(ctx context.Context, req *ticketpb.GetListingRequest) (*ticketpb.GetListingResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "listing ID is required")
+	}
+
+	listing, err := h.listingRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, resaleError("failed to get listing", err)
+	}
+
+	return &ticketpb.GetListingResponse{Listing: listing.ToProto()}, nil
+}
+
+// ListListings pages through listings, only those still on sale unless a
+// status is asked for.
+func (h *TicketHandler) ListListings(ctx context.Context, req *ticketpb.ListListingsRequest) (*ticketpb.ListListingsResponse, error) {
+	filter := repository.ListingFilter{
+		EventID:  req.EventId,
+		SellerID: req.SellerId,
+		Status:   model.ListingStatus(req.Status),
+	}
+
+	if filter.Status == "" {
+		filter.Status = model.ListingStatusActive
+	}
+	if !filter.Status.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid listing status: %s", req.Status)
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	after := primitive.NilObjectID
+	if req.PageToken != "" {
+		var err error
+		after, err = primitive.ObjectIDFromHex(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+	}
+
+	listings, next, err := h.listingRepo.List(ctx, filter, after, int64(pageSize))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list listings: %v", err)
+	}
+
+	resp := &ticketpb.ListListingsResponse{
+		Listings: make([]*ticketpb.Listing, 0, len(listings)),
+	}
+	for _, listing := range listings {
+		resp.Listings = append(resp.Listings, listing.ToProto())
+	}
+	if !next.IsZero() {
+		resp.NextPageToken = next.Hex()
+	}
+
+	return resp, nil
+}
+
+// WithdrawListing takes a listing off sale at its seller's request. A listing
+// a buyer is paying for cannot be withdrawn.
+func (h *TicketHandler) WithdrawListing(ctx context.Context, req *ticketpb.WithdrawListingRequest) (*ticketpb.WithdrawListingResponse, error) {
+	if req.Id == "" || req.SellerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "listing ID and seller ID are required")
+	}
+
+	listing, err := h.listingRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, resaleError("failed to get listing", err)
+	}
+	if listing.SellerID != req.SellerId {
+		return nil, status.Error(codes.PermissionDenied, "only the seller can withdraw a listing")
+	}
+
+	listing, err = h.listingRepo.Withdraw(ctx, listing.ID, req.SellerId)
+	if err != nil {
+		return nil, resaleError("failed to withdraw listing", err)
+	}
+
+	return &ticketpb.WithdrawListingResponse{Listing: listing.ToProto()}, nil
+}
+
+// BuyListing pays for a listing and reissues its ticket to the buyer with a
+// fresh code. Codes issued to the seller stop getting in.
+func (h *TicketHandler) BuyListing(ctx context.Context, req *ticketpb.BuyListingRequest) (*ticketpb.BuyListingResponse, error) {
+	if req.Id == "" || req.BuyerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "listing ID and buyer ID are required")
+	}
+
+	key := idempotencyKey(ctx, req)
+	if key == "" {
+		return h.buyListing(ctx, req)
+	}
+
+	resp := &ticketpb.BuyListingResponse{}
+	claim, replayed, err := h.beginIdempotent(ctx, req.BuyerId, key, "BuyListing", req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if replayed {
+		return resp, nil
+	}
+
+	resp, err = h.buyListing(ctx, req)
+	h.finishIdempotent(claim, resp, err)
+	return resp, err
+}
+
+func (h *TicketHandler) buyListing(ctx context.Context, req *ticketpb.BuyListingRequest) (*ticketpb.BuyListingResponse, error) {
+	listing, err := h.listingRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, resaleError("failed to get listing", err)
+	}
+	// The organizer may have forbidden transfers since the ticket was listed.
+	event, err := h.checkTransferable(ctx, listing.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	listing, order, ticket, err := h.resale.Buy(ctx, req.Id, req.BuyerId, req.PaymentMethod, event.MaxPerUser)
+	if err != nil {
+		return nil, resaleError("failed to buy listing", err)
+	}
+
+	resp := &ticketpb.BuyListingResponse{
+		Listing: listing.ToProto(),
+		Order:   order.ToProto(),
+		Ticket:  ticket.ToProto(),
+	}
+
+	// The sale is done either way; the buyer can fetch the code later if it
+	// cannot be issued now.
+	token, _, validUntil, err := h.issueCode(ctx, ticket)
+	if err != nil {
+		log.Printf("Failed to issue code for resold ticket %s: %v", ticket.ID.Hex(), err)
+		return resp, nil
+	}
+	resp.Token = token
+	resp.CodeExpiresAt = timestamppb.New(validUntil)
+
+	return resp, nil
+}
+
+func (h *TicketHandler) SetResalePolicy(ctx context.Context, req *ticketpb.SetResalePolicyRequest) (*ticketpb.SetResalePolicyResponse, error) {
+	if req.EventId == "" || req.ResalePolicy == nil {
+		return nil, status.Error(codes.InvalidArgument, "event ID and resale policy are required")
+	}
+
+	policy := model.ResalePolicyFromProto(req.ResalePolicy)
+	policy.EventID = req.EventId
+	if err := policy.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resale policy: %v", err)
+	}
+
+	if err := h.resale.SetPolicy(ctx, policy); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set resale policy: %v", err)
+	}
+
+	return &ticketpb.SetResalePolicyResponse{ResalePolicy: policy.ToProto()}, nil
+}
+
+func (h *TicketHandler) GetResalePolicy(ctx context.Context, req *ticketpb.GetResalePolicyRequest) (*ticketpb.GetResalePolicyResponse, error) {
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID is required")
+	}
+
+	policy, err := h.resale.Policy(ctx, req.EventId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get resale policy: %v", err)
+	}
+
+	return &ticketpb.GetResalePolicyResponse{ResalePolicy: policy.ToProto()}, nil
+}
+
+func resaleError(msg string, err error) error {
+	var limitErr *model.LimitError
+	switch {
+	case errors.As(err, &limitErr):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrListingNotFound), errors.Is(err, repository.ErrTicketNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, resale.ErrPriceAboveCap), errors.Is(err, resale.ErrOwnListing):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, payment.ErrDeclined):
+		return status.Error(codes.FailedPrecondition, "payment declined")
+	case errors.Is(err, repository.ErrListingUnavailable), errors.Is(err, repository.ErrInvalidStatus),
+		errors.Is(err, resale.ErrHoldExpired):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
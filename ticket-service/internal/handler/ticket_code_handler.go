@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/ticketcode"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultCodeSize = 256
+	maxCodeSize     = 2048
+)
+
+// GetTicketCode signs a token for a confirmed ticket and renders it as a QR
+// code. The token is valid until the ticket code grace period after the
+// event's date.
+func (h *TicketHandler) GetTicketCode(ctx context.Context, req *ticketpb.GetTicketCodeRequest) (*ticketpb.GetTicketCodeResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticket ID is required")
+	}
+
+	if _, err := primitive.ObjectIDFromHex(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ticket ID format")
+	}
+
+	format := ticketcode.FormatPNG
+	if req.Format != "" {
+		format = ticketcode.Format(strings.ToUpper(req.Format))
+	}
+	if format != ticketcode.FormatPNG && format != ticketcode.FormatSVG {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported format %s; use PNG or SVG", req.Format)
+	}
+
+	size := int(req.Size)
+	if size <= 0 {
+		size = defaultCodeSize
+	}
+	if size > maxCodeSize {
+		return nil, status.Errorf(codes.InvalidArgument, "size must be at most %d pixels", maxCodeSize)
+	}
+
+	ticket, err := h.repo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, ticketStatusError("failed to get ticket", err)
+	}
+	if ticket.Status != model.TicketStatusConfirmed {
+		return nil, status.Errorf(codes.FailedPrecondition, "only confirmed tickets have a code; ticket is %s", ticket.Status)
+	}
+
+	token, claims, validUntil, err := h.issueCode(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	image, contentType, err := ticketcode.Render(token, format, size)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to render ticket code: %v", err)
+	}
+
+	return &ticketpb.GetTicketCodeResponse{
+		Token:       token,
+		ContentType: contentType,
+		Image:       image,
+		KeyId:       claims.KeyID,
+		ExpiresAt:   timestamppb.New(validUntil),
+	}, nil
+}
+
+// issueCode signs a token for ticket that is valid until codeValidUntil.
+func (h *TicketHandler) issueCode(ctx context.Context, ticket *model.Ticket) (string, *ticketcode.Claims, time.Time, error) {
+	validUntil, err := h.codeValidUntil(ctx, ticket.EventID)
+	if err != nil {
+		return "", nil, time.Time{}, err
+	}
+
+	token, claims, err := h.ticketCodes.Issue(ctx, ticket, validUntil)
+	if err != nil {
+		return "", nil, time.Time{}, status.Errorf(codes.Internal, "failed to sign ticket code: %v", err)
+	}
+	return token, claims, validUntil, nil
+}
+
+// codeValidUntil is when codes for tickets to an event stop being accepted.
+func (h *TicketHandler) codeValidUntil(ctx context.Context, eventID string) (time.Time, error) {
+	resp, err := h.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{Id: eventID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return time.Time{}, status.Errorf(codes.NotFound, "event not found: %v", status.Convert(err).Message())
+		}
+		return time.Time{}, status.Errorf(codes.Unavailable, "failed to get event: %v", err)
+	}
+
+	date, err := time.Parse(time.RFC3339, resp.Event.Date)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.Internal, "event has an invalid date %q", resp.Event.Date)
+	}
+
+	validUntil := date.Add(h.codeGrace)
+	if validUntil.Before(time.Now()) {
+		return time.Time{}, status.Error(codes.FailedPrecondition, "the event is over")
+	}
+	return validUntil, nil
+}
+
+// RotateTicketSigningKey makes a new key the one new ticket codes are signed
+// with. Codes signed with the previous key stay valid.
+func (h *TicketHandler) RotateTicketSigningKey(ctx context.Context, req *ticketpb.RotateTicketSigningKeyRequest) (*ticketpb.RotateTicketSigningKeyResponse, error) {
+	key, err := h.ticketCodes.Rotate(ctx)
+	if err != nil {
+		return nil, signingKeyError("failed to rotate signing key", err)
+	}
+
+	return &ticketpb.RotateTicketSigningKeyResponse{Key: key.ToProto()}, nil
+}
+
+func (h *TicketHandler) ListTicketSigningKeys(ctx context.Context, req *ticketpb.ListTicketSigningKeysRequest) (*ticketpb.ListTicketSigningKeysResponse, error) {
+	keys, err := h.ticketCodes.TrustedKeys(ctx)
+	if err != nil {
+		return nil, signingKeyError("failed to list signing keys", err)
+	}
+
+	resp := &ticketpb.ListTicketSigningKeysResponse{
+		Keys: make([]*ticketpb.TicketSigningKey, 0, len(keys)),
+	}
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, key.ToProto())
+	}
+	return resp, nil
+}
+
+func (h *TicketHandler) RevokeTicketSigningKey(ctx context.Context, req *ticketpb.RevokeTicketSigningKeyRequest) (*ticketpb.RevokeTicketSigningKeyResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "key ID is required")
+	}
+
+	key, err := h.ticketCodes.Revoke(ctx, req.Id)
+	if err != nil {
+		return nil, signingKeyError("failed to revoke signing key", err)
+	}
+
+	return &ticketpb.RevokeTicketSigningKeyResponse{Key: key.ToProto()}, nil
+}
+
+func signingKeyError(msg string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrSigningKeyNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrSigningKeyActive):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
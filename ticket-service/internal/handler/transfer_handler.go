@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// InitiateTicketTransfer offers a confirmed ticket to another user. The
+// ticket stays with its holder until the recipient accepts.
+func (h *TicketHandler) InitiateTicketTransfer(ctx context.Context, req *ticketpb.InitiateTicketTransferRequest) (*ticketpb.InitiateTicketTransferResponse, error) {
+	if req.TicketId == "" || req.FromUserId == "" || req.ToUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticket ID, sender and recipient are required")
+	}
+
+	if _, err := primitive.ObjectIDFromHex(req.TicketId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid ticket ID format")
+	}
+
+	if req.FromUserId == req.ToUserId {
+		return nil, status.Error(codes.InvalidArgument, "a ticket cannot be transferred to its holder")
+	}
+
+	ticket, err := h.repo.GetByID(ctx, req.TicketId)
+	if err != nil {
+		return nil, ticketStatusError("failed to get ticket", err)
+	}
+	if ticket.UserID != req.FromUserId {
+		return nil, status.Error(codes.PermissionDenied, "ticket does not belong to the sender")
+	}
+	if ticket.Status != model.TicketStatusConfirmed {
+		return nil, status.Errorf(codes.FailedPrecondition, "only confirmed tickets can be transferred; ticket is %s", ticket.Status)
+	}
+
+	if _, err := h.checkTransferable(ctx, ticket.EventID); err != nil {
+		return nil, err
+	}
+
+	transfer := model.NewTransfer(ticket, req.ToUserId)
+	err = h.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := h.transferRepo.Create(ctx, transfer); err != nil {
+			return err
+		}
+		return h.outboxRepo.Add(ctx, model.NewTransferEvent(model.EventTicketTransferInitiated, ticket, transfer))
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, status.Error(codes.AlreadyExists, "ticket already has a pending transfer")
+	}
+	if err != nil {
+		return nil, transferError("failed to initiate transfer", err)
+	}
+
+	return &ticketpb.InitiateTicketTransferResponse{Transfer: transfer.ToProto()}, nil
+}
+
+// AcceptTicketTransfer gives the ticket to the recipient and issues them a
+// fresh code. Codes issued to the previous holder stop getting in, and a
+// resale listing of the ticket is taken off sale.
+func (h *TicketHandler) AcceptTicketTransfer(ctx context.Context, req *ticketpb.AcceptTicketTransferRequest) (*ticketpb.AcceptTicketTransferResponse, error) {
+	if req.Id == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "transfer ID and user ID are required")
+	}
+
+	transfer, err := h.transferRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, transferError("failed to get transfer", err)
+	}
+	if transfer.ToUserID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the recipient can accept a transfer")
+	}
+
+	// The organizer may have forbidden transfers since the offer was made.
+	event, err := h.checkTransferable(ctx, transfer.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketID, err := primitive.ObjectIDFromHex(transfer.TicketID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "transfer has an invalid ticket ID: %v", err)
+	}
+
+	var ticket *model.Ticket
+	err = h.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		// A transferred ticket counts against the recipient's limit as if
+		// they had bought it.
+		if err := h.repo.CheckUserLimit(ctx, transfer.ToUserID, transfer.EventID, event.MaxPerUser, 1); err != nil {
+			return err
+		}
+		accepted, err := h.transferRepo.SetStatus(ctx, transfer.ID, model.TransferStatusAccepted)
+		if err != nil {
+			return err
+		}
+		ticket, err = h.repo.Transfer(ctx, ticketID, accepted, accepted.Record(time.Now()))
+		if err != nil {
+			return err
+		}
+		if err := h.listingRepo.DelistTicket(ctx, accepted.TicketID, model.DelistTicketTransferred); err != nil {
+			return err
+		}
+		transfer = accepted
+		return h.outboxRepo.Add(ctx, model.NewTransferEvent(model.EventTicketTransferAccepted, ticket, accepted))
+	})
+	if err != nil {
+		return nil, transferError("failed to accept transfer", err)
+	}
+
+	resp := &ticketpb.AcceptTicketTransferResponse{
+		Transfer: transfer.ToProto(),
+		Ticket:   ticket.ToProto(),
+	}
+
+	// The transfer is done either way; the recipient can fetch the code
+	// later if it cannot be issued now.
+	token, _, validUntil, err := h.issueCode(ctx, ticket)
+	if err != nil {
+		log.Printf("Failed to issue code for transferred ticket %s: %v", ticket.ID.Hex(), err)
+		return resp, nil
+	}
+	resp.Token = token
+	resp.CodeExpiresAt = timestamppb.New(validUntil)
+
+	return resp, nil
+}
+
+// CancelTicketTransfer withdraws a pending transfer, by its sender, or
+// declines it, by its recipient.
+func (h *TicketHandler) CancelTicketTransfer(ctx context.Context, req *ticketpb.CancelTicketTransferRequest) (*ticketpb.CancelTicketTransferResponse, error) {
+	if req.Id == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "transfer ID and user ID are required")
+	}
+
+	transfer, err := h.transferRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, transferError("failed to get transfer", err)
+	}
+	if !transfer.Involves(req.UserId) {
+		return nil, status.Error(codes.PermissionDenied, "only the sender or recipient can cancel a transfer")
+	}
+
+	ticket, err := h.repo.GetByID(ctx, transfer.TicketID)
+	if err != nil {
+		return nil, ticketStatusError("failed to get ticket", err)
+	}
+
+	err = h.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		cancelled, err := h.transferRepo.SetStatus(ctx, transfer.ID, model.TransferStatusCancelled)
+		if err != nil {
+			return err
+		}
+		transfer = cancelled
+		return h.outboxRepo.Add(ctx, model.NewTransferEvent(model.EventTicketTransferCancelled, ticket, cancelled))
+	})
+	if err != nil {
+		return nil, transferError("failed to cancel transfer", err)
+	}
+
+	return &ticketpb.CancelTicketTransferResponse{Transfer: transfer.ToProto()}, nil
+}
+
+func (h *TicketHandler) GetTicketTransfer(ctx context.Context, req *ticketpb.GetTicketTransferRequest) (*ticketpb.GetTicketTransferResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "transfer ID is required")
+	}
+
+	transfer, err := h.transferRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, transferError("failed to get transfer", err)
+	}
+
+	return &ticketpb.GetTicketTransferResponse{Transfer: transfer.ToProto()}, nil
+}
+
+func (h *TicketHandler) ListTicketTransfers(ctx context.Context, req *ticketpb.ListTicketTransfersRequest) (*ticketpb.ListTicketTransfersResponse, error) {
+	filter := repository.TransferFilter{
+		UserID: req.UserId,
+		Status: model.TransferStatus(req.Status),
+	}
+
+	if filter.Status != "" && !filter.Status.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transfer status: %s", req.Status)
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	after := primitive.NilObjectID
+	if req.PageToken != "" {
+		var err error
+		after, err = primitive.ObjectIDFromHex(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+	}
+
+	transfers, next, err := h.transferRepo.List(ctx, filter, after, int64(pageSize))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %v", err)
+	}
+
+	resp := &ticketpb.ListTicketTransfersResponse{
+		Transfers: make([]*ticketpb.TicketTransfer, 0, len(transfers)),
+	}
+	for _, transfer := range transfers {
+		resp.Transfers = append(resp.Transfers, transfer.ToProto())
+	}
+	if !next.IsZero() {
+		resp.NextPageToken = next.Hex()
+	}
+
+	return resp, nil
+}
+
+// checkTransferable rejects transfers of tickets to events whose organizer
+// forbids them. Tickets to cancelled events are refunded, which already stops
+// them from changing hands.
+func (h *TicketHandler) checkTransferable(ctx context.Context, eventID string) (*eventpb.Event, error) {
+	resp, err := h.eventClient.GetEvent(ctx, &eventpb.GetEventRequest{Id: eventID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "event not found: %v", status.Convert(err).Message())
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to get event: %v", err)
+	}
+
+	if resp.Event.NonTransferable {
+		return nil, status.Error(codes.FailedPrecondition, "tickets to this event are non-transferable")
+	}
+	return resp.Event, nil
+}
+
+func transferError(msg string, err error) error {
+	var limitErr *model.LimitError
+	switch {
+	case errors.As(err, &limitErr):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTransferNotFound), errors.Is(err, repository.ErrTicketNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrTransferNotPending), errors.Is(err, repository.ErrInvalidStatus):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
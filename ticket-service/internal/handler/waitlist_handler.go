@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// JoinWaitlist puts a user in line for tickets to a sold-out event. Joining
+// is only possible while there are not enough tickets to buy outright, and
+// for no more tickets than the user could buy. Events with reserved seating
+// have no waitlist, since returned stock is a particular seat.
+func (h *TicketHandler) JoinWaitlist(ctx context.Context, req *ticketpb.JoinWaitlistRequest) (*ticketpb.JoinWaitlistResponse, error) {
+	if req.EventId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event ID and user ID are required")
+	}
+	if req.Quantity <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "quantity must be greater than 0")
+	}
+
+	availability, err := h.eventClient.CheckAvailability(ctx, &eventpb.CheckAvailabilityRequest{
+		EventId:      req.EventId,
+		Quantity:     req.Quantity,
+		TicketTypeId: req.TicketTypeId,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, status.Errorf(codes.NotFound, "event or ticket type not found: %v", status.Convert(err).Message())
+		case codes.FailedPrecondition, codes.PermissionDenied, codes.InvalidArgument:
+			return nil, status.Errorf(codes.FailedPrecondition, "tickets cannot be sold: %s", status.Convert(err).Message())
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to check availability: %v", err)
+	}
+	if availability.Available {
+		return nil, status.Error(codes.FailedPrecondition, "tickets are available; buy them instead of joining the waitlist")
+	}
+
+	seatMap, err := h.eventClient.GetSeatMap(ctx, &eventpb.GetSeatMapRequest{EventId: req.EventId})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to get seat map: %v", err)
+	}
+	if len(seatMap.GetSeatMap().GetSections()) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, "events with reserved seating have no waitlist")
+	}
+
+	entry := model.NewWaitlistEntry(req.EventId, req.TicketTypeId, req.UserId, req.Quantity)
+	if _, err := h.checkPurchaseLimits(ctx, entry.Order(nil)); err != nil {
+		return nil, err
+	}
+
+	err = h.waitlistRepo.Create(ctx, entry)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, status.Error(codes.AlreadyExists, "user is already on the waitlist of this event")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to join waitlist: %v", err)
+	}
+	h.waitlist.Wake()
+
+	pb, err := h.waitlistEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	return &ticketpb.JoinWaitlistResponse{Entry: pb}, nil
+}
+
+func (h *TicketHandler) GetWaitlistEntry(ctx context.Context, req *ticketpb.GetWaitlistEntryRequest) (*ticketpb.GetWaitlistEntryResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "waitlist entry ID is required")
+	}
+
+	entry, err := h.waitlistRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, waitlistError("failed to get waitlist entry", err)
+	}
+
+	pb, err := h.waitlistEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	return &ticketpb.GetWaitlistEntryResponse{Entry: pb}, nil
+}
+
+func (h *TicketHandler) ListWaitlistEntries(ctx context.Context, req *ticketpb.ListWaitlistEntriesRequest) (*ticketpb.ListWaitlistEntriesResponse, error) {
+	filter := repository.WaitlistFilter{
+		EventID: req.EventId,
+		UserID:  req.UserId,
+		Status:  model.WaitlistStatus(req.Status),
+	}
+
+	if filter.Status != "" && !filter.Status.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid waitlist status: %s", req.Status)
+	}
+
+	pageSize := req.PageSize
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	after := primitive.NilObjectID
+	if req.PageToken != "" {
+		var err error
+		after, err = primitive.ObjectIDFromHex(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+	}
+
+	entries, next, err := h.waitlistRepo.List(ctx, filter, after, int64(pageSize))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list waitlist entries: %v", err)
+	}
+
+	resp := &ticketpb.ListWaitlistEntriesResponse{
+		Entries: make([]*ticketpb.WaitlistEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		pb, err := h.waitlistEntry(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+		resp.Entries = append(resp.Entries, pb)
+	}
+	if !next.IsZero() {
+		resp.NextPageToken = next.Hex()
+	}
+
+	return resp, nil
+}
+
+// LeaveWaitlist takes a user out of line, turning down any offer they hold so
+// it passes to the next entry.
+func (h *TicketHandler) LeaveWaitlist(ctx context.Context, req *ticketpb.LeaveWaitlistRequest) (*ticketpb.LeaveWaitlistResponse, error) {
+	if req.Id == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "waitlist entry ID and user ID are required")
+	}
+
+	entry, err := h.waitlistRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, waitlistError("failed to get waitlist entry", err)
+	}
+	if entry.UserID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "waitlist entry does not belong to the user")
+	}
+
+	entry, err = h.waitlistRepo.Leave(ctx, entry.ID, req.UserId)
+	if err != nil {
+		return nil, waitlistError("failed to leave waitlist", err)
+	}
+	h.waitlist.Wake()
+
+	return &ticketpb.LeaveWaitlistResponse{Entry: entry.ToProto(0)}, nil
+}
+
+// ClaimWaitlistOffer buys the tickets a user has been offered, taking the
+// stock held for them. An offer is good for one purchase attempt; if it fails
+// the stock goes to the next entry in line.
+func (h *TicketHandler) ClaimWaitlistOffer(ctx context.Context, req *ticketpb.ClaimWaitlistOfferRequest) (*ticketpb.ClaimWaitlistOfferResponse, error) {
+	if req.Id == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "waitlist entry ID and user ID are required")
+	}
+
+	entry, err := h.waitlistRepo.GetByID(ctx, req.Id)
+	if err != nil {
+		return nil, waitlistError("failed to get waitlist entry", err)
+	}
+	if entry.UserID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "waitlist entry does not belong to the user")
+	}
+	if entry.Status != model.WaitlistStatusOffered || entry.OfferExpired(time.Now()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "waitlist entry has no open offer; it is %s", entry.Status)
+	}
+
+	order := entry.Order(req.PromoCodes)
+	limits, err := h.checkPurchaseLimits(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err = h.waitlistRepo.Claim(ctx, entry.ID, req.UserId, order.ID.Hex(), time.Now())
+	if err != nil {
+		return nil, waitlistError("failed to claim waitlist offer", err)
+	}
+
+	order, err = h.purchases.Purchase(ctx, order, req.PaymentMethod, limits, h.holdTTL)
+	if err != nil {
+		// The purchase gives back the stock it reserved, but not stock it
+		// never got to.
+		h.waitlist.Release(context.WithoutCancel(ctx), entry)
+		return nil, purchaseError(err)
+	}
+
+	tickets, err := h.orderTickets(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ticketpb.ClaimWaitlistOfferResponse{
+		Entry:   entry.ToProto(0),
+		Order:   order.ToProto(),
+		Tickets: tickets,
+	}, nil
+}
+
+// waitlistEntry converts entry, with its place in line if it is waiting.
+func (h *TicketHandler) waitlistEntry(ctx context.Context, entry *model.WaitlistEntry) (*ticketpb.WaitlistEntry, error) {
+	if entry.Status != model.WaitlistStatusWaiting {
+		return entry.ToProto(0), nil
+	}
+
+	position, err := h.waitlistRepo.Position(ctx, entry)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get waitlist position: %v", err)
+	}
+	return entry.ToProto(position), nil
+}
+
+func waitlistError(msg string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrWaitlistEntryNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", msg, err)
+	case errors.Is(err, repository.ErrNoWaitlistOffer), errors.Is(err, repository.ErrWaitlistEntryClosed):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// soldOutEventClient reports every event sold out, with the given seat map.
+type soldOutEventClient struct {
+	eventpb.EventServiceClient
+
+	sections []*eventpb.SeatMapSection
+}
+
+func (c *soldOutEventClient) CheckAvailability(ctx context.Context, req *eventpb.CheckAvailabilityRequest, opts ...grpc.CallOption) (*eventpb.CheckAvailabilityResponse, error) {
+	return &eventpb.CheckAvailabilityResponse{}, nil
+}
+
+func (c *soldOutEventClient) GetSeatMap(ctx context.Context, req *eventpb.GetSeatMapRequest, opts ...grpc.CallOption) (*eventpb.GetSeatMapResponse, error) {
+	return &eventpb.GetSeatMapResponse{SeatMap: &eventpb.SeatMap{EventId: req.EventId, Sections: c.sections}}, nil
+}
+
+func TestTicketHandler_JoinWaitlist_ReservedSeating(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("seated event", func(mt *mtest.T) {
+		h := &TicketHandler{
+			waitlistRepo: repository.NewWaitlistRepository(mt.DB),
+			eventClient:  &soldOutEventClient{sections: []*eventpb.SeatMapSection{{Name: "Stalls"}}},
+		}
+
+		_, err := h.JoinWaitlist(context.Background(), &ticketpb.JoinWaitlistRequest{EventId: "event1", UserId: "alice", Quantity: 1})
+		if status.Code(err) != codes.FailedPrecondition {
+			mt.Fatalf("JoinWaitlist() error = %v, want FailedPrecondition", err)
+		}
+		for _, event := range mt.GetAllStartedEvents() {
+			if event.CommandName == "insert" {
+				mt.Errorf("JoinWaitlist() put the user in line for a seated event")
+			}
+		}
+	})
+}
+
+func TestTicketHandler_CheckWaitlists(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	order := model.NewOrder("bob", []model.OrderItem{{EventID: "event1", Quantity: 1}}, nil)
+
+	mt.Run("line is empty", func(mt *mtest.T) {
+		h := &TicketHandler{waitlistRepo: repository.NewWaitlistRepository(mt.DB)}
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "test.waitlist", mtest.FirstBatch))
+
+		if err := h.checkWaitlists(context.Background(), order); err != nil {
+			mt.Fatalf("checkWaitlists() error = %v", err)
+		}
+	})
+
+	mt.Run("someone is waiting", func(mt *mtest.T) {
+		h := &TicketHandler{waitlistRepo: repository.NewWaitlistRepository(mt.DB)}
+		waiting := model.NewWaitlistEntry("event1", "", "alice", 1)
+		raw, err := bson.Marshal(waiting)
+		if err != nil {
+			mt.Fatalf("failed to marshal entry: %v", err)
+		}
+		var doc bson.D
+		if err := bson.Unmarshal(raw, &doc); err != nil {
+			mt.Fatalf("failed to unmarshal entry: %v", err)
+		}
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "test.waitlist", mtest.FirstBatch, doc))
+
+		err = h.checkWaitlists(context.Background(), order)
+		if status.Code(err) != codes.ResourceExhausted {
+			mt.Fatalf("checkWaitlists() error = %v, want ResourceExhausted", err)
+		}
+	})
+}
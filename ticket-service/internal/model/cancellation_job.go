@@ -0,0 +1,115 @@
+package model
+
+import (
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CancellationJobStatus string
+
+const (
+	CancellationJobRunning             CancellationJobStatus = "RUNNING"
+	CancellationJobCompleted           CancellationJobStatus = "COMPLETED"
+	CancellationJobCompletedWithErrors CancellationJobStatus = "COMPLETED_WITH_ERRORS"
+)
+
+// maxRecordedFailures caps how many failures a job keeps details of; the
+// Failed count keeps going.
+const maxRecordedFailures = 100
+
+type CancellationFailure struct {
+	TicketID string `bson:"ticket_id" json:"ticket_id"`
+	Error    string `bson:"error" json:"error"`
+}
+
+// CancellationJob walks the active tickets of a cancelled event in ID order,
+// refunding confirmed tickets and cancelling held ones. Cursor is the last
+// ticket handled in the current pass, so a job picked up after a restart
+// carries on from there. Tickets that fail stay active and are retried by the
+// next pass; Failed and Failures cover the current pass only. There is one
+// job per event, keyed by the event ID.
+type CancellationJob struct {
+	EventID       string                `bson:"_id" json:"event_id"`
+	Reason        string                `bson:"reason,omitempty" json:"reason,omitempty"`
+	Status        CancellationJobStatus `bson:"status" json:"status"`
+	Cursor        primitive.ObjectID    `bson:"cursor" json:"-"`
+	Pass          int32                 `bson:"pass" json:"-"`
+	PassStartedAt time.Time             `bson:"pass_started_at" json:"-"`
+	PassFound     bool                  `bson:"pass_found" json:"-"`
+	Refunded      int32                 `bson:"refunded" json:"refunded"`
+	Cancelled     int32                 `bson:"cancelled" json:"cancelled"`
+	Skipped       int32                 `bson:"skipped" json:"skipped"`
+	Failed        int32                 `bson:"failed" json:"failed"`
+	Failures      []CancellationFailure `bson:"failures,omitempty" json:"failures,omitempty"`
+	LeaseUntil    time.Time             `bson:"lease_until" json:"-"`
+	CreatedAt     time.Time             `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time             `bson:"updated_at" json:"updated_at"`
+	CompletedAt   time.Time             `bson:"completed_at,omitempty" json:"completed_at,omitempty"`
+}
+
+func NewCancellationJob(eventID, reason string) *CancellationJob {
+	now := time.Now()
+	return &CancellationJob{
+		EventID:       eventID,
+		Reason:        reason,
+		Status:        CancellationJobRunning,
+		Pass:          1,
+		PassStartedAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func (j *CancellationJob) RecordFailure(ticketID string, err error) {
+	j.Failed++
+	if len(j.Failures) < maxRecordedFailures {
+		j.Failures = append(j.Failures, CancellationFailure{TicketID: ticketID, Error: err.Error()})
+	}
+}
+
+// StartPass sets the job up to walk the event's active tickets again from the
+// first one, starting at at.
+func (j *CancellationJob) StartPass(at time.Time) {
+	j.Cursor = primitive.NilObjectID
+	j.Pass++
+	j.PassStartedAt = at
+	j.PassFound = false
+	j.Failed = 0
+	j.Failures = nil
+}
+
+// Finish marks the job done once no active tickets are left to walk.
+func (j *CancellationJob) Finish(now time.Time) {
+	j.Status = CancellationJobCompleted
+	if j.Failed > 0 {
+		j.Status = CancellationJobCompletedWithErrors
+	}
+	j.CompletedAt = now
+}
+
+func (j *CancellationJob) ToProto() *ticketpb.CancellationJob {
+	pb := &ticketpb.CancellationJob{
+		EventId:   j.EventID,
+		Reason:    j.Reason,
+		Status:    string(j.Status),
+		Refunded:  j.Refunded,
+		Cancelled: j.Cancelled,
+		Skipped:   j.Skipped,
+		Failed:    j.Failed,
+		CreatedAt: timestamppb.New(j.CreatedAt),
+		UpdatedAt: timestamppb.New(j.UpdatedAt),
+	}
+	for _, failure := range j.Failures {
+		pb.Failures = append(pb.Failures, &ticketpb.CancellationFailure{
+			TicketId: failure.TicketID,
+			Error:    failure.Error,
+		})
+	}
+	if !j.CompletedAt.IsZero() {
+		pb.CompletedAt = timestamppb.New(j.CompletedAt)
+	}
+	return pb
+}
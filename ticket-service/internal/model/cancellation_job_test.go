@@ -0,0 +1,80 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCancellationJob_Finish(t *testing.T) {
+	job := NewCancellationJob("event1", "storm warning")
+	if job.Status != CancellationJobRunning {
+		t.Fatalf("Status = %s, want %s", job.Status, CancellationJobRunning)
+	}
+
+	now := time.Now()
+	job.Finish(now)
+	if job.Status != CancellationJobCompleted {
+		t.Errorf("Status = %s, want %s", job.Status, CancellationJobCompleted)
+	}
+	if !job.CompletedAt.Equal(now) {
+		t.Errorf("CompletedAt = %v, want %v", job.CompletedAt, now)
+	}
+
+	failed := NewCancellationJob("event2", "")
+	failed.RecordFailure("ticket1", errors.New("provider unavailable"))
+	failed.Finish(now)
+	if failed.Status != CancellationJobCompletedWithErrors {
+		t.Errorf("Status = %s, want %s", failed.Status, CancellationJobCompletedWithErrors)
+	}
+}
+
+func TestCancellationJob_RecordFailure(t *testing.T) {
+	job := NewCancellationJob("event1", "")
+	for i := 0; i < maxRecordedFailures+5; i++ {
+		job.RecordFailure(fmt.Sprintf("ticket%d", i), errors.New("provider unavailable"))
+	}
+
+	if job.Failed != maxRecordedFailures+5 {
+		t.Errorf("Failed = %d, want %d", job.Failed, maxRecordedFailures+5)
+	}
+	if len(job.Failures) != maxRecordedFailures {
+		t.Errorf("kept %d failures, want %d", len(job.Failures), maxRecordedFailures)
+	}
+
+	pb := job.ToProto()
+	if pb.Failed != job.Failed || len(pb.Failures) != maxRecordedFailures {
+		t.Errorf("ToProto() = %d failed with %d failures", pb.Failed, len(pb.Failures))
+	}
+	if pb.Failures[0].TicketId != "ticket0" || pb.Failures[0].Error != "provider unavailable" {
+		t.Errorf("first failure = %v", pb.Failures[0])
+	}
+	if pb.CompletedAt != nil {
+		t.Error("CompletedAt set on a running job")
+	}
+}
+
+func TestCancellationJob_StartPass(t *testing.T) {
+	job := NewCancellationJob("event1", "")
+	job.Cursor = primitive.NewObjectID()
+	job.PassFound = true
+	job.Refunded = 3
+	job.RecordFailure("ticket1", errors.New("provider unavailable"))
+
+	next := time.Now().Add(time.Minute)
+	job.StartPass(next)
+
+	if !job.Cursor.IsZero() || job.Pass != 2 || !job.PassStartedAt.Equal(next) || job.PassFound {
+		t.Errorf("StartPass() left cursor %v, pass %d started at %v, found %v", job.Cursor, job.Pass, job.PassStartedAt, job.PassFound)
+	}
+	// The failed ticket is still active, so the next pass counts it again.
+	if job.Failed != 0 || len(job.Failures) != 0 {
+		t.Errorf("StartPass() kept %d failures", job.Failed)
+	}
+	if job.Refunded != 3 {
+		t.Errorf("Refunded = %d, want 3", job.Refunded)
+	}
+}
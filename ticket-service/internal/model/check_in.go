@@ -0,0 +1,137 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CheckInResult string
+
+const (
+	CheckInAccepted CheckInResult = "ACCEPTED"
+	CheckInRejected CheckInResult = "REJECTED"
+)
+
+// RejectReason says why a scan did not let its holder in.
+type RejectReason string
+
+const (
+	RejectInvalidCode       RejectReason = "INVALID_CODE"
+	RejectCodeReplaced      RejectReason = "CODE_REPLACED"
+	RejectExpired           RejectReason = "EXPIRED"
+	RejectWrongEvent        RejectReason = "WRONG_EVENT"
+	RejectNotConfirmed      RejectReason = "NOT_CONFIRMED"
+	RejectAlreadyUsed       RejectReason = "ALREADY_USED"
+	RejectEntryLimitReached RejectReason = "ENTRY_LIMIT_REACHED"
+	RejectCancelled         RejectReason = "CANCELLED"
+	RejectRefunded          RejectReason = "REFUNDED"
+)
+
+// CheckIn records one scan of a ticket code. Its ID is the scanner's scan ID,
+// so uploading the same scan again returns the recorded outcome. TokenHash
+// fingerprints the scanned code, so that a scan ID reused for another code
+// can be told apart from a genuine retry.
+type CheckIn struct {
+	ID        string        `bson:"_id" json:"scan_id"`
+	TokenHash string        `bson:"token_hash" json:"-"`
+	TicketID  string        `bson:"ticket_id,omitempty" json:"ticket_id,omitempty"`
+	EventID   string        `bson:"event_id,omitempty" json:"event_id,omitempty"`
+	GateID    string        `bson:"gate_id" json:"gate_id"`
+	Result    CheckInResult `bson:"result" json:"result"`
+	Reason    RejectReason  `bson:"reason,omitempty" json:"reason,omitempty"`
+	Message   string        `bson:"message,omitempty" json:"message,omitempty"`
+	Entry     int32         `bson:"entry,omitempty" json:"entry,omitempty"`
+	Offline   bool          `bson:"offline,omitempty" json:"offline,omitempty"`
+	ScannedAt time.Time     `bson:"scanned_at" json:"scanned_at"`
+	CreatedAt time.Time     `bson:"created_at" json:"created_at"`
+}
+
+// Accept marks the scan as letting its holder in for the entry-th time.
+func (c *CheckIn) Accept(entry int32) {
+	c.Result = CheckInAccepted
+	c.Entry = entry
+}
+
+// Reject marks the scan as turning its holder away.
+func (c *CheckIn) Reject(reason RejectReason, message string) {
+	c.Result = CheckInRejected
+	c.Reason = reason
+	c.Message = message
+}
+
+func (c *CheckIn) ToProto() *ticketpb.CheckIn {
+	return &ticketpb.CheckIn{
+		ScanId:    c.ID,
+		TicketId:  c.TicketID,
+		EventId:   c.EventID,
+		GateId:    c.GateID,
+		Result:    string(c.Result),
+		Reason:    string(c.Reason),
+		Message:   c.Message,
+		Entry:     c.Entry,
+		Offline:   c.Offline,
+		ScannedAt: timestamppb.New(c.ScannedAt),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
+}
+
+// EntryPolicy is how many times a ticket to an event lets its holder in: once
+// without re-entry, otherwise MaxEntries times, or without limit when
+// MaxEntries is 0.
+type EntryPolicy struct {
+	EventID      string    `bson:"_id" json:"event_id"`
+	AllowReentry bool      `bson:"allow_reentry" json:"allow_reentry"`
+	MaxEntries   int32     `bson:"max_entries,omitempty" json:"max_entries,omitempty"`
+	UpdatedAt    time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// DefaultEntryPolicy is the policy of events that have not set one: a single
+// entry per ticket.
+func DefaultEntryPolicy(eventID string) *EntryPolicy {
+	return &EntryPolicy{EventID: eventID}
+}
+
+func EntryPolicyFromProto(p *ticketpb.EntryPolicy) *EntryPolicy {
+	return &EntryPolicy{
+		EventID:      p.EventId,
+		AllowReentry: p.AllowReentry,
+		MaxEntries:   p.MaxEntries,
+	}
+}
+
+func (p *EntryPolicy) Validate() error {
+	if p.MaxEntries < 0 {
+		return errors.New("max entries cannot be negative")
+	}
+	if !p.AllowReentry && p.MaxEntries > 1 {
+		return errors.New("max entries above 1 needs re-entry to be allowed")
+	}
+	return nil
+}
+
+// Admits reports whether a ticket already let in entries times may let its
+// holder in again.
+func (p *EntryPolicy) Admits(entries int32) bool {
+	if entries == 0 {
+		return true
+	}
+	if !p.AllowReentry {
+		return false
+	}
+	return p.MaxEntries == 0 || entries < p.MaxEntries
+}
+
+func (p *EntryPolicy) ToProto() *ticketpb.EntryPolicy {
+	pb := &ticketpb.EntryPolicy{
+		EventId:      p.EventID,
+		AllowReentry: p.AllowReentry,
+		MaxEntries:   p.MaxEntries,
+	}
+	if !p.UpdatedAt.IsZero() {
+		pb.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+	return pb
+}
@@ -0,0 +1,47 @@
+package model
+
+import "testing"
+
+func TestEntryPolicy_Admits(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  EntryPolicy
+		entries int32
+		want    bool
+	}{
+		{"single entry, unused", EntryPolicy{}, 0, true},
+		{"single entry, used", EntryPolicy{}, 1, false},
+		{"unlimited re-entry", EntryPolicy{AllowReentry: true}, 10, true},
+		{"re-entry under limit", EntryPolicy{AllowReentry: true, MaxEntries: 3}, 2, true},
+		{"re-entry at limit", EntryPolicy{AllowReentry: true, MaxEntries: 3}, 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Admits(tt.entries); got != tt.want {
+				t.Errorf("Admits(%d) = %v, want %v", tt.entries, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntryPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  EntryPolicy
+		wantErr bool
+	}{
+		{"single entry", EntryPolicy{}, false},
+		{"limited re-entry", EntryPolicy{AllowReentry: true, MaxEntries: 2}, false},
+		{"negative limit", EntryPolicy{AllowReentry: true, MaxEntries: -1}, true},
+		{"limit without re-entry", EntryPolicy{MaxEntries: 2}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
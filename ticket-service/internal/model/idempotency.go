@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// IdempotencyRecord remembers the outcome of a request made with an
+// idempotency key. Keys are scoped to the user and remember the RPC they were
+// first sent to. Response is empty while the original request is still in
+// flight; that request holds the key under Token until LeaseUntil, and a retry
+// may take the key over once the lease has run out.
+type IdempotencyRecord struct {
+	UserID      string    `bson:"user_id"`
+	Key         string    `bson:"key"`
+	Method      string    `bson:"method"`
+	RequestHash string    `bson:"request_hash"`
+	Response    []byte    `bson:"response,omitempty"`
+	Token       string    `bson:"token,omitempty"`
+	LeaseUntil  time.Time `bson:"lease_until,omitempty"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
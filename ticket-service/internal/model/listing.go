@@ -0,0 +1,246 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ListingStatus string
+
+const (
+	ListingStatusActive    ListingStatus = "ACTIVE"
+	ListingStatusSold      ListingStatus = "SOLD"
+	ListingStatusWithdrawn ListingStatus = "WITHDRAWN"
+	ListingStatusDelisted  ListingStatus = "DELISTED"
+)
+
+// Valid reports whether s is one of the known listing statuses.
+func (s ListingStatus) Valid() bool {
+	switch s {
+	case ListingStatusActive, ListingStatusSold, ListingStatusWithdrawn, ListingStatusDelisted:
+		return true
+	}
+	return false
+}
+
+// Reasons a listing is taken off sale without its seller asking.
+const (
+	DelistTicketUsed        = "ticket was checked in"
+	DelistTicketCancelled   = "ticket was cancelled"
+	DelistTicketRefunded    = "ticket was refunded"
+	DelistTicketTransferred = "ticket was transferred"
+	DelistEventCancelled    = "event was cancelled"
+)
+
+// Listing offers a CONFIRMED ticket for resale at Price. FaceValue is what the
+// ticket originally sold for. While a buyer pays, the listing stays ACTIVE
+// but is held for their OrderID until HeldUntil, so nobody else can buy it.
+type Listing struct {
+	ID           primitive.ObjectID `bson:"_id" json:"id"`
+	TicketID     string             `bson:"ticket_id" json:"ticket_id"`
+	EventID      string             `bson:"event_id" json:"event_id"`
+	TicketTypeID string             `bson:"ticket_type_id,omitempty" json:"ticket_type_id,omitempty"`
+	SeatIDs      []string           `bson:"seat_ids,omitempty" json:"seat_ids,omitempty"`
+	SellerID     string             `bson:"seller_id" json:"seller_id"`
+	Price        int64              `bson:"price" json:"price"`
+	Currency     string             `bson:"currency,omitempty" json:"currency,omitempty"`
+	FaceValue    int64              `bson:"face_value" json:"face_value"`
+	Status       ListingStatus      `bson:"status" json:"status"`
+	Reason       string             `bson:"reason,omitempty" json:"reason,omitempty"`
+	BuyerID      string             `bson:"buyer_id,omitempty" json:"buyer_id,omitempty"`
+	OrderID      string             `bson:"order_id,omitempty" json:"order_id,omitempty"`
+	HeldUntil    time.Time          `bson:"held_until,omitempty" json:"-"`
+	SellerPayout int64              `bson:"seller_payout,omitempty" json:"seller_payout,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at" json:"updated_at"`
+	SoldAt       time.Time          `bson:"sold_at,omitempty" json:"sold_at,omitempty"`
+}
+
+func NewListing(ticket *Ticket, price int64) *Listing {
+	now := time.Now()
+	return &Listing{
+		ID:           primitive.NewObjectID(),
+		TicketID:     ticket.ID.Hex(),
+		EventID:      ticket.EventID,
+		TicketTypeID: ticket.TicketTypeID,
+		SeatIDs:      ticket.SeatIDs,
+		SellerID:     ticket.UserID,
+		Price:        price,
+		Currency:     ticket.Currency,
+		FaceValue:    ticket.UnitPrice,
+		Status:       ListingStatusActive,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+// Order returns the PENDING order through which buyerID pays for the
+// listing: a single item, the listed ticket, at the listing's price.
+func (l *Listing) Order(buyerID string) (*Order, error) {
+	ticketID, err := primitive.ObjectIDFromHex(l.TicketID)
+	if err != nil {
+		return nil, errors.New("listing has an invalid ticket ID")
+	}
+
+	breakdown := &PriceBreakdown{
+		Currency: l.Currency,
+		Base:     l.Price,
+		Total:    l.Price,
+	}
+	now := time.Now()
+	order := &Order{
+		ID:     primitive.NewObjectID(),
+		UserID: buyerID,
+		Status: OrderStatusPending,
+		Items: []OrderItem{{
+			EventID:      l.EventID,
+			TicketTypeID: l.TicketTypeID,
+			SeatIDs:      l.SeatIDs,
+			Quantity:     1,
+			UnitPrice:    l.Price,
+			Currency:     l.Currency,
+			Breakdown:    breakdown,
+			TicketIDs:    []primitive.ObjectID{ticketID},
+		}},
+		ListingID: l.ID.Hex(),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	order.SetBreakdowns([]*PriceBreakdown{breakdown})
+	return order, nil
+}
+
+// Record is the entry the sale leaves in its ticket's history once it is
+// sold to buyerID at time at.
+func (l *Listing) Record(buyerID string, at time.Time) TransferRecord {
+	return TransferRecord{
+		ListingID:     l.ID.Hex(),
+		FromUserID:    l.SellerID,
+		ToUserID:      buyerID,
+		TransferredAt: at,
+	}
+}
+
+func (l *Listing) ToProto() *ticketpb.Listing {
+	pb := &ticketpb.Listing{
+		Id:           l.ID.Hex(),
+		TicketId:     l.TicketID,
+		EventId:      l.EventID,
+		TicketTypeId: l.TicketTypeID,
+		SeatIds:      l.SeatIDs,
+		SellerId:     l.SellerID,
+		Price:        l.Price,
+		Currency:     l.Currency,
+		FaceValue:    l.FaceValue,
+		Status:       string(l.Status),
+		Reason:       l.Reason,
+		SellerPayout: l.SellerPayout,
+		CreatedAt:    timestamppb.New(l.CreatedAt),
+		UpdatedAt:    timestamppb.New(l.UpdatedAt),
+	}
+	// Who is paying for a listing is nobody else's business until it sells.
+	if l.Status == ListingStatusSold {
+		pb.BuyerId = l.BuyerID
+		pb.OrderId = l.OrderID
+		pb.SoldAt = timestamppb.New(l.SoldAt)
+	}
+	return pb
+}
+
+// ResalePolicy is an organizer's rule for reselling tickets to an event:
+// listings may ask at most MaxMarkupBps basis points over face value, and
+// SellerFeeBps of the price is kept from the seller's payout.
+type ResalePolicy struct {
+	EventID      string    `bson:"_id" json:"event_id"`
+	MaxMarkupBps int32     `bson:"max_markup_bps" json:"max_markup_bps"`
+	SellerFeeBps int32     `bson:"seller_fee_bps" json:"seller_fee_bps"`
+	UpdatedAt    time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// DefaultResalePolicy applies to events without a resale policy: tickets may
+// be resold at up to face value, with no fee.
+func DefaultResalePolicy(eventID string) *ResalePolicy {
+	return &ResalePolicy{EventID: eventID}
+}
+
+func ResalePolicyFromProto(p *ticketpb.ResalePolicy) *ResalePolicy {
+	return &ResalePolicy{
+		EventID:      p.EventId,
+		MaxMarkupBps: p.MaxMarkupBps,
+		SellerFeeBps: p.SellerFeeBps,
+	}
+}
+
+func (p *ResalePolicy) Validate() error {
+	if p.MaxMarkupBps < 0 {
+		return errors.New("max markup cannot be negative")
+	}
+	if p.SellerFeeBps < 0 || p.SellerFeeBps > 10000 {
+		return errors.New("seller fee must be between 0 and 10000 basis points")
+	}
+	return nil
+}
+
+// PriceCap is the most a ticket with face value faceValue may be listed for.
+func (p *ResalePolicy) PriceCap(faceValue int64) int64 {
+	return faceValue * (10000 + int64(p.MaxMarkupBps)) / 10000
+}
+
+// SellerFee is the fee kept from a sale at price, rounded half up.
+func (p *ResalePolicy) SellerFee(price int64) int64 {
+	return (price*int64(p.SellerFeeBps) + 5000) / 10000
+}
+
+func (p *ResalePolicy) ToProto() *ticketpb.ResalePolicy {
+	pb := &ticketpb.ResalePolicy{
+		EventId:      p.EventID,
+		MaxMarkupBps: p.MaxMarkupBps,
+		SellerFeeBps: p.SellerFeeBps,
+	}
+	if !p.UpdatedAt.IsZero() {
+		pb.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+	return pb
+}
+
+type PayoutStatus string
+
+const (
+	PayoutStatusPending PayoutStatus = "PENDING"
+)
+
+// Payout is what a seller is owed for a sold listing: the price paid less
+// Fee. Payouts are recorded PENDING for the finance process that settles
+// them.
+type Payout struct {
+	ID        primitive.ObjectID `bson:"_id" json:"id"`
+	ListingID string             `bson:"listing_id" json:"listing_id"`
+	OrderID   string             `bson:"order_id" json:"order_id"`
+	SellerID  string             `bson:"seller_id" json:"seller_id"`
+	Amount    int64              `bson:"amount" json:"amount"`
+	Fee       int64              `bson:"fee" json:"fee"`
+	Currency  string             `bson:"currency,omitempty" json:"currency,omitempty"`
+	Status    PayoutStatus       `bson:"status" json:"status"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// NewPayout records what the seller of listing is owed for its sale through
+// orderID, under policy.
+func NewPayout(listing *Listing, orderID string, policy *ResalePolicy) *Payout {
+	fee := policy.SellerFee(listing.Price)
+	return &Payout{
+		ID:        primitive.NewObjectID(),
+		ListingID: listing.ID.Hex(),
+		OrderID:   orderID,
+		SellerID:  listing.SellerID,
+		Amount:    listing.Price - fee,
+		Fee:       fee,
+		Currency:  listing.Currency,
+		Status:    PayoutStatusPending,
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestResalePolicy_PriceCap(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    ResalePolicy
+		faceValue int64
+		want      int64
+	}{
+		{"default is face value", ResalePolicy{}, 5000, 5000},
+		{"ten percent markup", ResalePolicy{MaxMarkupBps: 1000}, 5000, 5500},
+		{"rounds down", ResalePolicy{MaxMarkupBps: 1000}, 999, 1098},
+		{"double face value", ResalePolicy{MaxMarkupBps: 10000}, 5000, 10000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.PriceCap(tt.faceValue); got != tt.want {
+				t.Errorf("PriceCap(%d) = %d, want %d", tt.faceValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPayout(t *testing.T) {
+	listing := &Listing{ID: primitive.NewObjectID(), SellerID: "alice", Price: 5005, Currency: "USD"}
+
+	payout := NewPayout(listing, "order1", &ResalePolicy{SellerFeeBps: 500})
+	if payout.Fee != 250 || payout.Amount != 4755 {
+		t.Errorf("NewPayout() fee = %d, amount = %d, want 250 and 4755", payout.Fee, payout.Amount)
+	}
+	if payout.SellerID != "alice" || payout.Status != PayoutStatusPending {
+		t.Errorf("NewPayout() = %+v", payout)
+	}
+}
+
+func TestResalePolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  ResalePolicy
+		wantErr bool
+	}{
+		{"default", ResalePolicy{}, false},
+		{"markup and fee", ResalePolicy{MaxMarkupBps: 1000, SellerFeeBps: 500}, false},
+		{"negative markup", ResalePolicy{MaxMarkupBps: -1}, true},
+		{"fee over 100 percent", ResalePolicy{SellerFeeBps: 10001}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestListing_Order(t *testing.T) {
+	ticketID := primitive.NewObjectID()
+	listing := &Listing{
+		ID:       primitive.NewObjectID(),
+		TicketID: ticketID.Hex(),
+		EventID:  "event1",
+		SellerID: "alice",
+		Price:    5500,
+		Currency: "USD",
+	}
+
+	order, err := listing.Order("bob")
+	if err != nil {
+		t.Fatalf("Order() error = %v", err)
+	}
+	if order.UserID != "bob" || order.ListingID != listing.ID.Hex() || order.Status != OrderStatusPending {
+		t.Errorf("Order() = %+v", order)
+	}
+	if order.TotalPrice != 5500 || order.Currency != "USD" {
+		t.Errorf("Order() total = %d %s, want 5500 USD", order.TotalPrice, order.Currency)
+	}
+	if len(order.Items) != 1 || len(order.Items[0].TicketIDs) != 1 || order.Items[0].TicketIDs[0] != ticketID {
+		t.Errorf("Order() items = %+v, want the listed ticket", order.Items)
+	}
+}
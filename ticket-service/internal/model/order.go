@@ -0,0 +1,204 @@
+package model
+
+import (
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "PENDING"
+	OrderStatusConfirmed OrderStatus = "CONFIRMED"
+	OrderStatusFailed    OrderStatus = "FAILED"
+)
+
+// Valid reports whether s is one of the known order statuses.
+func (s OrderStatus) Valid() bool {
+	switch s {
+	case OrderStatusPending, OrderStatusConfirmed, OrderStatusFailed:
+		return true
+	}
+	return false
+}
+
+// OrderItem is quantity tickets of one tier of an event. TicketIDs holds the
+// ID of each ticket, which is also the ID of its stock reservation.
+type OrderItem struct {
+	EventID      string               `bson:"event_id" json:"event_id"`
+	TicketTypeID string               `bson:"ticket_type_id,omitempty" json:"ticket_type_id,omitempty"`
+	SeatIDs      []string             `bson:"seat_ids,omitempty" json:"seat_ids,omitempty"`
+	AccessCode   string               `bson:"access_code,omitempty" json:"-"`
+	Quantity     int32                `bson:"quantity" json:"quantity"`
+	UnitPrice    int64                `bson:"unit_price" json:"unit_price"`
+	Currency     string               `bson:"currency,omitempty" json:"currency,omitempty"`
+	Discount     int64                `bson:"discount,omitempty" json:"discount,omitempty"`
+	Breakdown    *PriceBreakdown      `bson:"breakdown,omitempty" json:"breakdown,omitempty"`
+	TicketIDs    []primitive.ObjectID `bson:"ticket_ids" json:"ticket_ids"`
+}
+
+// SetPrice records the per-ticket price, in minor units of currency.
+func (i *OrderItem) SetPrice(unitPrice int64, currency string) {
+	i.UnitPrice = unitPrice
+	i.Currency = currency
+}
+
+func (i *OrderItem) ToProto() *ticketpb.OrderItem {
+	ticketIDs := make([]string, 0, len(i.TicketIDs))
+	for _, id := range i.TicketIDs {
+		ticketIDs = append(ticketIDs, id.Hex())
+	}
+	return &ticketpb.OrderItem{
+		EventId:      i.EventID,
+		TicketTypeId: i.TicketTypeID,
+		Quantity:     i.Quantity,
+		SeatIds:      i.SeatIDs,
+		UnitPrice:    i.UnitPrice,
+		Currency:     i.Currency,
+		Discount:     i.Discount,
+		Breakdown:    i.Breakdown.ToProto(),
+		TicketIds:    ticketIDs,
+	}
+}
+
+// Order is a purchase of the tickets of one or more items, paid with a single
+// payment. Its breakdown is the sum of its items' breakdowns. Orders that buy
+// a resale listing carry its ListingID and pay for the ticket already issued.
+type Order struct {
+	ID               primitive.ObjectID `bson:"_id" json:"id"`
+	UserID           string             `bson:"user_id" json:"user_id"`
+	Status           OrderStatus        `bson:"status" json:"status"`
+	Items            []OrderItem        `bson:"items" json:"items"`
+	PromoCodes       []string           `bson:"promo_codes,omitempty" json:"promo_codes,omitempty"`
+	Currency         string             `bson:"currency,omitempty" json:"currency,omitempty"`
+	Breakdown        *PriceBreakdown    `bson:"breakdown,omitempty" json:"breakdown,omitempty"`
+	TotalPrice       int64              `bson:"total_price" json:"total_price"`
+	PaymentReference string             `bson:"payment_reference,omitempty" json:"payment_reference,omitempty"`
+	FailureReason    string             `bson:"failure_reason,omitempty" json:"failure_reason,omitempty"`
+	ListingID        string             `bson:"listing_id,omitempty" json:"listing_id,omitempty"`
+	CreatedAt        time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt        time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+func NewOrder(userID string, items []OrderItem, promoCodes []string) *Order {
+	now := time.Now()
+	order := &Order{
+		ID:         primitive.NewObjectID(),
+		UserID:     userID,
+		Status:     OrderStatusPending,
+		Items:      items,
+		PromoCodes: promoCodes,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	for i := range order.Items {
+		item := &order.Items[i]
+		item.TicketIDs = make([]primitive.ObjectID, item.Quantity)
+		for j := range item.TicketIDs {
+			item.TicketIDs[j] = primitive.NewObjectID()
+		}
+	}
+	return order
+}
+
+// Quantity is the number of tickets in the order.
+func (o *Order) Quantity() int32 {
+	var quantity int32
+	for _, item := range o.Items {
+		quantity += item.Quantity
+	}
+	return quantity
+}
+
+// EventQuantities sums the quantity ordered of each event.
+func (o *Order) EventQuantities() map[string]int32 {
+	quantities := make(map[string]int32)
+	for _, item := range o.Items {
+		quantities[item.EventID] += item.Quantity
+	}
+	return quantities
+}
+
+// Tickets returns one RESERVED ticket per admitted person, held until
+// holdUntil. Each gets its item's next seat, if it has seats, and an equal
+// share of its item's price.
+func (o *Order) Tickets(holdUntil time.Time) []Ticket {
+	now := time.Now()
+	tickets := make([]Ticket, 0, o.Quantity())
+	for _, item := range o.Items {
+		shares := item.Breakdown.Split(len(item.TicketIDs))
+		for j, id := range item.TicketIDs {
+			ticket := Ticket{
+				ID:            id,
+				OrderID:       o.ID.Hex(),
+				EventID:       item.EventID,
+				TicketTypeID:  item.TicketTypeID,
+				UserID:        o.UserID,
+				Status:        TicketStatusReserved,
+				ReservationID: id.Hex(),
+				PromoCodes:    o.PromoCodes,
+				ExpiresAt:     holdUntil,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			}
+			if len(item.SeatIDs) > 0 {
+				ticket.SeatIDs = []string{item.SeatIDs[j]}
+			}
+			ticket.SetPrice(item.UnitPrice, item.Currency)
+			if shares != nil {
+				ticket.Discount = shares[j].Discount
+				ticket.SetBreakdown(&shares[j])
+			}
+			tickets = append(tickets, ticket)
+		}
+	}
+	return tickets
+}
+
+// SetBreakdowns records the breakdown of each item, in item order, and totals
+// them up for the order. The breakdowns must all be in one currency.
+func (o *Order) SetBreakdowns(breakdowns []*PriceBreakdown) {
+	var total *PriceBreakdown
+	for i, breakdown := range breakdowns {
+		if total == nil {
+			first := *breakdown
+			total = &first
+		} else {
+			total.Add(breakdown)
+		}
+		o.Items[i].Discount = breakdown.Discount
+		o.Items[i].Breakdown = breakdown
+	}
+
+	if total == nil {
+		return
+	}
+	o.Breakdown = total
+	o.Currency = total.Currency
+	o.TotalPrice = total.Total
+}
+
+func (o *Order) ToProto() *ticketpb.Order {
+	items := make([]*ticketpb.OrderItem, 0, len(o.Items))
+	for i := range o.Items {
+		items = append(items, o.Items[i].ToProto())
+	}
+	return &ticketpb.Order{
+		Id:               o.ID.Hex(),
+		UserId:           o.UserID,
+		Status:           string(o.Status),
+		Items:            items,
+		PromoCodes:       o.PromoCodes,
+		Currency:         o.Currency,
+		Breakdown:        o.Breakdown.ToProto(),
+		TotalPrice:       o.TotalPrice,
+		PaymentReference: o.PaymentReference,
+		FailureReason:    o.FailureReason,
+		ListingId:        o.ListingID,
+		CreatedAt:        timestamppb.New(o.CreatedAt),
+		UpdatedAt:        timestamppb.New(o.UpdatedAt),
+	}
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrder_Tickets(t *testing.T) {
+	order := NewOrder("user1", []OrderItem{
+		{EventID: "event1", TicketTypeID: "vip", Quantity: 3},
+		{EventID: "event2", SeatIDs: []string{"A1", "A2"}, Quantity: 2},
+	}, []string{"SAVE10"})
+	order.Items[0].SetPrice(5000, "USD")
+	order.Items[1].SetPrice(2000, "USD")
+	order.SetBreakdowns([]*PriceBreakdown{
+		{Currency: "USD", Base: 15000, Discount: 1000, ServiceFee: 701, Tax: 1, Total: 14702, TaxJurisdiction: "US-CA", TaxRateBps: 725},
+		{Currency: "USD", Base: 4000, FacilityFee: 300, Total: 4300},
+	})
+
+	if order.TotalPrice != 19002 || order.Breakdown.Base != 19000 {
+		t.Errorf("order total = %d on a base of %d, want 19002 on 19000", order.TotalPrice, order.Breakdown.Base)
+	}
+	if order.Breakdown.TaxJurisdiction != "" {
+		t.Errorf("order tax jurisdiction = %q, want none for items taxed differently", order.Breakdown.TaxJurisdiction)
+	}
+
+	holdUntil := time.Now().Add(time.Minute)
+	tickets := order.Tickets(holdUntil)
+	if len(tickets) != 5 {
+		t.Fatalf("Tickets() returned %d tickets, want 5", len(tickets))
+	}
+
+	seen := make(map[string]bool)
+	var total int64
+	for _, ticket := range tickets {
+		if ticket.OrderID != order.ID.Hex() || ticket.ReservationID != ticket.ID.Hex() {
+			t.Errorf("ticket %s = %+v, want a ticket of order %s reserved under its own ID", ticket.ID.Hex(), ticket, order.ID.Hex())
+		}
+		if seen[ticket.ID.Hex()] {
+			t.Errorf("ticket ID %s is used twice", ticket.ID.Hex())
+		}
+		seen[ticket.ID.Hex()] = true
+		if !ticket.ExpiresAt.Equal(holdUntil) {
+			t.Errorf("ticket held until %v, want %v", ticket.ExpiresAt, holdUntil)
+		}
+		total += ticket.TotalPrice
+	}
+	if total != order.TotalPrice {
+		t.Errorf("tickets add up to %d, want the order's %d", total, order.TotalPrice)
+	}
+
+	// The first item's discount is split across its three tickets and stays
+	// off a ticket that is priced again.
+	if tickets[0].Discount != 334 || tickets[1].Discount != 333 || tickets[2].Discount != 333 {
+		t.Errorf("discounts = %d, %d and %d, want 334, 333 and 333", tickets[0].Discount, tickets[1].Discount, tickets[2].Discount)
+	}
+	tickets[0].SetPrice(6000, "USD")
+	if tickets[0].TotalPrice != 5666 {
+		t.Errorf("TotalPrice after a new price = %d, want 5666", tickets[0].TotalPrice)
+	}
+	if tickets[3].Discount != 0 || tickets[3].TotalPrice != 2150 {
+		t.Errorf("undiscounted ticket = %d off for %d, want 0 off for 2150", tickets[3].Discount, tickets[3].TotalPrice)
+	}
+
+	if tickets[3].SeatIDs[0] != "A1" || tickets[4].SeatIDs[0] != "A2" {
+		t.Errorf("seats = %v and %v, want A1 and A2", tickets[3].SeatIDs, tickets[4].SeatIDs)
+	}
+}
+
+func TestPriceBreakdown_Split(t *testing.T) {
+	breakdown := &PriceBreakdown{Currency: "USD", Base: 10000, Discount: 1000, ServiceFee: 701, Tax: 2, Total: 9703}
+
+	shares := breakdown.Split(3)
+	var sum PriceBreakdown
+	for _, share := range shares {
+		if share.Total != share.Base-share.Discount+share.ServiceFee+share.FacilityFee+share.Tax {
+			t.Errorf("share total %d does not add up", share.Total)
+		}
+		sum.Add(&share)
+	}
+
+	if sum.Base != breakdown.Base || sum.Discount != breakdown.Discount || sum.ServiceFee != breakdown.ServiceFee ||
+		sum.Tax != breakdown.Tax || sum.Total != breakdown.Total {
+		t.Errorf("shares add up to %+v, want %+v", sum, *breakdown)
+	}
+
+	if shares[0].Discount != 334 || shares[1].Discount != 333 || shares[2].Discount != 333 {
+		t.Errorf("discount shares = %d, %d and %d, want the remainder on the first", shares[0].Discount, shares[1].Discount, shares[2].Discount)
+	}
+
+	if (*PriceBreakdown)(nil).Split(3) != nil {
+		t.Error("Split() of a nil breakdown is not nil")
+	}
+}
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type OutboxEventType string
+
+const (
+	EventTicketPurchased OutboxEventType = "TicketPurchased"
+	EventTicketCancelled OutboxEventType = "TicketCancelled"
+	EventTicketRefunded  OutboxEventType = "TicketRefunded"
+
+	EventTicketTransferInitiated OutboxEventType = "TicketTransferInitiated"
+	EventTicketTransferAccepted  OutboxEventType = "TicketTransferAccepted"
+	EventTicketTransferCancelled OutboxEventType = "TicketTransferCancelled"
+
+	EventTicketResold OutboxEventType = "TicketResold"
+
+	EventWaitlistOffered      OutboxEventType = "WaitlistOffered"
+	EventWaitlistOfferExpired OutboxEventType = "WaitlistOfferExpired"
+)
+
+// TicketEventPayload is the state of a ticket at the moment a domain event
+// was recorded.
+type TicketEventPayload struct {
+	TicketID string       `bson:"ticket_id" json:"ticket_id"`
+	EventID  string       `bson:"event_id" json:"event_id"`
+	UserID   string       `bson:"user_id" json:"user_id"`
+	Quantity int32        `bson:"quantity" json:"quantity"`
+	Status   TicketStatus `bson:"status" json:"status"`
+	Reason   string       `bson:"reason,omitempty" json:"reason,omitempty"`
+	// Set on transfer and resale events.
+	TransferID string `bson:"transfer_id,omitempty" json:"transfer_id,omitempty"`
+	ListingID  string `bson:"listing_id,omitempty" json:"listing_id,omitempty"`
+	FromUserID string `bson:"from_user_id,omitempty" json:"from_user_id,omitempty"`
+	ToUserID   string `bson:"to_user_id,omitempty" json:"to_user_id,omitempty"`
+	// Set on waitlist events.
+	WaitlistEntryID string    `bson:"waitlist_entry_id,omitempty" json:"waitlist_entry_id,omitempty"`
+	OfferExpiresAt  time.Time `bson:"offer_expires_at,omitempty" json:"offer_expires_at,omitempty"`
+	// Set on purchase events.
+	OrderID string `bson:"order_id,omitempty" json:"order_id,omitempty"`
+}
+
+// OutboxEvent is a domain event written in the same transaction as the state
+// change it describes and published afterwards by the outbox relay.
+type OutboxEvent struct {
+	ID            primitive.ObjectID `bson:"_id" json:"id"`
+	Type          OutboxEventType    `bson:"type" json:"type"`
+	AggregateID   string             `bson:"aggregate_id" json:"aggregate_id"`
+	Payload       TicketEventPayload `bson:"payload" json:"payload"`
+	Attempts      int32              `bson:"attempts" json:"attempts"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at" json:"next_attempt_at"`
+	LastError     string             `bson:"last_error,omitempty" json:"last_error,omitempty"`
+	PublishedAt   time.Time          `bson:"published_at,omitempty" json:"published_at,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
+	TraceContext  map[string]string  `bson:"trace_context,omitempty" json:"trace_context,omitempty"`
+}
+
+func NewTicketEvent(eventType OutboxEventType, ticket *Ticket, reason string) *OutboxEvent {
+	now := time.Now()
+	return &OutboxEvent{
+		ID:          primitive.NewObjectID(),
+		Type:        eventType,
+		AggregateID: ticket.ID.Hex(),
+		Payload: TicketEventPayload{
+			TicketID: ticket.ID.Hex(),
+			EventID:  ticket.EventID,
+			UserID:   ticket.UserID,
+			Quantity: 1,
+			Status:   ticket.Status,
+			Reason:   reason,
+		},
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+// NewTransferEvent records a change to transfer of ticket, for notifying
+// both its sender and recipient.
+func NewTransferEvent(eventType OutboxEventType, ticket *Ticket, transfer *Transfer) *OutboxEvent {
+	event := NewTicketEvent(eventType, ticket, "")
+	event.Payload.TransferID = transfer.ID.Hex()
+	event.Payload.FromUserID = transfer.FromUserID
+	event.Payload.ToUserID = transfer.ToUserID
+	return event
+}
+
+// NewResaleEvent records the sale of ticket through listing, for notifying
+// both its seller and buyer.
+func NewResaleEvent(ticket *Ticket, listing *Listing) *OutboxEvent {
+	event := NewTicketEvent(EventTicketResold, ticket, "")
+	event.Payload.ListingID = listing.ID.Hex()
+	event.Payload.FromUserID = listing.SellerID
+	event.Payload.ToUserID = listing.BuyerID
+	return event
+}
+
+// NewWaitlistEvent records an offer made to, or lost by, the user holding a
+// waitlist entry. It is not about any one ticket, so TicketID is left empty.
+func NewWaitlistEvent(eventType OutboxEventType, entry *WaitlistEntry) *OutboxEvent {
+	now := time.Now()
+	return &OutboxEvent{
+		ID:          primitive.NewObjectID(),
+		Type:        eventType,
+		AggregateID: entry.ID.Hex(),
+		Payload: TicketEventPayload{
+			EventID:         entry.EventID,
+			UserID:          entry.UserID,
+			Quantity:        entry.Quantity,
+			WaitlistEntryID: entry.ID.Hex(),
+			OfferExpiresAt:  entry.OfferExpiresAt,
+		},
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
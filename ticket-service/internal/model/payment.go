@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending    PaymentStatus = "PENDING"
+	PaymentStatusAuthorized PaymentStatus = "AUTHORIZED"
+	PaymentStatusCaptured   PaymentStatus = "CAPTURED"
+	PaymentStatusVoided     PaymentStatus = "VOIDED"
+	PaymentStatusRefunded   PaymentStatus = "REFUNDED"
+	PaymentStatusDeclined   PaymentStatus = "DECLINED"
+)
+
+// Payment is the money side of an order. There is at most one per order.
+// A payment is PENDING from before it is sent to the provider until the
+// provider answers, and keeps its PaymentMethod so that an answer that never
+// arrived can be asked for again. Tickets of the order can be refunded one at
+// a time; RefundedTickets lists those already refunded so a repeated refund
+// is not paid out twice.
+type Payment struct {
+	ID              primitive.ObjectID `bson:"_id" json:"id"`
+	OrderID         string             `bson:"order_id" json:"order_id"`
+	UserID          string             `bson:"user_id" json:"user_id"`
+	Provider        string             `bson:"provider" json:"provider"`
+	AuthorizationID string             `bson:"authorization_id,omitempty" json:"authorization_id,omitempty"`
+	PaymentMethod   string             `bson:"payment_method,omitempty" json:"-"`
+	Amount          int64              `bson:"amount" json:"amount"`
+	Currency        string             `bson:"currency,omitempty" json:"currency,omitempty"`
+	Status          PaymentStatus      `bson:"status" json:"status"`
+	RefundedAmount  int64              `bson:"refunded_amount,omitempty" json:"refunded_amount,omitempty"`
+	RefundedTickets []string           `bson:"refunded_tickets,omitempty" json:"refunded_tickets,omitempty"`
+	FailureReason   string             `bson:"failure_reason,omitempty" json:"failure_reason,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+func NewPayment(order *Order, provider, paymentMethod string) *Payment {
+	now := time.Now()
+	return &Payment{
+		ID:            primitive.NewObjectID(),
+		OrderID:       order.ID.Hex(),
+		UserID:        order.UserID,
+		Provider:      provider,
+		PaymentMethod: paymentMethod,
+		Amount:        order.TotalPrice,
+		Currency:      order.Currency,
+		Status:        PaymentStatusPending,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// Refunded reports whether ticketID has already been refunded.
+func (p *Payment) Refunded(ticketID string) bool {
+	for _, id := range p.RefundedTickets {
+		if id == ticketID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package model
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ValidCurrency reports whether currency looks like an ISO 4217 code.
+func ValidCurrency(currency string) bool {
+	return currencyPattern.MatchString(currency)
+}
+
+// FeeSchedule is what an event charges on top of its ticket prices: a service
+// fee in basis points of the discounted price plus fixed service and facility
+// fees per ticket, in minor units of Currency. Tax is charged at the rate of
+// Jurisdiction, if one is set.
+type FeeSchedule struct {
+	EventID              string    `bson:"_id" json:"event_id"`
+	Currency             string    `bson:"currency,omitempty" json:"currency,omitempty"`
+	ServiceFeeBps        int32     `bson:"service_fee_bps" json:"service_fee_bps"`
+	ServiceFeePerTicket  int64     `bson:"service_fee_per_ticket" json:"service_fee_per_ticket"`
+	FacilityFeePerTicket int64     `bson:"facility_fee_per_ticket" json:"facility_fee_per_ticket"`
+	Jurisdiction         string    `bson:"jurisdiction,omitempty" json:"jurisdiction,omitempty"`
+	UpdatedAt            time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+func FeeScheduleFromProto(p *ticketpb.FeeSchedule) *FeeSchedule {
+	return &FeeSchedule{
+		EventID:              p.EventId,
+		Currency:             strings.ToUpper(p.Currency),
+		ServiceFeeBps:        p.ServiceFeeBps,
+		ServiceFeePerTicket:  p.ServiceFeePerTicket,
+		FacilityFeePerTicket: p.FacilityFeePerTicket,
+		Jurisdiction:         strings.ToUpper(p.Jurisdiction),
+	}
+}
+
+func (s *FeeSchedule) Validate() error {
+	if s.ServiceFeeBps < 0 || s.ServiceFeeBps > 10000 {
+		return errors.New("service fee must be between 0 and 10000 basis points")
+	}
+	if s.ServiceFeePerTicket < 0 || s.FacilityFeePerTicket < 0 {
+		return errors.New("fees cannot be negative")
+	}
+	if (s.ServiceFeePerTicket > 0 || s.FacilityFeePerTicket > 0) && !ValidCurrency(s.Currency) {
+		return errors.New("fixed fees need a 3-letter ISO currency code")
+	}
+	if s.Currency != "" && !ValidCurrency(s.Currency) {
+		return errors.New("currency must be a 3-letter ISO currency code")
+	}
+	return nil
+}
+
+func (s *FeeSchedule) ToProto() *ticketpb.FeeSchedule {
+	return &ticketpb.FeeSchedule{
+		EventId:              s.EventID,
+		Currency:             s.Currency,
+		ServiceFeeBps:        s.ServiceFeeBps,
+		ServiceFeePerTicket:  s.ServiceFeePerTicket,
+		FacilityFeePerTicket: s.FacilityFeePerTicket,
+		Jurisdiction:         s.Jurisdiction,
+		UpdatedAt:            timestamppb.New(s.UpdatedAt),
+	}
+}
+
+// TaxRate is the sales tax of a jurisdiction in basis points.
+type TaxRate struct {
+	Jurisdiction string    `bson:"_id" json:"jurisdiction"`
+	Name         string    `bson:"name,omitempty" json:"name,omitempty"`
+	RateBps      int32     `bson:"rate_bps" json:"rate_bps"`
+	UpdatedAt    time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+func TaxRateFromProto(p *ticketpb.TaxRate) *TaxRate {
+	return &TaxRate{
+		Jurisdiction: strings.ToUpper(p.Jurisdiction),
+		Name:         p.Name,
+		RateBps:      p.RateBps,
+	}
+}
+
+func (r *TaxRate) Validate() error {
+	if r.Jurisdiction == "" {
+		return errors.New("jurisdiction is required")
+	}
+	if r.RateBps < 0 || r.RateBps > 10000 {
+		return errors.New("rate must be between 0 and 10000 basis points")
+	}
+	return nil
+}
+
+func (r *TaxRate) ToProto() *ticketpb.TaxRate {
+	return &ticketpb.TaxRate{
+		Jurisdiction: r.Jurisdiction,
+		Name:         r.Name,
+		RateBps:      r.RateBps,
+		UpdatedAt:    timestamppb.New(r.UpdatedAt),
+	}
+}
+
+// PriceBreakdown is the price of an order split into its parts, in minor
+// units of Currency. It is stored with the order as charged, so a receipt
+// shows exactly what the buyer paid even if fees or taxes change later.
+type PriceBreakdown struct {
+	Currency        string `bson:"currency,omitempty" json:"currency,omitempty"`
+	Base            int64  `bson:"base" json:"base"`
+	Discount        int64  `bson:"discount" json:"discount"`
+	ServiceFee      int64  `bson:"service_fee" json:"service_fee"`
+	FacilityFee     int64  `bson:"facility_fee" json:"facility_fee"`
+	Tax             int64  `bson:"tax" json:"tax"`
+	Total           int64  `bson:"total" json:"total"`
+	TaxJurisdiction string `bson:"tax_jurisdiction,omitempty" json:"tax_jurisdiction,omitempty"`
+	TaxRateBps      int32  `bson:"tax_rate_bps,omitempty" json:"tax_rate_bps,omitempty"`
+}
+
+func (b *PriceBreakdown) ToProto() *ticketpb.PriceBreakdown {
+	if b == nil {
+		return nil
+	}
+	return &ticketpb.PriceBreakdown{
+		Currency:        b.Currency,
+		Base:            b.Base,
+		Discount:        b.Discount,
+		ServiceFee:      b.ServiceFee,
+		FacilityFee:     b.FacilityFee,
+		Tax:             b.Tax,
+		Total:           b.Total,
+		TaxJurisdiction: b.TaxJurisdiction,
+		TaxRateBps:      b.TaxRateBps,
+	}
+}
+
+// Add sums other into b. The tax jurisdiction is only kept while every part
+// shares it.
+func (b *PriceBreakdown) Add(other *PriceBreakdown) {
+	if b.Currency == "" {
+		b.Currency = other.Currency
+	}
+	if b.TaxJurisdiction != other.TaxJurisdiction || b.TaxRateBps != other.TaxRateBps {
+		b.TaxJurisdiction = ""
+		b.TaxRateBps = 0
+	}
+
+	b.Base += other.Base
+	b.Discount += other.Discount
+	b.ServiceFee += other.ServiceFee
+	b.FacilityFee += other.FacilityFee
+	b.Tax += other.Tax
+	b.Total += other.Total
+}
+
+// Split divides b into n shares that add up to it exactly. Every part is
+// split evenly, with what does not divide going to the first shares, and
+// each share's total is the sum of its parts. A nil breakdown splits into
+// nil.
+func (b *PriceBreakdown) Split(n int) []PriceBreakdown {
+	if b == nil || n <= 0 {
+		return nil
+	}
+
+	shares := make([]PriceBreakdown, n)
+	for i := range shares {
+		share := &shares[i]
+		share.Currency = b.Currency
+		share.TaxJurisdiction = b.TaxJurisdiction
+		share.TaxRateBps = b.TaxRateBps
+		share.Base = splitShare(b.Base, n, i)
+		share.Discount = splitShare(b.Discount, n, i)
+		share.ServiceFee = splitShare(b.ServiceFee, n, i)
+		share.FacilityFee = splitShare(b.FacilityFee, n, i)
+		share.Tax = splitShare(b.Tax, n, i)
+		share.Total = share.Base - share.Discount + share.ServiceFee + share.FacilityFee + share.Tax
+	}
+	return shares
+}
+
+func splitShare(amount int64, n, i int) int64 {
+	share := amount / int64(n)
+	if int64(i) < amount%int64(n) {
+		share++
+	}
+	return share
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFeeSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule FeeSchedule
+		wantErr  bool
+	}{
+		{name: "empty", schedule: FeeSchedule{}},
+		{name: "percentage only", schedule: FeeSchedule{ServiceFeeBps: 1000}},
+		{name: "fixed fees", schedule: FeeSchedule{Currency: "USD", FacilityFeePerTicket: 200}},
+		{name: "fixed fees without currency", schedule: FeeSchedule{FacilityFeePerTicket: 200}, wantErr: true},
+		{name: "bad currency", schedule: FeeSchedule{Currency: "usd"}, wantErr: true},
+		{name: "over 100 percent", schedule: FeeSchedule{ServiceFeeBps: 10001}, wantErr: true},
+		{name: "negative fee", schedule: FeeSchedule{Currency: "USD", ServiceFeePerTicket: -1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTicket_SetBreakdown(t *testing.T) {
+	order := NewOrder("user1", []OrderItem{{EventID: "event1", Quantity: 1}}, nil)
+	order.Items[0].SetPrice(1000, "USD")
+	order.SetBreakdowns([]*PriceBreakdown{{Currency: "USD", Base: 1000, ServiceFee: 100, Total: 1100}})
+	ticket := order.Tickets(time.Now().Add(time.Minute))[0]
+
+	if ticket.TotalPrice != 1100 {
+		t.Errorf("TotalPrice = %d, want 1100", ticket.TotalPrice)
+	}
+	if ticket.ToProto().Breakdown.GetTotal() != ticket.TotalPrice {
+		t.Errorf("breakdown total = %d, want the ticket's total %d", ticket.ToProto().Breakdown.GetTotal(), ticket.TotalPrice)
+	}
+}
@@ -0,0 +1,174 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type DiscountType string
+
+const (
+	DiscountPercentage DiscountType = "PERCENTAGE"
+	DiscountFixed      DiscountType = "FIXED"
+)
+
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// PromoCode is a discount on the price of tickets. A percentage code takes
+// PercentOff percent off each eligible ticket; a fixed code takes AmountOff,
+// in minor units of Currency, off each, down to zero. Codes can be limited to
+// one event and to some of its ticket types, and capped in redemptions
+// overall and per user; zero caps are unlimited.
+type PromoCode struct {
+	Code           string       `bson:"_id" json:"code"`
+	Description    string       `bson:"description,omitempty" json:"description,omitempty"`
+	DiscountType   DiscountType `bson:"discount_type" json:"discount_type"`
+	PercentOff     int32        `bson:"percent_off,omitempty" json:"percent_off,omitempty"`
+	AmountOff      int64        `bson:"amount_off,omitempty" json:"amount_off,omitempty"`
+	Currency       string       `bson:"currency,omitempty" json:"currency,omitempty"`
+	EventID        string       `bson:"event_id,omitempty" json:"event_id,omitempty"`
+	TicketTypeIDs  []string     `bson:"ticket_type_ids,omitempty" json:"ticket_type_ids,omitempty"`
+	Stackable      bool         `bson:"stackable" json:"stackable"`
+	MaxRedemptions int64        `bson:"max_redemptions" json:"max_redemptions"`
+	MaxPerUser     int64        `bson:"max_per_user" json:"max_per_user"`
+	Redemptions    int64        `bson:"redemptions" json:"redemptions"`
+	ExpiresAt      time.Time    `bson:"expires_at,omitempty" json:"expires_at,omitempty"`
+	CreatedAt      time.Time    `bson:"created_at" json:"created_at"`
+	UpdatedAt      time.Time    `bson:"updated_at" json:"updated_at"`
+}
+
+// NormalizePromoCode puts a code as typed by a buyer into its stored form.
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// PromoCodeFromProto reads the settings of a code from a create or update
+// request. Redemptions and timestamps are left to the repository.
+func PromoCodeFromProto(p *ticketpb.PromoCode) *PromoCode {
+	code := &PromoCode{
+		Code:           NormalizePromoCode(p.Code),
+		Description:    p.Description,
+		DiscountType:   DiscountType(p.DiscountType),
+		PercentOff:     p.PercentOff,
+		AmountOff:      p.AmountOff,
+		Currency:       strings.ToUpper(p.Currency),
+		EventID:        p.EventId,
+		TicketTypeIDs:  p.TicketTypeIds,
+		Stackable:      p.Stackable,
+		MaxRedemptions: p.MaxRedemptions,
+		MaxPerUser:     p.MaxPerUser,
+	}
+	if p.ExpiresAt != nil {
+		code.ExpiresAt = p.ExpiresAt.AsTime()
+	}
+	return code
+}
+
+func (c *PromoCode) Validate() error {
+	if !promoCodePattern.MatchString(c.Code) {
+		return errors.New("code must be 3 to 32 letters, digits, dashes or underscores")
+	}
+
+	switch c.DiscountType {
+	case DiscountPercentage:
+		if c.PercentOff < 1 || c.PercentOff > 100 {
+			return errors.New("percent off must be between 1 and 100")
+		}
+		if c.AmountOff != 0 {
+			return errors.New("percentage codes cannot have an amount off")
+		}
+	case DiscountFixed:
+		if c.AmountOff <= 0 {
+			return errors.New("amount off must be greater than 0")
+		}
+		if len(c.Currency) != 3 {
+			return errors.New("fixed codes need a 3-letter ISO currency code")
+		}
+		if c.PercentOff != 0 {
+			return errors.New("fixed codes cannot have a percent off")
+		}
+	default:
+		return fmt.Errorf("discount type must be %s or %s", DiscountPercentage, DiscountFixed)
+	}
+
+	if c.MaxRedemptions < 0 || c.MaxPerUser < 0 {
+		return errors.New("redemption caps cannot be negative")
+	}
+	if len(c.TicketTypeIDs) > 0 && c.EventID == "" {
+		return errors.New("codes limited to ticket types must name their event")
+	}
+	return nil
+}
+
+// Expired reports whether the code can no longer be used at now.
+func (c *PromoCode) Expired(now time.Time) bool {
+	return !c.ExpiresAt.IsZero() && !now.Before(c.ExpiresAt)
+}
+
+// AppliesTo reports whether the code can be used on tickets of ticketTypeID
+// at eventID.
+func (c *PromoCode) AppliesTo(eventID, ticketTypeID string) bool {
+	if c.EventID != "" && c.EventID != eventID {
+		return false
+	}
+	if len(c.TicketTypeIDs) == 0 {
+		return true
+	}
+	for _, id := range c.TicketTypeIDs {
+		if id == ticketTypeID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *PromoCode) ToProto() *ticketpb.PromoCode {
+	pb := &ticketpb.PromoCode{
+		Code:           c.Code,
+		Description:    c.Description,
+		DiscountType:   string(c.DiscountType),
+		PercentOff:     c.PercentOff,
+		AmountOff:      c.AmountOff,
+		Currency:       c.Currency,
+		EventId:        c.EventID,
+		TicketTypeIds:  c.TicketTypeIDs,
+		Stackable:      c.Stackable,
+		MaxRedemptions: c.MaxRedemptions,
+		MaxPerUser:     c.MaxPerUser,
+		Redemptions:    c.Redemptions,
+		CreatedAt:      timestamppb.New(c.CreatedAt),
+		UpdatedAt:      timestamppb.New(c.UpdatedAt),
+	}
+	if !c.ExpiresAt.IsZero() {
+		pb.ExpiresAt = timestamppb.New(c.ExpiresAt)
+	}
+	return pb
+}
+
+// PromoRedemption records one use of a code by an order. Its ID is the code
+// and the order ID, so redeeming twice for an order is a no-op.
+type PromoRedemption struct {
+	ID        string    `bson:"_id" json:"id"`
+	Code      string    `bson:"code" json:"code"`
+	OrderID   string    `bson:"order_id" json:"order_id"`
+	UserID    string    `bson:"user_id" json:"user_id"`
+	Amount    int64     `bson:"amount" json:"amount"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+func NewPromoRedemption(code string, order *Order, amount int64) *PromoRedemption {
+	return &PromoRedemption{
+		ID:        code + "/" + order.ID.Hex(),
+		Code:      code,
+		OrderID:   order.ID.Hex(),
+		UserID:    order.UserID,
+		Amount:    amount,
+		CreatedAt: time.Now(),
+	}
+}
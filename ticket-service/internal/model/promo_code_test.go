@@ -0,0 +1,51 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPromoCode_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    PromoCode
+		wantErr bool
+	}{
+		{name: "percentage", code: PromoCode{Code: "SUMMER-10", DiscountType: DiscountPercentage, PercentOff: 10}},
+		{name: "fixed", code: PromoCode{Code: "FIVE", DiscountType: DiscountFixed, AmountOff: 500, Currency: "USD"}},
+		{name: "bad code", code: PromoCode{Code: "a b", DiscountType: DiscountPercentage, PercentOff: 10}, wantErr: true},
+		{name: "unknown type", code: PromoCode{Code: "FREE", DiscountType: "BOGO"}, wantErr: true},
+		{name: "percent over 100", code: PromoCode{Code: "MORE", DiscountType: DiscountPercentage, PercentOff: 101}, wantErr: true},
+		{name: "fixed without currency", code: PromoCode{Code: "FIVE", DiscountType: DiscountFixed, AmountOff: 500}, wantErr: true},
+		{name: "negative cap", code: PromoCode{Code: "TEN", DiscountType: DiscountPercentage, PercentOff: 10, MaxPerUser: -1}, wantErr: true},
+		{
+			name:    "tiers without event",
+			code:    PromoCode{Code: "VIP", DiscountType: DiscountPercentage, PercentOff: 10, TicketTypeIDs: []string{"vip"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.code.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPromoCode_AppliesTo(t *testing.T) {
+	code := PromoCode{EventID: "event1", TicketTypeIDs: []string{"vip"}}
+	if !code.AppliesTo("event1", "vip") {
+		t.Error("AppliesTo(event1, vip) = false, want true")
+	}
+	if code.AppliesTo("event1", "ga") || code.AppliesTo("event2", "vip") {
+		t.Error("AppliesTo() = true for another tier or event")
+	}
+
+	code.ExpiresAt = time.Now()
+	if !code.Expired(code.ExpiresAt) {
+		t.Error("Expired() = false at the expiry time")
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import "fmt"
+
+// PurchaseLimits are an event's caps on how many tickets one order and one
+// user may buy. Zero means no limit.
+type PurchaseLimits struct {
+	MaxPerOrder int32 `bson:"max_per_order,omitempty" json:"max_per_order,omitempty"`
+	MaxPerUser  int32 `bson:"max_per_user,omitempty" json:"max_per_user,omitempty"`
+}
+
+// HeldStatuses are the statuses of tickets that count against a user's limit.
+var HeldStatuses = []TicketStatus{
+	TicketStatusReserved,
+	TicketStatusConfirmed,
+	TicketStatusUsed,
+}
+
+// LimitError reports a purchase over one of an event's limits together with
+// how many tickets the buyer may still order.
+type LimitError struct {
+	PerUser   bool
+	Limit     int32
+	Remaining int32
+}
+
+func (e *LimitError) Error() string {
+	limit := fmt.Sprintf("the limit is %d tickets per order", e.Limit)
+	if e.PerUser {
+		limit = fmt.Sprintf("the limit is %d tickets per user for this event", e.Limit)
+	}
+	if e.Remaining == 0 {
+		return limit + "; no more can be bought"
+	}
+	return fmt.Sprintf("%s; %d more can be bought", limit, e.Remaining)
+}
+
+// Check returns a *LimitError if a user who already holds held tickets of the
+// event may not buy quantity more.
+func (l PurchaseLimits) Check(quantity, held int32) error {
+	remaining := l.Remaining(held)
+
+	if l.MaxPerOrder > 0 && quantity > l.MaxPerOrder {
+		return &LimitError{Limit: l.MaxPerOrder, Remaining: remaining}
+	}
+	if l.MaxPerUser > 0 && quantity > remaining {
+		return &LimitError{PerUser: true, Limit: l.MaxPerUser, Remaining: remaining}
+	}
+	return nil
+}
+
+// Remaining is how many tickets a user holding held tickets may buy in their
+// next order, or -1 when nothing limits it.
+func (l PurchaseLimits) Remaining(held int32) int32 {
+	remaining := int32(-1)
+	if l.MaxPerUser > 0 {
+		remaining = max(l.MaxPerUser-held, 0)
+	}
+	if l.MaxPerOrder > 0 && (remaining < 0 || l.MaxPerOrder < remaining) {
+		remaining = l.MaxPerOrder
+	}
+	return remaining
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPurchaseLimits_Check(t *testing.T) {
+	tests := []struct {
+		name          string
+		limits        PurchaseLimits
+		quantity      int32
+		held          int32
+		wantPerUser   bool
+		wantRemaining int32
+		wantErr       bool
+	}{
+		{name: "no limits", limits: PurchaseLimits{}, quantity: 100},
+		{name: "within order limit", limits: PurchaseLimits{MaxPerOrder: 4}, quantity: 4},
+		{name: "over order limit", limits: PurchaseLimits{MaxPerOrder: 4}, quantity: 5, wantErr: true, wantRemaining: 4},
+		{name: "within user limit", limits: PurchaseLimits{MaxPerUser: 6}, quantity: 2, held: 4},
+		{name: "over user limit", limits: PurchaseLimits{MaxPerUser: 6}, quantity: 3, held: 4, wantErr: true, wantPerUser: true, wantRemaining: 2},
+		{name: "user limit reached", limits: PurchaseLimits{MaxPerUser: 6}, quantity: 1, held: 6, wantErr: true, wantPerUser: true},
+		{name: "order limit capped by user limit", limits: PurchaseLimits{MaxPerOrder: 4, MaxPerUser: 6}, quantity: 5, held: 3, wantErr: true, wantRemaining: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limits.Check(tt.quantity, tt.held)
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("Check() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var limitErr *LimitError
+			if !errors.As(err, &limitErr) {
+				t.Fatalf("Check() error = %v, want a *LimitError", err)
+			}
+			if limitErr.PerUser != tt.wantPerUser || limitErr.Remaining != tt.wantRemaining {
+				t.Errorf("Check() = %+v, want per user %v and %d remaining", limitErr, tt.wantPerUser, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestLimitError_Error(t *testing.T) {
+	err := &LimitError{PerUser: true, Limit: 6, Remaining: 2}
+	if got, want := err.Error(), "the limit is 6 tickets per user for this event; 2 more can be bought"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	err = &LimitError{PerUser: true, Limit: 6}
+	if got, want := err.Error(), "the limit is 6 tickets per user for this event; no more can be bought"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,144 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type SagaStatus string
+
+const (
+	SagaStatusRunning      SagaStatus = "RUNNING"
+	SagaStatusCompensating SagaStatus = "COMPENSATING"
+	SagaStatusCompleted    SagaStatus = "COMPLETED"
+	SagaStatusAborted      SagaStatus = "ABORTED"
+)
+
+type SagaStep string
+
+const (
+	SagaStepReserveStock     SagaStep = "RESERVE_STOCK"
+	SagaStepRedeemPromoCodes SagaStep = "REDEEM_PROMO_CODES"
+	SagaStepPriceOrder       SagaStep = "PRICE_ORDER"
+	SagaStepCreateOrder      SagaStep = "CREATE_ORDER"
+	SagaStepAuthorizePayment SagaStep = "AUTHORIZE_PAYMENT"
+	SagaStepCapturePayment   SagaStep = "CAPTURE_PAYMENT"
+	SagaStepConfirmOrder     SagaStep = "CONFIRM_ORDER"
+	SagaStepNotify           SagaStep = "NOTIFY"
+)
+
+// PurchaseSteps is the order in which a purchase saga runs. Once
+// SagaStepConfirmOrder has completed the purchase is final: later failures
+// are retried rather than compensated.
+var PurchaseSteps = []SagaStep{
+	SagaStepReserveStock,
+	SagaStepRedeemPromoCodes,
+	SagaStepPriceOrder,
+	SagaStepCreateOrder,
+	SagaStepAuthorizePayment,
+	SagaStepCapturePayment,
+	SagaStepConfirmOrder,
+	SagaStepNotify,
+}
+
+// PurchaseSaga is the persisted progress of placing an order. Its ID is the
+// ID of the order. Limits are the purchase limits of each event in the order
+// at the time the purchase started, and HoldUntil is when the order's tickets
+// stop being held for the buyer. LeaseOwner is the run driving the saga until
+// LeaseUntil, and Version counts its saves, so that a run whose lease was
+// taken over cannot overwrite the new owner's progress.
+type PurchaseSaga struct {
+	ID              primitive.ObjectID        `bson:"_id" json:"id"`
+	Order           Order                     `bson:"order" json:"order"`
+	Status          SagaStatus                `bson:"status" json:"status"`
+	Completed       []SagaStep                `bson:"completed" json:"completed"`
+	PaymentMethod   string                    `bson:"payment_method,omitempty" json:"-"`
+	Limits          map[string]PurchaseLimits `bson:"limits" json:"limits"`
+	HoldUntil       time.Time                 `bson:"hold_until" json:"hold_until"`
+	AuthorizationID string                    `bson:"authorization_id,omitempty" json:"authorization_id,omitempty"`
+	Error           string                    `bson:"error,omitempty" json:"error,omitempty"`
+	LeaseUntil      time.Time                 `bson:"lease_until" json:"lease_until"`
+	LeaseOwner      string                    `bson:"lease_owner" json:"-"`
+	Version         int64                     `bson:"version" json:"-"`
+	CreatedAt       time.Time                 `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time                 `bson:"updated_at" json:"updated_at"`
+}
+
+func NewPurchaseSaga(order *Order, paymentMethod string, holdTTL time.Duration) *PurchaseSaga {
+	now := time.Now()
+	return &PurchaseSaga{
+		ID:            order.ID,
+		Order:         *order,
+		Status:        SagaStatusRunning,
+		Completed:     []SagaStep{},
+		PaymentMethod: paymentMethod,
+		HoldUntil:     now.Add(holdTTL),
+		LeaseOwner:    primitive.NewObjectID().Hex(),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// Tickets returns the tickets the saga's order creates.
+func (s *PurchaseSaga) Tickets() []Ticket {
+	return s.Order.Tickets(s.HoldUntil)
+}
+
+// NextStep returns the first step that has not completed yet.
+func (s *PurchaseSaga) NextStep() (SagaStep, bool) {
+	for _, step := range PurchaseSteps {
+		if !s.HasCompleted(step) {
+			return step, true
+		}
+	}
+	return "", false
+}
+
+func (s *PurchaseSaga) HasCompleted(step SagaStep) bool {
+	for _, completed := range s.Completed {
+		if completed == step {
+			return true
+		}
+	}
+	return false
+}
+
+// Final reports whether the purchase has passed the point after which it can
+// no longer be rolled back.
+func (s *PurchaseSaga) Final() bool {
+	return s.HasCompleted(SagaStepConfirmOrder)
+}
+
+func (s *PurchaseSaga) Complete(step SagaStep) {
+	if !s.HasCompleted(step) {
+		s.Completed = append(s.Completed, step)
+	}
+}
+
+// Fail records that step failed. A step that failed, and above all one that
+// timed out, may still have taken effect on the remote side, so a step that
+// can be undone is counted as completed for compensation to undo. Every undo
+// tolerates a step that never happened.
+func (s *PurchaseSaga) Fail(step SagaStep) {
+	if step == SagaStepConfirmOrder || step == SagaStepNotify {
+		return
+	}
+	s.Complete(step)
+}
+
+// LastCompleted returns the most recently completed step, which is the next
+// one to compensate.
+func (s *PurchaseSaga) LastCompleted() (SagaStep, bool) {
+	if len(s.Completed) == 0 {
+		return "", false
+	}
+	return s.Completed[len(s.Completed)-1], true
+}
+
+// Undo records that the most recently completed step has been compensated.
+func (s *PurchaseSaga) Undo() {
+	if len(s.Completed) > 0 {
+		s.Completed = s.Completed[:len(s.Completed)-1]
+	}
+}
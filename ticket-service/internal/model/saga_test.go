@@ -0,0 +1,77 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPurchaseSaga_Steps(t *testing.T) {
+	order := NewOrder("user1", []OrderItem{{EventID: "event1", Quantity: 2}}, nil)
+	saga := NewPurchaseSaga(order, "", time.Minute)
+
+	if saga.ID != order.ID {
+		t.Errorf("saga ID = %s, want order ID %s", saga.ID.Hex(), order.ID.Hex())
+	}
+
+	for _, want := range PurchaseSteps {
+		step, ok := saga.NextStep()
+		if !ok || step != want {
+			t.Fatalf("NextStep() = %s, %v, want %s", step, ok, want)
+		}
+		if want == SagaStepConfirmOrder && saga.Final() {
+			t.Error("Final() = true before the order is confirmed")
+		}
+		saga.Complete(step)
+		saga.Complete(step)
+	}
+
+	if _, ok := saga.NextStep(); ok {
+		t.Error("NextStep() returned a step after every step completed")
+	}
+	if !saga.Final() {
+		t.Error("Final() = false after the order is confirmed")
+	}
+	if len(saga.Completed) != len(PurchaseSteps) {
+		t.Errorf("Completed has %d steps, want %d", len(saga.Completed), len(PurchaseSteps))
+	}
+}
+
+func TestPurchaseSaga_Undo(t *testing.T) {
+	order := NewOrder("user1", []OrderItem{{EventID: "event1", Quantity: 1}}, nil)
+	saga := NewPurchaseSaga(order, "", time.Minute)
+	saga.Complete(SagaStepReserveStock)
+	saga.Complete(SagaStepCreateOrder)
+
+	var undone []SagaStep
+	for {
+		step, ok := saga.LastCompleted()
+		if !ok {
+			break
+		}
+		undone = append(undone, step)
+		saga.Undo()
+	}
+
+	if len(undone) != 2 || undone[0] != SagaStepCreateOrder || undone[1] != SagaStepReserveStock {
+		t.Errorf("compensation order = %v, want [CREATE_ORDER RESERVE_STOCK]", undone)
+	}
+	if step, _ := saga.NextStep(); step != SagaStepReserveStock {
+		t.Errorf("NextStep() after undo = %s, want %s", step, SagaStepReserveStock)
+	}
+}
+
+func TestPurchaseSaga_Fail(t *testing.T) {
+	order := NewOrder("user1", []OrderItem{{EventID: "event1", Quantity: 1}}, nil)
+	saga := NewPurchaseSaga(order, "", time.Minute)
+	saga.Complete(SagaStepReserveStock)
+
+	saga.Fail(SagaStepRedeemPromoCodes)
+	if step, _ := saga.LastCompleted(); step != SagaStepRedeemPromoCodes {
+		t.Errorf("LastCompleted() after a failed step = %s, want it to be undone", step)
+	}
+
+	saga.Fail(SagaStepConfirmOrder)
+	if saga.Final() {
+		t.Error("Final() = true after confirming the order failed")
+	}
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"crypto/ed25519"
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SigningKeyStatus string
+
+const (
+	SigningKeyActive  SigningKeyStatus = "ACTIVE"
+	SigningKeyRetired SigningKeyStatus = "RETIRED"
+	SigningKeyRevoked SigningKeyStatus = "REVOKED"
+)
+
+// SigningKey is an Ed25519 key pair that ticket tokens are signed with. Only
+// the seed of the private key is stored.
+type SigningKey struct {
+	ID        string           `bson:"_id" json:"id"`
+	Seed      []byte           `bson:"seed" json:"-"`
+	PublicKey []byte           `bson:"public_key" json:"public_key"`
+	Status    SigningKeyStatus `bson:"status" json:"status"`
+	CreatedAt time.Time        `bson:"created_at" json:"created_at"`
+	RetiredAt time.Time        `bson:"retired_at,omitempty" json:"retired_at,omitempty"`
+}
+
+func (k *SigningKey) PrivateKey() ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(k.Seed)
+}
+
+// Trusted reports whether tokens signed with the key should be accepted.
+func (k *SigningKey) Trusted() bool {
+	return k.Status == SigningKeyActive || k.Status == SigningKeyRetired
+}
+
+func (k *SigningKey) ToProto() *ticketpb.TicketSigningKey {
+	pb := &ticketpb.TicketSigningKey{
+		Id:        k.ID,
+		Algorithm: "Ed25519",
+		PublicKey: k.PublicKey,
+		Status:    string(k.Status),
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+	if !k.RetiredAt.IsZero() {
+		pb.RetiredAt = timestamppb.New(k.RetiredAt)
+	}
+	return pb
+}
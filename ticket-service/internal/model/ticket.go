@@ -5,6 +5,7 @@ import (
 
 	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type TicketStatus string
@@ -14,36 +15,133 @@ const (
 	TicketStatusConfirmed TicketStatus = "CONFIRMED"
 	TicketStatusCancelled TicketStatus = "CANCELLED"
 	TicketStatusUsed      TicketStatus = "USED"
+	TicketStatusRefunded  TicketStatus = "REFUNDED"
 )
 
+// Valid reports whether s is one of the known ticket statuses.
+func (s TicketStatus) Valid() bool {
+	switch s {
+	case TicketStatusReserved, TicketStatusConfirmed, TicketStatusCancelled, TicketStatusUsed, TicketStatusRefunded:
+		return true
+	}
+	return false
+}
+
+var ticketTransitions = map[TicketStatus][]TicketStatus{
+	TicketStatusReserved:  {TicketStatusConfirmed, TicketStatusCancelled},
+	TicketStatusConfirmed: {TicketStatusUsed, TicketStatusCancelled, TicketStatusRefunded},
+}
+
+// CanTransitionTo reports whether a ticket in status s may move to next.
+// USED, CANCELLED and REFUNDED are terminal.
+func (s TicketStatus) CanTransitionTo(next TicketStatus) bool {
+	for _, allowed := range ticketTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusesTransitioningTo lists every status from which a ticket may move to
+// next.
+func StatusesTransitioningTo(next TicketStatus) []TicketStatus {
+	var from []TicketStatus
+	for status := range ticketTransitions {
+		if status.CanTransitionTo(next) {
+			from = append(from, status)
+		}
+	}
+	return from
+}
+
+// ReturnsStock reports whether moving into status s gives the ticket's
+// quantity back to the event.
+func (s TicketStatus) ReturnsStock() bool {
+	return s == TicketStatusCancelled || s == TicketStatusRefunded
+}
+
+// Ticket admits its holder, UserID, to an event. Its CodeVersion is bumped
+// whenever it changes hands, so codes issued to earlier holders stop getting
+// in, and Transfers keeps who it passed between.
 type Ticket struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	EventID   string             `bson:"event_id" json:"event_id"`
-	UserID    string             `bson:"user_id" json:"user_id"`
-	Status    TicketStatus       `bson:"status" json:"status"`
-	Quantity  int32              `bson:"quantity" json:"quantity"`
-	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
-	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	OrderID       string             `bson:"order_id,omitempty" json:"order_id,omitempty"`
+	EventID       string             `bson:"event_id" json:"event_id"`
+	TicketTypeID  string             `bson:"ticket_type_id,omitempty" json:"ticket_type_id,omitempty"`
+	SeatIDs       []string           `bson:"seat_ids,omitempty" json:"seat_ids,omitempty"`
+	UserID        string             `bson:"user_id" json:"user_id"`
+	Status        TicketStatus       `bson:"status" json:"status"`
+	ReservationID string             `bson:"reservation_id" json:"reservation_id"`
+	UnitPrice     int64              `bson:"unit_price" json:"unit_price"`
+	TotalPrice    int64              `bson:"total_price" json:"total_price"`
+	Currency      string             `bson:"currency,omitempty" json:"currency,omitempty"`
+	PromoCodes    []string           `bson:"promo_codes,omitempty" json:"promo_codes,omitempty"`
+	Discount      int64              `bson:"discount,omitempty" json:"discount,omitempty"`
+	Breakdown     *PriceBreakdown    `bson:"breakdown,omitempty" json:"breakdown,omitempty"`
+	ExpiresAt     time.Time          `bson:"expires_at,omitempty" json:"expires_at,omitempty"`
+	StockReleased bool               `bson:"stock_released,omitempty" json:"-"`
+	CheckedInAt   time.Time          `bson:"checked_in_at,omitempty" json:"checked_in_at,omitempty"`
+	GateID        string             `bson:"gate_id,omitempty" json:"gate_id,omitempty"`
+	Entries       int32              `bson:"entries,omitempty" json:"entries,omitempty"`
+	LastEntryAt   time.Time          `bson:"last_entry_at,omitempty" json:"last_entry_at,omitempty"`
+	CodeVersion   int32              `bson:"code_version,omitempty" json:"-"`
+	Transfers     []TransferRecord   `bson:"transfers,omitempty" json:"transfers,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at" json:"updated_at"`
 }
 
 func (t *Ticket) ToProto() *ticketpb.Ticket {
-	return &ticketpb.Ticket{
-		Id:      t.ID.Hex(),
-		EventId: t.EventID,
-		UserId:  t.UserID,
-		Status:  string(t.Status),
+	pb := &ticketpb.Ticket{
+		Id:           t.ID.Hex(),
+		EventId:      t.EventID,
+		UserId:       t.UserID,
+		Status:       string(t.Status),
+		Quantity:     1,
+		CreatedAt:    timestamppb.New(t.CreatedAt),
+		UpdatedAt:    timestamppb.New(t.UpdatedAt),
+		UnitPrice:    t.UnitPrice,
+		TotalPrice:   t.TotalPrice,
+		Currency:     t.Currency,
+		TicketTypeId: t.TicketTypeID,
+		SeatIds:      t.SeatIDs,
+		Discount:     t.Discount,
+		PromoCodes:   t.PromoCodes,
+		Breakdown:    t.Breakdown.ToProto(),
+		OrderId:      t.OrderID,
+		GateId:       t.GateID,
+		Entries:      t.Entries,
+	}
+	if !t.ExpiresAt.IsZero() {
+		pb.ExpiresAt = timestamppb.New(t.ExpiresAt)
+	}
+	for _, transfer := range t.Transfers {
+		pb.Transfers = append(pb.Transfers, transfer.ToProto())
+	}
+	if !t.CheckedInAt.IsZero() {
+		pb.CheckedInAt = timestamppb.New(t.CheckedInAt)
+		pb.LastEntryAt = timestamppb.New(t.LastEntryAt)
 	}
+	return pb
 }
 
-func NewTicket(eventID, userID string, quantity int32) *Ticket {
-	now := time.Now()
-	return &Ticket{
-		ID:        primitive.NewObjectID(),
-		EventID:   eventID,
-		UserID:    userID,
-		Status:    TicketStatusReserved,
-		Quantity:  quantity,
-		CreatedAt: now,
-		UpdatedAt: now,
-	}
+// SetPrice records the ticket's price, in minor units of currency, and
+// derives the total from it.
+func (t *Ticket) SetPrice(unitPrice int64, currency string) {
+	t.UnitPrice = unitPrice
+	t.TotalPrice = unitPrice - t.Discount
+	t.Currency = currency
+}
+
+// SetBreakdown records what the buyer is charged; the total price becomes the
+// breakdown's total.
+func (t *Ticket) SetBreakdown(breakdown *PriceBreakdown) {
+	t.Breakdown = breakdown
+	t.TotalPrice = breakdown.Total
+	t.Currency = breakdown.Currency
+}
+
+// HoldExpired reports whether a RESERVED ticket's hold has run out at now.
+func (t *Ticket) HoldExpired(now time.Time) bool {
+	return t.Status == TicketStatusReserved && !t.ExpiresAt.IsZero() && !now.Before(t.ExpiresAt)
 }
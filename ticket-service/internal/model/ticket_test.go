@@ -0,0 +1,107 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTicketStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from TicketStatus
+		to   TicketStatus
+		want bool
+	}{
+		{TicketStatusReserved, TicketStatusConfirmed, true},
+		{TicketStatusReserved, TicketStatusCancelled, true},
+		{TicketStatusReserved, TicketStatusRefunded, false},
+		{TicketStatusReserved, TicketStatusUsed, false},
+		{TicketStatusConfirmed, TicketStatusUsed, true},
+		{TicketStatusConfirmed, TicketStatusCancelled, true},
+		{TicketStatusConfirmed, TicketStatusRefunded, true},
+		{TicketStatusConfirmed, TicketStatusReserved, false},
+		{TicketStatusUsed, TicketStatusReserved, false},
+		{TicketStatusUsed, TicketStatusRefunded, false},
+		{TicketStatusCancelled, TicketStatusConfirmed, false},
+		{TicketStatusRefunded, TicketStatusConfirmed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+				t.Errorf("CanTransitionTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusesTransitioningTo(t *testing.T) {
+	from := StatusesTransitioningTo(TicketStatusCancelled)
+	if len(from) != 2 {
+		t.Fatalf("StatusesTransitioningTo(CANCELLED) = %v, want RESERVED and CONFIRMED", from)
+	}
+	for _, status := range from {
+		if status != TicketStatusReserved && status != TicketStatusConfirmed {
+			t.Errorf("StatusesTransitioningTo(CANCELLED) contains %s", status)
+		}
+	}
+
+	if from := StatusesTransitioningTo(TicketStatusReserved); len(from) != 0 {
+		t.Errorf("StatusesTransitioningTo(RESERVED) = %v, want none", from)
+	}
+}
+
+func TestTicket_HoldExpired(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		ticket Ticket
+		want   bool
+	}{
+		{"before expiry", Ticket{Status: TicketStatusReserved, ExpiresAt: now.Add(time.Second)}, false},
+		{"at expiry", Ticket{Status: TicketStatusReserved, ExpiresAt: now}, true},
+		{"after expiry", Ticket{Status: TicketStatusReserved, ExpiresAt: now.Add(-time.Second)}, true},
+		{"no expiry", Ticket{Status: TicketStatusReserved}, false},
+		{"confirmed", Ticket{Status: TicketStatusConfirmed, ExpiresAt: now.Add(-time.Second)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ticket.HoldExpired(now); got != tt.want {
+				t.Errorf("HoldExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTicket_ToProto(t *testing.T) {
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ticket := Ticket{
+		EventID:    "event1",
+		UserID:     "alice",
+		Status:     TicketStatusReserved,
+		UnitPrice:  2500,
+		TotalPrice: 2500,
+		Currency:   "USD",
+		ExpiresAt:  created.Add(15 * time.Minute),
+		CreatedAt:  created,
+		UpdatedAt:  created.Add(time.Minute),
+	}
+
+	pb := ticket.ToProto()
+	if pb.Quantity != 1 || pb.UnitPrice != 2500 || pb.TotalPrice != 2500 || pb.Currency != "USD" {
+		t.Errorf("ToProto() = quantity %d, unit %d, total %d %s, want 1, 2500, 2500 USD",
+			pb.Quantity, pb.UnitPrice, pb.TotalPrice, pb.Currency)
+	}
+	if !pb.CreatedAt.AsTime().Equal(ticket.CreatedAt) || !pb.UpdatedAt.AsTime().Equal(ticket.UpdatedAt) {
+		t.Errorf("ToProto() timestamps = %v, %v, want %v, %v", pb.CreatedAt.AsTime(), pb.UpdatedAt.AsTime(), ticket.CreatedAt, ticket.UpdatedAt)
+	}
+	if !pb.ExpiresAt.AsTime().Equal(ticket.ExpiresAt) {
+		t.Errorf("ToProto() expires at %v, want %v", pb.ExpiresAt.AsTime(), ticket.ExpiresAt)
+	}
+
+	ticket.ExpiresAt = time.Time{}
+	if pb := ticket.ToProto(); pb.ExpiresAt != nil {
+		t.Errorf("ToProto() of a ticket without a hold expires at %v", pb.ExpiresAt.AsTime())
+	}
+}
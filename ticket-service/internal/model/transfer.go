@@ -0,0 +1,101 @@
+package model
+
+import (
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TransferStatus string
+
+const (
+	TransferStatusPending   TransferStatus = "PENDING"
+	TransferStatusAccepted  TransferStatus = "ACCEPTED"
+	TransferStatusCancelled TransferStatus = "CANCELLED"
+)
+
+// Valid reports whether s is one of the known transfer statuses.
+func (s TransferStatus) Valid() bool {
+	switch s {
+	case TransferStatusPending, TransferStatusAccepted, TransferStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// Transfer is an offer by a ticket's holder to give it to another user.
+type Transfer struct {
+	ID         primitive.ObjectID `bson:"_id" json:"id"`
+	TicketID   string             `bson:"ticket_id" json:"ticket_id"`
+	EventID    string             `bson:"event_id" json:"event_id"`
+	FromUserID string             `bson:"from_user_id" json:"from_user_id"`
+	ToUserID   string             `bson:"to_user_id" json:"to_user_id"`
+	Status     TransferStatus     `bson:"status" json:"status"`
+	CreatedAt  time.Time          `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+func NewTransfer(ticket *Ticket, toUserID string) *Transfer {
+	now := time.Now()
+	return &Transfer{
+		ID:         primitive.NewObjectID(),
+		TicketID:   ticket.ID.Hex(),
+		EventID:    ticket.EventID,
+		FromUserID: ticket.UserID,
+		ToUserID:   toUserID,
+		Status:     TransferStatusPending,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+// Involves reports whether userID is the sender or the recipient.
+func (t *Transfer) Involves(userID string) bool {
+	return userID == t.FromUserID || userID == t.ToUserID
+}
+
+// Record is the entry the transfer leaves in its ticket's history once it is
+// accepted at time at.
+func (t *Transfer) Record(at time.Time) TransferRecord {
+	return TransferRecord{
+		TransferID:    t.ID.Hex(),
+		FromUserID:    t.FromUserID,
+		ToUserID:      t.ToUserID,
+		TransferredAt: at,
+	}
+}
+
+func (t *Transfer) ToProto() *ticketpb.TicketTransfer {
+	return &ticketpb.TicketTransfer{
+		Id:         t.ID.Hex(),
+		TicketId:   t.TicketID,
+		EventId:    t.EventID,
+		FromUserId: t.FromUserID,
+		ToUserId:   t.ToUserID,
+		Status:     string(t.Status),
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+		UpdatedAt:  timestamppb.New(t.UpdatedAt),
+	}
+}
+
+// TransferRecord is one change of a ticket's holder, through a transfer or a
+// resale listing.
+type TransferRecord struct {
+	TransferID    string    `bson:"transfer_id,omitempty" json:"transfer_id,omitempty"`
+	ListingID     string    `bson:"listing_id,omitempty" json:"listing_id,omitempty"`
+	FromUserID    string    `bson:"from_user_id" json:"from_user_id"`
+	ToUserID      string    `bson:"to_user_id" json:"to_user_id"`
+	TransferredAt time.Time `bson:"transferred_at" json:"transferred_at"`
+}
+
+func (r TransferRecord) ToProto() *ticketpb.TicketTransferRecord {
+	return &ticketpb.TicketTransferRecord{
+		TransferId:    r.TransferID,
+		ListingId:     r.ListingID,
+		FromUserId:    r.FromUserID,
+		ToUserId:      r.ToUserID,
+		TransferredAt: timestamppb.New(r.TransferredAt),
+	}
+}
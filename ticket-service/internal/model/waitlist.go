@@ -0,0 +1,115 @@
+package model
+
+import (
+	"time"
+
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting   WaitlistStatus = "WAITING"
+	WaitlistStatusOffered   WaitlistStatus = "OFFERED"
+	WaitlistStatusClaimed   WaitlistStatus = "CLAIMED"
+	WaitlistStatusExpired   WaitlistStatus = "EXPIRED"
+	WaitlistStatusCancelled WaitlistStatus = "CANCELLED"
+)
+
+// Valid reports whether s is one of the known waitlist statuses.
+func (s WaitlistStatus) Valid() bool {
+	switch s {
+	case WaitlistStatusWaiting, WaitlistStatusOffered, WaitlistStatusClaimed, WaitlistStatusExpired, WaitlistStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// WaitlistEntry is a user's place in line for Quantity tickets to a sold-out
+// event. Entries are served in _id order per event and ticket type. Stock
+// for the entry is reserved one unit per TicketIDs element, under that ID,
+// which becomes the ID of the ticket when the offer is claimed. Open is set
+// while the entry is WAITING or OFFERED, so a user has one open entry per
+// event; StockReleased is set once the stock of a closed entry that was never
+// claimed has been given back.
+type WaitlistEntry struct {
+	ID             primitive.ObjectID   `bson:"_id" json:"id"`
+	EventID        string               `bson:"event_id" json:"event_id"`
+	TicketTypeID   string               `bson:"ticket_type_id,omitempty" json:"ticket_type_id,omitempty"`
+	UserID         string               `bson:"user_id" json:"user_id"`
+	Quantity       int32                `bson:"quantity" json:"quantity"`
+	Status         WaitlistStatus       `bson:"status" json:"status"`
+	Open           bool                 `bson:"open,omitempty" json:"-"`
+	TicketIDs      []primitive.ObjectID `bson:"ticket_ids" json:"-"`
+	OfferedAt      time.Time            `bson:"offered_at,omitempty" json:"offered_at,omitempty"`
+	OfferExpiresAt time.Time            `bson:"offer_expires_at,omitempty" json:"offer_expires_at,omitempty"`
+	OrderID        string               `bson:"order_id,omitempty" json:"order_id,omitempty"`
+	Reason         string               `bson:"reason,omitempty" json:"reason,omitempty"`
+	StockReleased  bool                 `bson:"stock_released,omitempty" json:"-"`
+	CreatedAt      time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt      time.Time            `bson:"updated_at" json:"updated_at"`
+}
+
+func NewWaitlistEntry(eventID, ticketTypeID, userID string, quantity int32) *WaitlistEntry {
+	now := time.Now()
+	entry := &WaitlistEntry{
+		ID:           primitive.NewObjectID(),
+		EventID:      eventID,
+		TicketTypeID: ticketTypeID,
+		UserID:       userID,
+		Quantity:     quantity,
+		Status:       WaitlistStatusWaiting,
+		Open:         true,
+		TicketIDs:    make([]primitive.ObjectID, quantity),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	for i := range entry.TicketIDs {
+		entry.TicketIDs[i] = primitive.NewObjectID()
+	}
+	return entry
+}
+
+// Order returns a new order for the entry's tickets, which reuses the IDs the
+// offer's stock is reserved under, so the purchase takes the held stock.
+func (e *WaitlistEntry) Order(promoCodes []string) *Order {
+	order := NewOrder(e.UserID, []OrderItem{{
+		EventID:      e.EventID,
+		TicketTypeID: e.TicketTypeID,
+		Quantity:     e.Quantity,
+	}}, promoCodes)
+	order.Items[0].TicketIDs = append([]primitive.ObjectID(nil), e.TicketIDs...)
+	return order
+}
+
+// OfferExpired reports whether an OFFERED entry's offer has run out at now.
+func (e *WaitlistEntry) OfferExpired(now time.Time) bool {
+	return e.Status == WaitlistStatusOffered && !now.Before(e.OfferExpiresAt)
+}
+
+// ToProto converts the entry; position is its place in line, which is only
+// shown while it is WAITING.
+func (e *WaitlistEntry) ToProto(position int32) *ticketpb.WaitlistEntry {
+	pb := &ticketpb.WaitlistEntry{
+		Id:           e.ID.Hex(),
+		EventId:      e.EventID,
+		TicketTypeId: e.TicketTypeID,
+		UserId:       e.UserID,
+		Quantity:     e.Quantity,
+		Status:       string(e.Status),
+		OrderId:      e.OrderID,
+		Reason:       e.Reason,
+		CreatedAt:    timestamppb.New(e.CreatedAt),
+		UpdatedAt:    timestamppb.New(e.UpdatedAt),
+	}
+	if e.Status == WaitlistStatusWaiting {
+		pb.Position = position
+	}
+	if !e.OfferedAt.IsZero() {
+		pb.OfferedAt = timestamppb.New(e.OfferedAt)
+		pb.OfferExpiresAt = timestamppb.New(e.OfferExpiresAt)
+	}
+	return pb
+}
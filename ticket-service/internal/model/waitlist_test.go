@@ -0,0 +1,77 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWaitlistEntry_Order(t *testing.T) {
+	entry := NewWaitlistEntry("event1", "vip", "alice", 2)
+
+	order := entry.Order([]string{"SAVE10"})
+	if order.UserID != "alice" || order.Status != OrderStatusPending || len(order.PromoCodes) != 1 {
+		t.Errorf("Order() = %+v", order)
+	}
+	if len(order.Items) != 1 {
+		t.Fatalf("Order() items = %+v, want one item", order.Items)
+	}
+
+	item := order.Items[0]
+	if item.EventID != "event1" || item.TicketTypeID != "vip" || item.Quantity != 2 {
+		t.Errorf("Order() item = %+v", item)
+	}
+	if len(item.TicketIDs) != 2 || item.TicketIDs[0] != entry.TicketIDs[0] || item.TicketIDs[1] != entry.TicketIDs[1] {
+		t.Errorf("Order() ticket IDs = %v, want the entry's %v", item.TicketIDs, entry.TicketIDs)
+	}
+
+	// The order must not share the entry's slice.
+	item.TicketIDs[0] = entry.ID
+	if entry.TicketIDs[0] == entry.ID {
+		t.Error("Order() shares its ticket IDs with the entry")
+	}
+}
+
+func TestWaitlistEntry_OfferExpired(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		status    WaitlistStatus
+		expiresAt time.Time
+		want      bool
+	}{
+		{"open offer", WaitlistStatusOffered, now.Add(time.Minute), false},
+		{"expires now", WaitlistStatusOffered, now, true},
+		{"expired offer", WaitlistStatusOffered, now.Add(-time.Minute), true},
+		{"still waiting", WaitlistStatusWaiting, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := &WaitlistEntry{Status: tt.status, OfferExpiresAt: tt.expiresAt}
+			if got := entry.OfferExpired(now); got != tt.want {
+				t.Errorf("OfferExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaitlistEntry_ToProto(t *testing.T) {
+	entry := NewWaitlistEntry("event1", "", "alice", 1)
+
+	if got := entry.ToProto(3).Position; got != 3 {
+		t.Errorf("ToProto() position of waiting entry = %d, want 3", got)
+	}
+
+	entry.Status = WaitlistStatusOffered
+	entry.OfferedAt = time.Now()
+	entry.OfferExpiresAt = entry.OfferedAt.Add(time.Minute)
+
+	pb := entry.ToProto(3)
+	if pb.Position != 0 {
+		t.Errorf("ToProto() position of offered entry = %d, want 0", pb.Position)
+	}
+	if pb.OfferExpiresAt == nil || !pb.OfferExpiresAt.AsTime().Equal(entry.OfferExpiresAt) {
+		t.Errorf("ToProto() offer expires at %v, want %v", pb.OfferExpiresAt, entry.OfferExpiresAt)
+	}
+}
@@ -0,0 +1,64 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	TicketEventsStream        = "TICKETS"
+	TicketEventsSubjectPrefix = "tickets."
+
+	source = "ticket-service"
+)
+
+// TicketEventsStreamConfig is the bus stream ticket domain events are
+// published to.
+var TicketEventsStreamConfig = bus.Stream{
+	Name:     TicketEventsStream,
+	Subjects: []string{TicketEventsSubjectPrefix + ">"},
+}
+
+// BusPublisher publishes ticket domain events on the message bus under
+// "tickets.<type>". The outbox event ID becomes the envelope ID, so retried
+// publishes are dropped as duplicates.
+type BusPublisher struct {
+	publisher bus.Publisher
+}
+
+func NewBusPublisher(publisher bus.Publisher) *BusPublisher {
+	return &BusPublisher{publisher: publisher}
+}
+
+func (p *BusPublisher) Publish(ctx context.Context, event *model.OutboxEvent) error {
+	ctx = bus.WithTraceContext(ctx, event.TraceContext)
+
+	payload := &ticketpb.TicketEvent{
+		TicketId:        event.Payload.TicketID,
+		EventId:         event.Payload.EventID,
+		UserId:          event.Payload.UserID,
+		Quantity:        event.Payload.Quantity,
+		Status:          string(event.Payload.Status),
+		Reason:          event.Payload.Reason,
+		TransferId:      event.Payload.TransferID,
+		ListingId:       event.Payload.ListingID,
+		FromUserId:      event.Payload.FromUserID,
+		ToUserId:        event.Payload.ToUserID,
+		WaitlistEntryId: event.Payload.WaitlistEntryID,
+		OrderId:         event.Payload.OrderID,
+	}
+	if !event.Payload.OfferExpiresAt.IsZero() {
+		payload.OfferExpiresAt = timestamppb.New(event.Payload.OfferExpiresAt)
+	}
+
+	env, err := bus.NewEnvelope(ctx, event.ID.Hex(), string(event.Type), source, event.CreatedAt, payload)
+	if err != nil {
+		return err
+	}
+
+	return p.publisher.Publish(ctx, TicketEventsSubjectPrefix+string(event.Type), env)
+}
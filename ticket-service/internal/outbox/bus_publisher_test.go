@@ -0,0 +1,54 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
+	ticketpb "github.com/doniiel/event-ticketing-platform/proto/ticket"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusPublisher_Publish(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventBus := bus.NewMemory()
+	publisher := NewBusPublisher(eventBus)
+
+	order := model.NewOrder("user1", []model.OrderItem{{EventID: "event1", Quantity: 2}}, nil)
+	ticket := &order.Tickets(time.Now().Add(time.Minute))[0]
+	event := model.NewTicketEvent(model.EventTicketPurchased, ticket, "")
+	event.Payload.OrderID = order.ID.Hex()
+
+	assert.NoError(t, publisher.Publish(ctx, event))
+	assert.NoError(t, publisher.Publish(ctx, event))
+
+	received := make(chan *bus.Message, 2)
+	assert.NoError(t, eventBus.Subscribe(ctx, "tickets.>", 0, func(ctx context.Context, msg *bus.Message) error {
+		received <- msg
+		return nil
+	}))
+
+	msg := <-received
+	assert.Equal(t, "tickets.TicketPurchased", msg.Subject)
+	assert.Equal(t, event.ID.Hex(), msg.Envelope.Id)
+	assert.Equal(t, "TicketPurchased", msg.Envelope.Type)
+	assert.Equal(t, "ticket-service", msg.Envelope.Source)
+
+	var payload ticketpb.TicketEvent
+	assert.NoError(t, msg.Envelope.Payload.UnmarshalTo(&payload))
+	assert.Equal(t, ticket.ID.Hex(), payload.TicketId)
+	assert.Equal(t, "event1", payload.EventId)
+	assert.Equal(t, "user1", payload.UserId)
+	assert.Equal(t, int32(1), payload.Quantity)
+	assert.Equal(t, order.ID.Hex(), payload.OrderId)
+
+	select {
+	case <-received:
+		t.Fatal("duplicate publish was delivered")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
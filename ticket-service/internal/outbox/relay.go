@@ -0,0 +1,116 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+)
+
+const (
+	// claimLease is how long a claimed event stays hidden from other relays
+	// while it is being published.
+	claimLease = 30 * time.Second
+	maxBackoff = 5 * time.Minute
+)
+
+// Publisher delivers a domain event to its consumers. Delivery is
+// at-least-once, so consumers must tolerate duplicates.
+type Publisher interface {
+	Publish(ctx context.Context, event *model.OutboxEvent) error
+}
+
+// Relay polls the outbox and publishes pending events, retrying failures with
+// exponential backoff until they succeed.
+type Relay struct {
+	repo      *repository.OutboxRepository
+	publisher Publisher
+	interval  time.Duration
+	stopCh    chan struct{}
+}
+
+func NewRelay(repo *repository.OutboxRepository, publisher Publisher, interval time.Duration) *Relay {
+	return &Relay{
+		repo:      repo,
+		publisher: publisher,
+		interval:  interval,
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (r *Relay) Start() {
+	log.Printf("Starting outbox relay, polling every %v", r.interval)
+	go r.run()
+}
+
+func (r *Relay) Stop() {
+	close(r.stopCh)
+}
+
+func (r *Relay) run() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.Drain(context.Background())
+		case <-r.stopCh:
+			log.Println("Outbox relay stopped")
+			return
+		}
+	}
+}
+
+// Drain publishes due events until the outbox has none left or the relay is
+// stopped.
+func (r *Relay) Drain(ctx context.Context) {
+	for {
+		select {
+		case <-r.stopCh:
+			return
+		default:
+		}
+
+		now := time.Now()
+		event, err := r.repo.ClaimNext(ctx, now, claimLease)
+		if err != nil {
+			log.Printf("Failed to claim outbox event: %v", err)
+			return
+		}
+		if event == nil {
+			return
+		}
+
+		publishCtx, cancel := context.WithTimeout(ctx, claimLease)
+		err = r.publisher.Publish(publishCtx, event)
+		cancel()
+
+		if err != nil {
+			next := now.Add(backoff(event.Attempts))
+			log.Printf("Failed to publish %s event %s (attempt %d), retrying at %v: %v",
+				event.Type, event.ID.Hex(), event.Attempts, next.Format(time.RFC3339), err)
+			if err := r.repo.MarkFailed(ctx, event.ID, next, err); err != nil {
+				log.Printf("Failed to record outbox failure for %s: %v", event.ID.Hex(), err)
+			}
+			continue
+		}
+
+		if err := r.repo.MarkPublished(ctx, event.ID, time.Now()); err != nil {
+			log.Printf("Failed to mark outbox event %s published: %v", event.ID.Hex(), err)
+		}
+	}
+}
+
+func backoff(attempts int32) time.Duration {
+	delay := time.Second
+	for i := int32(1); i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,131 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// stubPublisher records the events it publishes and fails every publish while
+// failing is set.
+type stubPublisher struct {
+	failing   bool
+	published []*model.OutboxEvent
+}
+
+func (p *stubPublisher) Publish(ctx context.Context, event *model.OutboxEvent) error {
+	if p.failing {
+		return errors.New("bus is down")
+	}
+	p.published = append(p.published, event)
+	return nil
+}
+
+func eventDocument(mt *mtest.T, event *model.OutboxEvent) bson.D {
+	raw, err := bson.Marshal(event)
+	if err != nil {
+		mt.Fatalf("failed to marshal event: %v", err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		mt.Fatalf("failed to unmarshal event: %v", err)
+	}
+	return doc
+}
+
+// sentUpdate returns the update of the first update command sent.
+func sentUpdate(mt *mtest.T) bson.Raw {
+	for _, event := range mt.GetAllStartedEvents() {
+		if event.CommandName == "update" {
+			return event.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("u").Document()
+		}
+	}
+	mt.Fatalf("no update command was sent")
+	return nil
+}
+
+func TestRelay_Drain(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	ticket := &model.Ticket{ID: primitive.NewObjectID(), EventID: "event1", UserID: "user1", Status: model.TicketStatusConfirmed}
+	event := model.NewTicketEvent(model.EventTicketPurchased, ticket, "")
+	event.Attempts = 3
+
+	tests := []struct {
+		name    string
+		failing bool
+	}{
+		{name: "published", failing: false},
+		{name: "bus down", failing: true},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			publisher := &stubPublisher{failing: tt.failing}
+			relay := NewRelay(repository.NewOutboxRepository(mt.DB), publisher, time.Second)
+			mt.AddMockResponses(
+				mtest.CreateSuccessResponse(bson.E{Key: "value", Value: eventDocument(mt, event)}),
+				mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}),
+				// The outbox has nothing else due.
+				mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}),
+			)
+
+			start := time.Now()
+			relay.Drain(context.Background())
+
+			set := sentUpdate(mt).Lookup("$set").Document()
+			if !tt.failing {
+				if len(publisher.published) != 1 || publisher.published[0].ID != event.ID {
+					mt.Fatalf("published %v, want %s", publisher.published, event.ID.Hex())
+				}
+				if _, err := set.LookupErr("published_at"); err != nil {
+					mt.Errorf("Drain() update = %v, want the event marked published", set)
+				}
+				return
+			}
+
+			if len(publisher.published) != 0 {
+				mt.Fatalf("published %v while the bus was down", publisher.published)
+			}
+			if _, err := set.LookupErr("published_at"); err == nil {
+				mt.Fatalf("Drain() marked an event that failed to publish as published")
+			}
+			if set.Lookup("last_error").StringValue() != "bus is down" {
+				mt.Errorf("Drain() recorded error %v, want bus is down", set.Lookup("last_error"))
+			}
+			// The third attempt failed, so the next one waits backoff(3).
+			wait := set.Lookup("next_attempt_at").Time().Sub(start)
+			if want := backoff(3); wait < want-time.Millisecond || wait > want+time.Second {
+				mt.Errorf("Drain() retries in %v, want %v", wait, want)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{4, 8 * time.Second},
+		{9, 256 * time.Second},
+		{10, maxBackoff},
+		{100, maxBackoff},
+	}
+
+	for _, tt := range tests {
+		if got := backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
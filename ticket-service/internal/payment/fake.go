@@ -0,0 +1,91 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// DeclinedPaymentMethod is a payment method the fake provider always
+	// declines.
+	DeclinedPaymentMethod = "pm_card_declined"
+
+	fakeAuthorizationPrefix = "fake_auth_"
+)
+
+// FakeProvider is a deterministic, in-process payment gateway for tests and
+// local environments. It approves every payment method except
+// DeclinedPaymentMethod, derives authorization IDs from idempotency keys and
+// signs webhooks with HMAC-SHA256 over the raw payload.
+type FakeProvider struct {
+	webhookSecret []byte
+}
+
+func NewFakeProvider(webhookSecret string) *FakeProvider {
+	return &FakeProvider{webhookSecret: []byte(webhookSecret)}
+}
+
+func (p *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (p *FakeProvider) Authorize(ctx context.Context, req AuthorizeRequest) (string, error) {
+	if req.IdempotencyKey == "" {
+		return "", fmt.Errorf("idempotency key is required")
+	}
+	if req.Amount < 0 {
+		return "", fmt.Errorf("invalid amount %d", req.Amount)
+	}
+	if req.PaymentMethod == DeclinedPaymentMethod {
+		return "", ErrDeclined
+	}
+	return fakeAuthorizationPrefix + req.IdempotencyKey, nil
+}
+
+func (p *FakeProvider) Capture(ctx context.Context, authorizationID string, amount int64) error {
+	return p.checkAuthorization(authorizationID)
+}
+
+func (p *FakeProvider) Void(ctx context.Context, authorizationID string) error {
+	return p.checkAuthorization(authorizationID)
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, authorizationID string, amount int64) error {
+	return p.checkAuthorization(authorizationID)
+}
+
+func (p *FakeProvider) VerifyWebhook(payload []byte, signature string) (*WebhookEvent, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, p.mac(payload)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	return &event, nil
+}
+
+// Sign returns the signature the fake provider would send with payload.
+func (p *FakeProvider) Sign(payload []byte) string {
+	return hex.EncodeToString(p.mac(payload))
+}
+
+func (p *FakeProvider) mac(payload []byte) []byte {
+	mac := hmac.New(sha256.New, p.webhookSecret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func (p *FakeProvider) checkAuthorization(authorizationID string) error {
+	if !strings.HasPrefix(authorizationID, fakeAuthorizationPrefix) {
+		return ErrUnknownAuthorization
+	}
+	return nil
+}
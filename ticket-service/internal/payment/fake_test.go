@@ -0,0 +1,67 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFakeProvider_Authorize(t *testing.T) {
+	provider := NewFakeProvider("secret")
+	ctx := context.Background()
+
+	first, err := provider.Authorize(ctx, AuthorizeRequest{IdempotencyKey: "ticket1", Amount: 5000, Currency: "USD"})
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	second, err := provider.Authorize(ctx, AuthorizeRequest{IdempotencyKey: "ticket1", Amount: 5000, Currency: "USD"})
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if first != second {
+		t.Errorf("Authorize() returned %s and %s for the same idempotency key", first, second)
+	}
+
+	_, err = provider.Authorize(ctx, AuthorizeRequest{IdempotencyKey: "ticket2", PaymentMethod: DeclinedPaymentMethod, Amount: 5000})
+	if !errors.Is(err, ErrDeclined) {
+		t.Errorf("Authorize() error = %v, want %v", err, ErrDeclined)
+	}
+
+	if _, err := provider.Authorize(ctx, AuthorizeRequest{Amount: 5000}); err == nil {
+		t.Error("Authorize() without an idempotency key succeeded")
+	}
+
+	for name, op := range map[string]func(string) error{
+		"Capture": func(id string) error { return provider.Capture(ctx, id, 5000) },
+		"Void":    func(id string) error { return provider.Void(ctx, id) },
+		"Refund":  func(id string) error { return provider.Refund(ctx, id, 5000) },
+	} {
+		if err := op(first); err != nil {
+			t.Errorf("%s() error = %v", name, err)
+		}
+		if err := op("auth_unknown"); !errors.Is(err, ErrUnknownAuthorization) {
+			t.Errorf("%s() on unknown authorization error = %v, want %v", name, err, ErrUnknownAuthorization)
+		}
+	}
+}
+
+func TestFakeProvider_VerifyWebhook(t *testing.T) {
+	provider := NewFakeProvider("secret")
+	payload := []byte(`{"id":"evt_1","type":"payment.refunded","authorization_id":"fake_auth_ticket1"}`)
+
+	event, err := provider.VerifyWebhook(payload, provider.Sign(payload))
+	if err != nil {
+		t.Fatalf("VerifyWebhook() error = %v", err)
+	}
+	if event.Type != WebhookPaymentRefunded || event.AuthorizationID != "fake_auth_ticket1" {
+		t.Errorf("VerifyWebhook() = %+v", event)
+	}
+
+	other := NewFakeProvider("other-secret")
+	if _, err := provider.VerifyWebhook(payload, other.Sign(payload)); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("VerifyWebhook() with wrong secret error = %v, want %v", err, ErrInvalidSignature)
+	}
+	if _, err := provider.VerifyWebhook(payload, "not-hex"); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("VerifyWebhook() with malformed signature error = %v, want %v", err, ErrInvalidSignature)
+	}
+}
@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrDeclined              = errors.New("payment declined")
+	ErrUnknownAuthorization  = errors.New("unknown authorization")
+	ErrInvalidSignature      = errors.New("invalid webhook signature")
+	ErrUnsupportedTransition = errors.New("payment is not in a valid status for this operation")
+)
+
+// AuthorizeRequest asks a provider to hold Amount on the buyer's payment
+// method. Providers must treat repeated requests with the same
+// IdempotencyKey as one authorization.
+type AuthorizeRequest struct {
+	IdempotencyKey string
+	UserID         string
+	PaymentMethod  string
+	Amount         int64
+	Currency       string
+}
+
+type WebhookEventType string
+
+const (
+	WebhookPaymentCaptured WebhookEventType = "payment.captured"
+	WebhookPaymentVoided   WebhookEventType = "payment.voided"
+	WebhookPaymentRefunded WebhookEventType = "payment.refunded"
+)
+
+// WebhookEvent is a verified notification sent by a provider when a payment
+// changes outside of our own calls, e.g. a refund issued from its dashboard.
+type WebhookEvent struct {
+	ID              string           `json:"id"`
+	Type            WebhookEventType `json:"type"`
+	AuthorizationID string           `json:"authorization_id"`
+}
+
+// Provider is a payment gateway. Capture, Void and Refund must succeed when
+// repeated for an authorization already in the requested state.
+type Provider interface {
+	Name() string
+	Authorize(ctx context.Context, req AuthorizeRequest) (string, error)
+	Capture(ctx context.Context, authorizationID string, amount int64) error
+	Void(ctx context.Context, authorizationID string) error
+	Refund(ctx context.Context, authorizationID string, amount int64) error
+	VerifyWebhook(payload []byte, signature string) (*WebhookEvent, error)
+}
@@ -0,0 +1,235 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+)
+
+// Service keeps the payment record of each order in step with the provider.
+// Every method is safe to repeat, which the purchase saga relies on when it
+// resumes after a restart.
+type Service struct {
+	provider Provider
+	repo     *repository.PaymentRepository
+}
+
+func NewService(provider Provider, repo *repository.PaymentRepository) *Service {
+	return &Service{
+		provider: provider,
+		repo:     repo,
+	}
+}
+
+// Authorize holds the order's total price on paymentMethod. The payment is
+// recorded as PENDING before the provider is asked, so that Reverse can find
+// an authorization whose answer was lost. A declined payment is recorded and
+// reported as ErrDeclined.
+func (s *Service) Authorize(ctx context.Context, order *model.Order, paymentMethod string) (*model.Payment, error) {
+	payment, err := s.repo.GetByOrderID(ctx, order.ID.Hex())
+	switch {
+	case err == nil && payment.Status == model.PaymentStatusPending:
+	case err == nil && payment.Status == model.PaymentStatusDeclined:
+		payment.Status = model.PaymentStatusPending
+		payment.PaymentMethod = paymentMethod
+		if err := s.repo.Save(ctx, payment); err != nil {
+			return nil, fmt.Errorf("failed to save payment: %w", err)
+		}
+	case err == nil:
+		return payment, nil
+	case !errors.Is(err, repository.ErrPaymentNotFound):
+		return nil, err
+	default:
+		payment = model.NewPayment(order, s.provider.Name(), paymentMethod)
+		if err := s.repo.Save(ctx, payment); err != nil {
+			return nil, fmt.Errorf("failed to save payment: %w", err)
+		}
+	}
+
+	if err := s.authorize(ctx, payment); err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+// authorize asks the provider to authorize a PENDING payment and records its
+// answer. Asking again for the same payment returns the same authorization.
+func (s *Service) authorize(ctx context.Context, payment *model.Payment) error {
+	authorizationID, err := s.provider.Authorize(ctx, AuthorizeRequest{
+		IdempotencyKey: payment.OrderID,
+		UserID:         payment.UserID,
+		PaymentMethod:  payment.PaymentMethod,
+		Amount:         payment.Amount,
+		Currency:       payment.Currency,
+	})
+	if errors.Is(err, ErrDeclined) {
+		payment.Status = model.PaymentStatusDeclined
+		payment.FailureReason = err.Error()
+		if err := s.repo.Save(ctx, payment); err != nil {
+			log.Printf("Failed to record declined payment for order %s: %v", payment.OrderID, err)
+		}
+		return ErrDeclined
+	}
+	if err != nil {
+		return fmt.Errorf("failed to authorize payment: %w", err)
+	}
+
+	payment.AuthorizationID = authorizationID
+	payment.Status = model.PaymentStatusAuthorized
+	payment.FailureReason = ""
+	if err := s.repo.Save(ctx, payment); err != nil {
+		return fmt.Errorf("failed to save payment: %w", err)
+	}
+	return nil
+}
+
+// Captured reports whether the payment of an order has been collected.
+func (s *Service) Captured(ctx context.Context, orderID string) (bool, error) {
+	payment, err := s.repo.GetByOrderID(ctx, orderID)
+	if errors.Is(err, repository.ErrPaymentNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return payment.Status == model.PaymentStatusCaptured, nil
+}
+
+// Capture collects an authorized payment.
+func (s *Service) Capture(ctx context.Context, orderID string) (*model.Payment, error) {
+	payment, err := s.repo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch payment.Status {
+	case model.PaymentStatusCaptured:
+		return payment, nil
+	case model.PaymentStatusAuthorized:
+	default:
+		return nil, fmt.Errorf("%w: cannot capture a %s payment", ErrUnsupportedTransition, payment.Status)
+	}
+
+	if err := s.provider.Capture(ctx, payment.AuthorizationID, payment.Amount); err != nil {
+		return nil, fmt.Errorf("failed to capture payment: %w", err)
+	}
+
+	return payment, s.setStatus(ctx, payment, model.PaymentStatusCaptured)
+}
+
+// Reverse gives the buyer their money back for a whole order: an authorized
+// payment is voided and what has not yet been refunded of a captured one is
+// refunded. A PENDING payment may have been authorized without the answer
+// reaching us, so its authorization is asked for again and then voided.
+// Orders without a live payment, and authorizations the provider does not
+// know, are left alone.
+func (s *Service) Reverse(ctx context.Context, orderID string) error {
+	payment, err := s.repo.GetByOrderID(ctx, orderID)
+	if errors.Is(err, repository.ErrPaymentNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if payment.Status == model.PaymentStatusPending {
+		err := s.authorize(ctx, payment)
+		if errors.Is(err, ErrDeclined) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	switch payment.Status {
+	case model.PaymentStatusAuthorized:
+		err := s.provider.Void(ctx, payment.AuthorizationID)
+		if err != nil && !errors.Is(err, ErrUnknownAuthorization) {
+			return fmt.Errorf("failed to void payment: %w", err)
+		}
+		return s.setStatus(ctx, payment, model.PaymentStatusVoided)
+	case model.PaymentStatusCaptured:
+		if err := s.provider.Refund(ctx, payment.AuthorizationID, payment.Amount-payment.RefundedAmount); err != nil {
+			return fmt.Errorf("failed to refund payment: %w", err)
+		}
+		payment.RefundedAmount = payment.Amount
+		return s.setStatus(ctx, payment, model.PaymentStatusRefunded)
+	}
+
+	return nil
+}
+
+// RefundTicket gives the buyer back what they paid for one ticket of an
+// order. Only captured payments are refunded: an order still being paid for
+// voids its whole payment if one of its tickets is cancelled.
+func (s *Service) RefundTicket(ctx context.Context, ticket *model.Ticket) error {
+	payment, err := s.repo.GetByOrderID(ctx, ticket.OrderID)
+	if errors.Is(err, repository.ErrPaymentNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ticketID := ticket.ID.Hex()
+	if payment.Status != model.PaymentStatusCaptured || payment.Refunded(ticketID) {
+		return nil
+	}
+
+	amount := min(ticket.TotalPrice, payment.Amount-payment.RefundedAmount)
+	if err := s.provider.Refund(ctx, payment.AuthorizationID, amount); err != nil {
+		return fmt.Errorf("failed to refund ticket: %w", err)
+	}
+
+	payment.RefundedAmount += amount
+	payment.RefundedTickets = append(payment.RefundedTickets, ticketID)
+	if payment.RefundedAmount >= payment.Amount {
+		payment.Status = model.PaymentStatusRefunded
+	}
+	if err := s.repo.Save(ctx, payment); err != nil {
+		return fmt.Errorf("failed to save payment: %w", err)
+	}
+	return nil
+}
+
+// HandleWebhook verifies a provider notification and applies it to the
+// matching payment record.
+func (s *Service) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	event, err := s.provider.VerifyWebhook(payload, signature)
+	if err != nil {
+		return err
+	}
+
+	payment, err := s.repo.GetByAuthorizationID(ctx, event.AuthorizationID)
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case WebhookPaymentCaptured:
+		return s.setStatus(ctx, payment, model.PaymentStatusCaptured)
+	case WebhookPaymentVoided:
+		return s.setStatus(ctx, payment, model.PaymentStatusVoided)
+	case WebhookPaymentRefunded:
+		return s.setStatus(ctx, payment, model.PaymentStatusRefunded)
+	}
+
+	log.Printf("Ignoring payment webhook %s of type %s", event.ID, event.Type)
+	return nil
+}
+
+func (s *Service) setStatus(ctx context.Context, payment *model.Payment, status model.PaymentStatus) error {
+	if payment.Status == status {
+		return nil
+	}
+	payment.Status = status
+	if err := s.repo.Save(ctx, payment); err != nil {
+		return fmt.Errorf("failed to save payment: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package payment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// recordingProvider is the fake provider, remembering what it voided.
+type recordingProvider struct {
+	*FakeProvider
+	voided []string
+}
+
+func (p *recordingProvider) Void(ctx context.Context, authorizationID string) error {
+	p.voided = append(p.voided, authorizationID)
+	return p.FakeProvider.Void(ctx, authorizationID)
+}
+
+func paymentDocument(t *testing.T, payment *model.Payment) bson.D {
+	raw, err := bson.Marshal(payment)
+	if err != nil {
+		t.Fatalf("failed to marshal payment: %v", err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("failed to unmarshal payment: %v", err)
+	}
+	return doc
+}
+
+func TestService_Reverse(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	order := model.NewOrder("alice", []model.OrderItem{{EventID: "event1", Quantity: 1}}, nil)
+	order.TotalPrice = 5000
+
+	mt.Run("pending payment", func(mt *mtest.T) {
+		provider := &recordingProvider{FakeProvider: NewFakeProvider("secret")}
+		service := NewService(provider, repository.NewPaymentRepository(mt.DB))
+
+		// The authorization was made, but its answer never came back.
+		payment := model.NewPayment(order, provider.Name(), "pm_card")
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "test.payments", mtest.FirstBatch, paymentDocument(mt.T, payment)),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+		)
+
+		if err := service.Reverse(context.Background(), order.ID.Hex()); err != nil {
+			mt.Fatalf("Reverse() error = %v", err)
+		}
+		if len(provider.voided) != 1 || provider.voided[0] != fakeAuthorizationPrefix+order.ID.Hex() {
+			mt.Errorf("Reverse() voided %v, want the order's authorization", provider.voided)
+		}
+	})
+
+	mt.Run("declined pending payment", func(mt *mtest.T) {
+		provider := &recordingProvider{FakeProvider: NewFakeProvider("secret")}
+		service := NewService(provider, repository.NewPaymentRepository(mt.DB))
+
+		payment := model.NewPayment(order, provider.Name(), DeclinedPaymentMethod)
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "test.payments", mtest.FirstBatch, paymentDocument(mt.T, payment)),
+			mtest.CreateSuccessResponse(),
+		)
+
+		if err := service.Reverse(context.Background(), order.ID.Hex()); err != nil {
+			mt.Fatalf("Reverse() error = %v", err)
+		}
+		if len(provider.voided) != 0 {
+			mt.Errorf("Reverse() voided %v, want nothing", provider.voided)
+		}
+	})
+
+	mt.Run("unknown authorization", func(mt *mtest.T) {
+		provider := &recordingProvider{FakeProvider: NewFakeProvider("secret")}
+		service := NewService(provider, repository.NewPaymentRepository(mt.DB))
+
+		payment := model.NewPayment(order, provider.Name(), "pm_card")
+		payment.Status = model.PaymentStatusAuthorized
+		payment.AuthorizationID = "auth_unknown"
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "test.payments", mtest.FirstBatch, paymentDocument(mt.T, payment)),
+			mtest.CreateSuccessResponse(),
+		)
+
+		if err := service.Reverse(context.Background(), order.ID.Hex()); err != nil {
+			mt.Errorf("Reverse() error = %v", err)
+		}
+	})
+
+	mt.Run("no payment", func(mt *mtest.T) {
+		service := NewService(NewFakeProvider("secret"), repository.NewPaymentRepository(mt.DB))
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "test.payments", mtest.FirstBatch),
+		)
+
+		if err := service.Reverse(context.Background(), order.ID.Hex()); err != nil {
+			mt.Errorf("Reverse() error = %v", err)
+		}
+	})
+}
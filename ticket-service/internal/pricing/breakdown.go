@@ -0,0 +1,57 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/promo"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("fees are in a different currency than the tickets")
+	ErrMixedCurrencies  = errors.New("order items are priced in different currencies")
+)
+
+// Compute prices line with discount taken off. Fees are charged on the
+// discounted base and tax on the discounted base plus fees; percentages are
+// rounded half up to a whole minor unit. A nil schedule charges no fees and a
+// nil rate no tax.
+func Compute(line promo.Line, discount int64, schedule *model.FeeSchedule, rate *model.TaxRate) (*model.PriceBreakdown, error) {
+	breakdown := &model.PriceBreakdown{
+		Currency: line.Currency,
+		Base:     line.Amount(),
+		Discount: discount,
+	}
+	net := breakdown.Base - discount
+
+	if schedule != nil {
+		fixed := schedule.ServiceFeePerTicket > 0 || schedule.FacilityFeePerTicket > 0
+		if fixed && breakdown.Currency == "" {
+			// Free tickets have no currency of their own.
+			breakdown.Currency = schedule.Currency
+		}
+		if fixed && schedule.Currency != breakdown.Currency {
+			return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, schedule.Currency, breakdown.Currency)
+		}
+
+		quantity := int64(line.Quantity)
+		breakdown.ServiceFee = percentOf(net, schedule.ServiceFeeBps) + schedule.ServiceFeePerTicket*quantity
+		breakdown.FacilityFee = schedule.FacilityFeePerTicket * quantity
+	}
+
+	taxable := net + breakdown.ServiceFee + breakdown.FacilityFee
+	if rate != nil {
+		breakdown.Tax = percentOf(taxable, rate.RateBps)
+		breakdown.TaxJurisdiction = rate.Jurisdiction
+		breakdown.TaxRateBps = rate.RateBps
+	}
+
+	breakdown.Total = taxable + breakdown.Tax
+	return breakdown, nil
+}
+
+// percentOf returns bps basis points of amount, rounded half up.
+func percentOf(amount int64, bps int32) int64 {
+	return (amount*int64(bps) + 5000) / 10000
+}
@@ -0,0 +1,86 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/promo"
+)
+
+func TestCompute(t *testing.T) {
+	line := promo.Line{EventID: "event1", Quantity: 2, UnitPrice: 4999, Currency: "USD"}
+	schedule := &model.FeeSchedule{
+		EventID:              "event1",
+		Currency:             "USD",
+		ServiceFeeBps:        1000,
+		ServiceFeePerTicket:  50,
+		FacilityFeePerTicket: 200,
+		Jurisdiction:         "US-CA",
+	}
+	rate := &model.TaxRate{Jurisdiction: "US-CA", RateBps: 725}
+
+	tests := []struct {
+		name     string
+		discount int64
+		schedule *model.FeeSchedule
+		rate     *model.TaxRate
+		want     model.PriceBreakdown
+	}{
+		{
+			name: "no fees or tax",
+			want: model.PriceBreakdown{Currency: "USD", Base: 9998, Total: 9998},
+		},
+		{
+			name:     "fees and tax",
+			schedule: schedule,
+			rate:     rate,
+			// Service fee 999.8 rounds to 1000, plus 100 fixed; tax is 7.25%
+			// of 9998+1100+400 = 11498, 833.6 rounded to 834.
+			want: model.PriceBreakdown{
+				Currency: "USD", Base: 9998, ServiceFee: 1100, FacilityFee: 400, Tax: 834, Total: 12332,
+				TaxJurisdiction: "US-CA", TaxRateBps: 725,
+			},
+		},
+		{
+			name:     "fees on the discounted price",
+			discount: 1998,
+			schedule: schedule,
+			want: model.PriceBreakdown{
+				Currency: "USD", Base: 9998, Discount: 1998, ServiceFee: 900, FacilityFee: 400, Total: 9300,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compute(line, tt.discount, tt.schedule, tt.rate)
+			if err != nil {
+				t.Fatalf("Compute() error = %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("Compute() = %+v, want %+v", *got, tt.want)
+			}
+			if got.Total != got.Base-got.Discount+got.ServiceFee+got.FacilityFee+got.Tax {
+				t.Errorf("Compute() total %d does not add up", got.Total)
+			}
+		})
+	}
+}
+
+func TestCompute_Currency(t *testing.T) {
+	schedule := &model.FeeSchedule{Currency: "EUR", FacilityFeePerTicket: 100}
+
+	_, err := Compute(promo.Line{Quantity: 1, UnitPrice: 1000, Currency: "USD"}, 0, schedule, nil)
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Compute() error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+
+	free, err := Compute(promo.Line{Quantity: 2}, 0, schedule, nil)
+	if err != nil {
+		t.Fatalf("Compute() error = %v", err)
+	}
+	if free.Currency != "EUR" || free.Total != 200 {
+		t.Errorf("Compute() of free tickets = %d %s, want 200 EUR", free.Total, free.Currency)
+	}
+}
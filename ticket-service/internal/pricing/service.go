@@ -0,0 +1,120 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/promo"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+)
+
+// Quote is the price of an order before it is placed.
+type Quote struct {
+	Line      promo.Line
+	Discounts []promo.Discount
+	Breakdown *model.PriceBreakdown
+}
+
+// Service prices orders from the fee schedule of their event and the tax
+// rate of its jurisdiction.
+type Service struct {
+	repo   *repository.PricingRepository
+	promos *promo.Service
+}
+
+func NewService(repo *repository.PricingRepository, promos *promo.Service) *Service {
+	return &Service{
+		repo:   repo,
+		promos: promos,
+	}
+}
+
+// SetFeeSchedule replaces the fee schedule of an event. Its jurisdiction must
+// already have a tax rate.
+func (s *Service) SetFeeSchedule(ctx context.Context, schedule *model.FeeSchedule) error {
+	if schedule.Jurisdiction != "" {
+		if _, err := s.repo.GetTaxRate(ctx, schedule.Jurisdiction); err != nil {
+			return fmt.Errorf("%w: %s", err, schedule.Jurisdiction)
+		}
+	}
+	return s.repo.SaveFeeSchedule(ctx, schedule)
+}
+
+func (s *Service) GetFeeSchedule(ctx context.Context, eventID string) (*model.FeeSchedule, error) {
+	return s.repo.GetFeeSchedule(ctx, eventID)
+}
+
+func (s *Service) SetTaxRate(ctx context.Context, rate *model.TaxRate) error {
+	return s.repo.SaveTaxRate(ctx, rate)
+}
+
+func (s *Service) ListTaxRates(ctx context.Context) ([]*model.TaxRate, error) {
+	return s.repo.ListTaxRates(ctx)
+}
+
+// Quote prices line for userID with codes applied, at today's fees and tax.
+func (s *Service) Quote(ctx context.Context, line promo.Line, userID string, codes []string) (*Quote, error) {
+	discounts, err := s.promos.Quote(ctx, line, userID, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown, err := s.price(ctx, line, promo.Total(discounts))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Quote{
+		Line:      line,
+		Discounts: discounts,
+		Breakdown: breakdown,
+	}, nil
+}
+
+// PriceOrder adds fees and tax to each item of an order whose prices and
+// discounts are already set, and records the breakdown it will be charged.
+// One payment cannot cover items priced in different currencies.
+func (s *Service) PriceOrder(ctx context.Context, order *model.Order) error {
+	lines := promo.Lines(order)
+	breakdowns := make([]*model.PriceBreakdown, 0, len(lines))
+	currency := ""
+	for i, line := range lines {
+		breakdown, err := s.price(ctx, line, order.Items[i].Discount)
+		if err != nil {
+			return err
+		}
+
+		if currency == "" {
+			currency = breakdown.Currency
+		}
+		if breakdown.Currency != "" && breakdown.Currency != currency {
+			return fmt.Errorf("%w: %s and %s", ErrMixedCurrencies, currency, breakdown.Currency)
+		}
+		breakdowns = append(breakdowns, breakdown)
+	}
+
+	order.SetBreakdowns(breakdowns)
+	return nil
+}
+
+func (s *Service) price(ctx context.Context, line promo.Line, discount int64) (*model.PriceBreakdown, error) {
+	schedule, err := s.repo.GetFeeSchedule(ctx, line.EventID)
+	if errors.Is(err, repository.ErrFeeScheduleNotFound) {
+		return Compute(line, discount, nil, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee schedule: %w", err)
+	}
+
+	var rate *model.TaxRate
+	if schedule.Jurisdiction != "" {
+		rate, err = s.repo.GetTaxRate(ctx, schedule.Jurisdiction)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tax rate of %s: %w", schedule.Jurisdiction, err)
+		}
+	}
+
+	return Compute(line, discount, schedule, rate)
+}
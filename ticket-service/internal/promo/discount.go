@@ -0,0 +1,133 @@
+package promo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+)
+
+var (
+	ErrExpired       = errors.New("promo code has expired")
+	ErrNotApplicable = errors.New("promo code does not apply to this purchase")
+	ErrNotStackable  = errors.New("promo code cannot be combined with other codes")
+	ErrRepeated      = errors.New("promo code was given more than once")
+)
+
+// Line is quantity tickets of one tier of an event at UnitPrice, in minor
+// units of Currency.
+type Line struct {
+	EventID      string
+	TicketTypeID string
+	Quantity     int32
+	UnitPrice    int64
+	Currency     string
+}
+
+func (l Line) Amount() int64 {
+	return l.UnitPrice * int64(l.Quantity)
+}
+
+// Lines returns a line for each item of order, in item order.
+func Lines(order *model.Order) []Line {
+	lines := make([]Line, 0, len(order.Items))
+	for _, item := range order.Items {
+		lines = append(lines, Line{
+			EventID:      item.EventID,
+			TicketTypeID: item.TicketTypeID,
+			Quantity:     item.Quantity,
+			UnitPrice:    item.UnitPrice,
+			Currency:     item.Currency,
+		})
+	}
+	return lines
+}
+
+// Discount is what one promo code takes off a line.
+type Discount struct {
+	Code        string
+	Description string
+	Amount      int64
+}
+
+// Total sums the amounts of discounts.
+func Total(discounts []Discount) int64 {
+	var total int64
+	for _, discount := range discounts {
+		total += discount.Amount
+	}
+	return total
+}
+
+// Apply works out the discounts codes give on line at now. Codes are applied
+// in the order given, each to what the codes before it left, so the total
+// never goes below zero. Percentages are rounded down to a whole minor unit.
+// More than one code can only be used when all of them are stackable.
+func Apply(line Line, codes []*model.PromoCode, now time.Time) ([]Discount, error) {
+	discounts, err := ApplyOrder([]Line{line}, codes, now)
+	if err != nil {
+		return nil, err
+	}
+	return discounts[0], nil
+}
+
+// ApplyOrder is Apply for an order of several lines. Each code is applied to
+// every line it covers and must cover at least one. The discounts of each
+// line are returned in line order.
+func ApplyOrder(lines []Line, codes []*model.PromoCode, now time.Time) ([][]Discount, error) {
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		if seen[code.Code] {
+			return nil, fmt.Errorf("%w: %s", ErrRepeated, code.Code)
+		}
+		seen[code.Code] = true
+
+		if len(codes) > 1 && !code.Stackable {
+			return nil, fmt.Errorf("%w: %s", ErrNotStackable, code.Code)
+		}
+		if code.Expired(now) {
+			return nil, fmt.Errorf("%w: %s", ErrExpired, code.Code)
+		}
+		if !slices.ContainsFunc(lines, func(line Line) bool { return covers(code, line) }) {
+			return nil, fmt.Errorf("%w: %s", ErrNotApplicable, code.Code)
+		}
+	}
+
+	discounts := make([][]Discount, len(lines))
+	for i, line := range lines {
+		remaining := line.Amount()
+		discounts[i] = make([]Discount, 0, len(codes))
+		for _, code := range codes {
+			if !covers(code, line) {
+				continue
+			}
+
+			var amount int64
+			switch code.DiscountType {
+			case model.DiscountPercentage:
+				amount = remaining * int64(code.PercentOff) / 100
+			case model.DiscountFixed:
+				amount = min(code.AmountOff*int64(line.Quantity), remaining)
+			}
+			remaining -= amount
+
+			discounts[i] = append(discounts[i], Discount{
+				Code:        code.Code,
+				Description: code.Description,
+				Amount:      amount,
+			})
+		}
+	}
+	return discounts, nil
+}
+
+// covers reports whether code can be used on line. Fixed amounts only apply
+// to lines in their currency.
+func covers(code *model.PromoCode, line Line) bool {
+	if !code.AppliesTo(line.EventID, line.TicketTypeID) {
+		return false
+	}
+	return code.DiscountType != model.DiscountFixed || code.Currency == line.Currency
+}
@@ -0,0 +1,133 @@
+package promo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+)
+
+func TestApply(t *testing.T) {
+	now := time.Now()
+	line := Line{EventID: "event1", TicketTypeID: "vip", Quantity: 2, UnitPrice: 5000, Currency: "USD"}
+
+	percent := func(code string, off int32, stackable bool) *model.PromoCode {
+		return &model.PromoCode{Code: code, DiscountType: model.DiscountPercentage, PercentOff: off, Stackable: stackable}
+	}
+	fixed := func(code string, off int64, stackable bool) *model.PromoCode {
+		return &model.PromoCode{Code: code, DiscountType: model.DiscountFixed, AmountOff: off, Currency: "USD", Stackable: stackable}
+	}
+
+	tests := []struct {
+		name    string
+		codes   []*model.PromoCode
+		want    []int64
+		wantErr error
+	}{
+		{name: "no codes", want: []int64{}},
+		{name: "percentage", codes: []*model.PromoCode{percent("TEN", 10, false)}, want: []int64{1000}},
+		{name: "fixed per ticket", codes: []*model.PromoCode{fixed("FIVE", 500, false)}, want: []int64{1000}},
+		{name: "fixed capped at price", codes: []*model.PromoCode{fixed("HUGE", 10000, false)}, want: []int64{10000}},
+		{
+			name:  "percentage rounds down",
+			codes: []*model.PromoCode{fixed("CENT", 1, true), percent("FIFTEEN", 15, true)},
+			want:  []int64{2, 1499},
+		},
+		{
+			name:  "stacked in order",
+			codes: []*model.PromoCode{fixed("FIVE", 500, true), percent("HALF", 50, true)},
+			want:  []int64{1000, 4500},
+		},
+		{
+			name:    "not stackable",
+			codes:   []*model.PromoCode{percent("TEN", 10, false), percent("HALF", 50, true)},
+			wantErr: ErrNotStackable,
+		},
+		{
+			name:    "repeated",
+			codes:   []*model.PromoCode{percent("HALF", 50, true), percent("HALF", 50, true)},
+			wantErr: ErrRepeated,
+		},
+		{
+			name:    "expired",
+			codes:   []*model.PromoCode{{Code: "OLD", DiscountType: model.DiscountPercentage, PercentOff: 10, ExpiresAt: now.Add(-time.Minute)}},
+			wantErr: ErrExpired,
+		},
+		{
+			name:    "other event",
+			codes:   []*model.PromoCode{{Code: "OTHER", DiscountType: model.DiscountPercentage, PercentOff: 10, EventID: "event2"}},
+			wantErr: ErrNotApplicable,
+		},
+		{
+			name:    "other tier",
+			codes:   []*model.PromoCode{{Code: "GA", DiscountType: model.DiscountPercentage, PercentOff: 10, EventID: "event1", TicketTypeIDs: []string{"ga"}}},
+			wantErr: ErrNotApplicable,
+		},
+		{
+			name:    "other currency",
+			codes:   []*model.PromoCode{{Code: "EURO", DiscountType: model.DiscountFixed, AmountOff: 500, Currency: "EUR"}},
+			wantErr: ErrNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discounts, err := Apply(line, tt.codes, now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Apply() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+
+			if len(discounts) != len(tt.want) {
+				t.Fatalf("Apply() returned %d discounts, want %d", len(discounts), len(tt.want))
+			}
+			for i, discount := range discounts {
+				if discount.Amount != tt.want[i] {
+					t.Errorf("discount %s = %d, want %d", discount.Code, discount.Amount, tt.want[i])
+				}
+			}
+			if total := Total(discounts); total > line.Amount() {
+				t.Errorf("Total() = %d, more than the line's %d", total, line.Amount())
+			}
+		})
+	}
+}
+
+func TestApplyOrder(t *testing.T) {
+	now := time.Now()
+	lines := []Line{
+		{EventID: "event1", TicketTypeID: "vip", Quantity: 2, UnitPrice: 5000, Currency: "USD"},
+		{EventID: "event1", TicketTypeID: "ga", Quantity: 1, UnitPrice: 2000, Currency: "USD"},
+		{EventID: "event2", Quantity: 1, UnitPrice: 3000, Currency: "EUR"},
+	}
+	vip := &model.PromoCode{Code: "VIP", DiscountType: model.DiscountPercentage, PercentOff: 10, EventID: "event1", TicketTypeIDs: []string{"vip"}, Stackable: true}
+	dollars := &model.PromoCode{Code: "DOLLARS", DiscountType: model.DiscountFixed, AmountOff: 100, Currency: "USD", Stackable: true}
+
+	discounts, err := ApplyOrder(lines, []*model.PromoCode{vip, dollars}, now)
+	if err != nil {
+		t.Fatalf("ApplyOrder() error = %v", err)
+	}
+
+	want := [][]int64{{1000, 200}, {100}, {}}
+	for i, line := range discounts {
+		if len(line) != len(want[i]) {
+			t.Fatalf("line %d has %d discounts, want %d", i, len(line), len(want[i]))
+		}
+		for j, discount := range line {
+			if discount.Amount != want[i][j] {
+				t.Errorf("line %d discount %s = %d, want %d", i, discount.Code, discount.Amount, want[i][j])
+			}
+		}
+	}
+
+	other := &model.PromoCode{Code: "OTHER", DiscountType: model.DiscountPercentage, PercentOff: 10, EventID: "event3"}
+	if _, err := ApplyOrder(lines, []*model.PromoCode{other}, now); !errors.Is(err, ErrNotApplicable) {
+		t.Errorf("ApplyOrder() error = %v, want %v", err, ErrNotApplicable)
+	}
+}
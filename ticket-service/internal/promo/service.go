@@ -0,0 +1,147 @@
+package promo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+)
+
+// Service manages promo codes and redeems them for purchases. Redeem and
+// Release are safe to repeat, which the purchase saga relies on when it
+// resumes after a restart.
+type Service struct {
+	repo       *repository.PromoCodeRepository
+	transactor *repository.Transactor
+}
+
+func NewService(repo *repository.PromoCodeRepository, transactor *repository.Transactor) *Service {
+	return &Service{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, code *model.PromoCode) (*model.PromoCode, error) {
+	return s.repo.Create(ctx, code)
+}
+
+func (s *Service) Get(ctx context.Context, code string) (*model.PromoCode, error) {
+	return s.repo.Get(ctx, model.NormalizePromoCode(code))
+}
+
+func (s *Service) List(ctx context.Context, eventID, after string, limit int64) ([]*model.PromoCode, error) {
+	return s.repo.List(ctx, eventID, after, limit)
+}
+
+func (s *Service) Update(ctx context.Context, code *model.PromoCode) (*model.PromoCode, error) {
+	return s.repo.Update(ctx, code)
+}
+
+func (s *Service) Delete(ctx context.Context, code string) error {
+	return s.repo.Delete(ctx, model.NormalizePromoCode(code))
+}
+
+// Quote works out the discounts codes would give userID on line, including
+// whether either redemption cap has already been reached. The caps are only
+// enforced atomically when the codes are redeemed.
+func (s *Service) Quote(ctx context.Context, line Line, userID string, codes []string) ([]Discount, error) {
+	promoCodes, err := s.load(ctx, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, code := range promoCodes {
+		if code.MaxRedemptions > 0 && code.Redemptions >= code.MaxRedemptions {
+			return nil, fmt.Errorf("%w: %s", repository.ErrPromoCodeUsedUp, code.Code)
+		}
+		if code.MaxPerUser > 0 && userID != "" {
+			used, err := s.repo.UserRedemptions(ctx, code.Code, userID)
+			if err != nil {
+				return nil, err
+			}
+			if used >= code.MaxPerUser {
+				return nil, fmt.Errorf("%w: %s", repository.ErrPromoCodeUserLimit, code.Code)
+			}
+		}
+	}
+
+	return Apply(line, promoCodes, time.Now())
+}
+
+// Redeem applies the order's promo codes to the price of its items and
+// counts one use of each. Either every code is redeemed or none is.
+func (s *Service) Redeem(ctx context.Context, order *model.Order) error {
+	if len(order.PromoCodes) == 0 {
+		return nil
+	}
+
+	promoCodes, err := s.load(ctx, order.PromoCodes)
+	if err != nil {
+		return err
+	}
+
+	discounts, err := ApplyOrder(Lines(order), promoCodes, time.Now())
+	if err != nil {
+		return err
+	}
+
+	amounts := make(map[string]int64, len(promoCodes))
+	for _, line := range discounts {
+		for _, discount := range line {
+			amounts[discount.Code] += discount.Amount
+		}
+	}
+
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		for _, code := range promoCodes {
+			redemption := model.NewPromoRedemption(code.Code, order, amounts[code.Code])
+			if err := s.repo.Redeem(ctx, code, redemption); err != nil {
+				return fmt.Errorf("%w: %s", err, code.Code)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, line := range discounts {
+		order.Items[i].Discount = Total(line)
+	}
+	return nil
+}
+
+// Release gives back the uses of every code redeemed for an order.
+func (s *Service) Release(ctx context.Context, orderID string) error {
+	redemptions, err := s.repo.ListRedemptions(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if len(redemptions) == 0 {
+		return nil
+	}
+
+	return s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		for _, redemption := range redemptions {
+			if err := s.repo.Unredeem(ctx, redemption); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Service) load(ctx context.Context, codes []string) ([]*model.PromoCode, error) {
+	promoCodes := make([]*model.PromoCode, 0, len(codes))
+	for _, code := range codes {
+		promoCode, err := s.repo.Get(ctx, model.NormalizePromoCode(code))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, code)
+		}
+		promoCodes = append(promoCodes, promoCode)
+	}
+	return promoCodes, nil
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrCancellationJobNotFound = errors.New("cancellation job not found")
+	ErrEventCancelled          = errors.New("event was cancelled")
+)
+
+type CancellationJobRepository struct {
+	collection *mongo.Collection
+}
+
+func NewCancellationJobRepository(db *mongo.Database) *CancellationJobRepository {
+	collection := db.Collection("cancellation_jobs")
+
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "status", Value: 1},
+			{Key: "lease_until", Value: 1},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &CancellationJobRepository{collection: collection}
+}
+
+// Create stores a new job. An event only ever gets one job, so a job that
+// already exists for the event is left as it is and reported as not created.
+func (r *CancellationJobRepository) Create(ctx context.Context, job *model.CancellationJob) (bool, error) {
+	_, err := r.collection.InsertOne(ctx, job)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *CancellationJobRepository) Get(ctx context.Context, eventID string) (*model.CancellationJob, error) {
+	var job model.CancellationJob
+	err := r.collection.FindOne(ctx, bson.M{"_id": eventID}).Decode(&job)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrCancellationJobNotFound
+		}
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// CheckNotCancelled returns ErrEventCancelled if the event has a cancellation
+// job.
+func (r *CancellationJobRepository) CheckNotCancelled(ctx context.Context, eventID string) error {
+	err := r.collection.FindOne(ctx, bson.M{"_id": eventID}).Err()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return ErrEventCancelled
+}
+
+func (r *CancellationJobRepository) Save(ctx context.Context, job *model.CancellationJob) error {
+	job.UpdatedAt = time.Now()
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": job.EventID}, job)
+	return err
+}
+
+// ClaimStale leases a running job whose previous lease has run out, either
+// because it was just created or because the process working on it stopped.
+// It returns nil when there is none.
+func (r *CancellationJobRepository) ClaimStale(ctx context.Context, now time.Time, lease time.Duration) (*model.CancellationJob, error) {
+	filter := bson.M{
+		"status":      model.CancellationJobRunning,
+		"lease_until": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{"lease_until": now.Add(lease)},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "lease_until", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var job model.CancellationJob
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrCheckInNotFound     = errors.New("check-in not found")
+	ErrEntryPolicyNotFound = errors.New("entry policy not found")
+)
+
+// CheckInRepository stores every scan of a ticket code under its scan ID and
+// the entry policies of events.
+type CheckInRepository struct {
+	checkIns *mongo.Collection
+	policies *mongo.Collection
+}
+
+func NewCheckInRepository(db *mongo.Database) *CheckInRepository {
+	checkIns := db.Collection("check_ins")
+
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{{Key: "ticket_id", Value: 1}, {Key: "scanned_at", Value: 1}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := checkIns.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &CheckInRepository{
+		checkIns: checkIns,
+		policies: db.Collection("entry_policies"),
+	}
+}
+
+// Create records a scan. A scan ID that is already recorded fails with a
+// duplicate key error.
+func (r *CheckInRepository) Create(ctx context.Context, checkIn *model.CheckIn) error {
+	if _, err := r.checkIns.InsertOne(ctx, checkIn); err != nil {
+		return fmt.Errorf("failed to record check-in: %w", err)
+	}
+	return nil
+}
+
+func (r *CheckInRepository) Get(ctx context.Context, scanID string) (*model.CheckIn, error) {
+	var checkIn model.CheckIn
+	err := r.checkIns.FindOne(ctx, bson.M{"_id": scanID}).Decode(&checkIn)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrCheckInNotFound
+		}
+		return nil, err
+	}
+	return &checkIn, nil
+}
+
+// SaveEntryPolicy creates or replaces the entry policy of an event.
+func (r *CheckInRepository) SaveEntryPolicy(ctx context.Context, policy *model.EntryPolicy) error {
+	policy.UpdatedAt = time.Now()
+	_, err := r.policies.ReplaceOne(ctx, bson.M{"_id": policy.EventID}, policy,
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save entry policy: %w", err)
+	}
+	return nil
+}
+
+func (r *CheckInRepository) GetEntryPolicy(ctx context.Context, eventID string) (*model.EntryPolicy, error) {
+	var policy model.EntryPolicy
+	err := r.policies.FindOne(ctx, bson.M{"_id": eventID}).Decode(&policy)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrEntryPolicyNotFound
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrIdempotencyKeyExists = errors.New("idempotency key already used")
+
+type IdempotencyRepository struct {
+	collection *mongo.Collection
+}
+
+// NewIdempotencyRepository stores keys in the idempotency_keys collection.
+// Keys are unique per user and expire ttl after they were first seen.
+func NewIdempotencyRepository(db *mongo.Database, ttl time.Duration) *IdempotencyRepository {
+	collection := db.Collection("idempotency_keys")
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(ttl.Seconds())),
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &IdempotencyRepository{collection: collection}
+}
+
+// Begin claims the user's key for a request to method with the given hash,
+// holding it until now plus lease. A key whose request is still in flight
+// under an expired lease is taken over if method and hash match. Otherwise,
+// if the key was already claimed, it returns the existing record together
+// with ErrIdempotencyKeyExists.
+func (r *IdempotencyRepository) Begin(ctx context.Context, userID, key, method, requestHash string, now time.Time, lease time.Duration) (*model.IdempotencyRecord, error) {
+	record := &model.IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		Method:      method,
+		RequestHash: requestHash,
+		Token:       primitive.NewObjectID().Hex(),
+		LeaseUntil:  now.Add(lease),
+		CreatedAt:   now,
+	}
+
+	_, err := r.collection.InsertOne(ctx, record)
+	if err == nil {
+		return record, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"user_id":      userID,
+		"key":          key,
+		"method":       method,
+		"request_hash": requestHash,
+		"response":     bson.M{"$exists": false},
+		"lease_until":  bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{"token": record.Token, "lease_until": record.LeaseUntil},
+	}
+	var taken model.IdempotencyRecord
+	err = r.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&taken)
+	if err == nil {
+		return &taken, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	var existing model.IdempotencyRecord
+	if err := r.collection.FindOne(ctx, bson.M{"user_id": userID, "key": key}).Decode(&existing); err != nil {
+		return nil, err
+	}
+
+	return &existing, ErrIdempotencyKeyExists
+}
+
+// Renew extends the lease of a key still held by record's request.
+func (r *IdempotencyRepository) Renew(ctx context.Context, record *model.IdempotencyRecord, until time.Time) error {
+	_, err := r.collection.UpdateOne(ctx, claimedBy(record), bson.M{
+		"$set": bson.M{"lease_until": until},
+	})
+	return err
+}
+
+// Complete stores the response to return for repeated requests with the key
+// of record, unless another request has taken the key over.
+func (r *IdempotencyRepository) Complete(ctx context.Context, record *model.IdempotencyRecord, response []byte) error {
+	_, err := r.collection.UpdateOne(ctx, claimedBy(record), bson.M{
+		"$set":   bson.M{"response": response},
+		"$unset": bson.M{"token": "", "lease_until": ""},
+	})
+	return err
+}
+
+// Abandon releases the key of record after the original request failed so
+// that it can be retried.
+func (r *IdempotencyRepository) Abandon(ctx context.Context, record *model.IdempotencyRecord) error {
+	_, err := r.collection.DeleteOne(ctx, claimedBy(record))
+	return err
+}
+
+// claimedBy matches the key of record while record's request still holds it.
+func claimedBy(record *model.IdempotencyRecord) bson.M {
+	return bson.M{
+		"user_id":  record.UserID,
+		"key":      record.Key,
+		"token":    record.Token,
+		"response": bson.M{"$exists": false},
+	}
+}
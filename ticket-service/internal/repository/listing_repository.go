@@ -0,0 +1,307 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrListingNotFound      = errors.New("listing not found")
+	ErrListingUnavailable   = errors.New("listing is not available")
+	ErrResalePolicyNotFound = errors.New("resale policy not found")
+)
+
+// ListingFilter narrows List to listings matching every non-empty field.
+type ListingFilter struct {
+	EventID  string
+	SellerID string
+	Status   model.ListingStatus
+}
+
+// ListingRepository stores resale listings, the resale policies of events and
+// the payouts owed to sellers. A partial unique index allows one ACTIVE
+// listing per ticket.
+type ListingRepository struct {
+	listings *mongo.Collection
+	policies *mongo.Collection
+	payouts  *mongo.Collection
+}
+
+func NewListingRepository(db *mongo.Database) *ListingRepository {
+	listings := db.Collection("listings")
+	payouts := db.Collection("payouts")
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "ticket_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": model.ListingStatusActive}),
+		},
+		{Keys: bson.D{{Key: "event_id", Value: 1}, {Key: "status", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "seller_id", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "held_until", Value: 1}}, Options: options.Index().SetSparse(true)},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := listings.Indexes().CreateMany(ctx, indexModels); err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	payoutIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "listing_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := payouts.Indexes().CreateOne(ctx, payoutIndex); err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &ListingRepository{
+		listings: listings,
+		policies: db.Collection("resale_policies"),
+		payouts:  payouts,
+	}
+}
+
+// Create stores a listing. Listing a ticket that is already on sale fails
+// with a duplicate key error.
+func (r *ListingRepository) Create(ctx context.Context, listing *model.Listing) error {
+	_, err := r.listings.InsertOne(ctx, listing)
+	return err
+}
+
+func (r *ListingRepository) GetByID(ctx context.Context, id string) (*model.Listing, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid ID format")
+	}
+
+	var listing model.Listing
+	err = r.listings.FindOne(ctx, bson.M{"_id": objectID}).Decode(&listing)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrListingNotFound
+		}
+		return nil, err
+	}
+
+	return &listing, nil
+}
+
+// List returns up to limit listings matching filter in _id order, starting
+// after the listing with ID after. The second return value is the ID to
+// resume from, or primitive.NilObjectID when there are no more listings.
+func (r *ListingRepository) List(ctx context.Context, filter ListingFilter, after primitive.ObjectID, limit int64) ([]*model.Listing, primitive.ObjectID, error) {
+	query := bson.M{}
+	if filter.EventID != "" {
+		query["event_id"] = filter.EventID
+	}
+	if filter.SellerID != "" {
+		query["seller_id"] = filter.SellerID
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if !after.IsZero() {
+		query["_id"] = bson.M{"$gt": after}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit + 1)
+
+	cursor, err := r.listings.Find(ctx, query, opts)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+	defer cursor.Close(ctx)
+
+	var listings []*model.Listing
+	if err := cursor.All(ctx, &listings); err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+
+	if int64(len(listings)) <= limit {
+		return listings, primitive.NilObjectID, nil
+	}
+
+	listings = listings[:limit]
+	return listings, listings[limit-1].ID, nil
+}
+
+// Hold reserves an ACTIVE listing for buyerID while they pay through orderID,
+// until heldUntil. A listing that is no longer on sale or is already being
+// bought fails with ErrListingUnavailable.
+func (r *ListingRepository) Hold(ctx context.Context, id primitive.ObjectID, buyerID, orderID string, heldUntil time.Time) (*model.Listing, error) {
+	filter := bson.M{
+		"_id":      id,
+		"status":   model.ListingStatusActive,
+		"order_id": bson.M{"$exists": false},
+	}
+	update := bson.M{"$set": bson.M{
+		"buyer_id":   buyerID,
+		"order_id":   orderID,
+		"held_until": heldUntil,
+		"updated_at": time.Now(),
+	}}
+	return r.findOneAndUpdate(ctx, filter, update, "cannot hold it")
+}
+
+// Release lifts the hold orderID has on a listing, which goes back on sale if
+// it is still ACTIVE. Releasing a hold that is gone does nothing.
+func (r *ListingRepository) Release(ctx context.Context, id primitive.ObjectID, orderID string) error {
+	_, err := r.listings.UpdateOne(ctx, bson.M{"_id": id, "order_id": orderID}, bson.M{
+		"$set":   bson.M{"updated_at": time.Now()},
+		"$unset": bson.M{"buyer_id": "", "order_id": "", "held_until": ""},
+	})
+	return err
+}
+
+// Sell marks an ACTIVE listing held by orderID as SOLD, owing its seller
+// payout. A listing that was delisted while being paid for fails with
+// ErrListingUnavailable.
+func (r *ListingRepository) Sell(ctx context.Context, id primitive.ObjectID, orderID string, payout int64, at time.Time) (*model.Listing, error) {
+	filter := bson.M{
+		"_id":      id,
+		"status":   model.ListingStatusActive,
+		"order_id": orderID,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":        model.ListingStatusSold,
+			"seller_payout": payout,
+			"sold_at":       at,
+			"updated_at":    at,
+		},
+		"$unset": bson.M{"held_until": ""},
+	}
+	return r.findOneAndUpdate(ctx, filter, update, "cannot sell it")
+}
+
+// Withdraw takes an ACTIVE listing of sellerID off sale. A listing someone is
+// paying for cannot be withdrawn.
+func (r *ListingRepository) Withdraw(ctx context.Context, id primitive.ObjectID, sellerID string) (*model.Listing, error) {
+	filter := bson.M{
+		"_id":       id,
+		"seller_id": sellerID,
+		"status":    model.ListingStatusActive,
+		"order_id":  bson.M{"$exists": false},
+	}
+	update := bson.M{"$set": bson.M{
+		"status":     model.ListingStatusWithdrawn,
+		"updated_at": time.Now(),
+	}}
+	return r.findOneAndUpdate(ctx, filter, update, "cannot withdraw it")
+}
+
+// DelistTicket takes the ACTIVE listing of a ticket, if it has one, off sale
+// for reason. A buyer paying for it fails to complete the purchase and is
+// paid back.
+func (r *ListingRepository) DelistTicket(ctx context.Context, ticketID, reason string) error {
+	_, err := r.listings.UpdateMany(ctx, bson.M{
+		"ticket_id": ticketID,
+		"status":    model.ListingStatusActive,
+	}, delist(reason))
+	if err != nil {
+		return fmt.Errorf("failed to delist ticket: %w", err)
+	}
+	return nil
+}
+
+// DelistEvent takes every ACTIVE listing of an event off sale for reason and
+// returns how many there were.
+func (r *ListingRepository) DelistEvent(ctx context.Context, eventID, reason string) (int64, error) {
+	res, err := r.listings.UpdateMany(ctx, bson.M{
+		"event_id": eventID,
+		"status":   model.ListingStatusActive,
+	}, delist(reason))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delist event: %w", err)
+	}
+	return res.ModifiedCount, nil
+}
+
+func delist(reason string) bson.M {
+	return bson.M{"$set": bson.M{
+		"status":     model.ListingStatusDelisted,
+		"reason":     reason,
+		"updated_at": time.Now(),
+	}}
+}
+
+// ListStaleHolds returns up to limit listings whose hold ran out at or before
+// now without the sale completing.
+func (r *ListingRepository) ListStaleHolds(ctx context.Context, now time.Time, limit int64) ([]*model.Listing, error) {
+	opts := options.Find().SetLimit(limit)
+	cursor, err := r.listings.Find(ctx, bson.M{
+		"held_until": bson.M{"$lte": now},
+		"status":     bson.M{"$ne": model.ListingStatusSold},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var listings []*model.Listing
+	if err := cursor.All(ctx, &listings); err != nil {
+		return nil, err
+	}
+	return listings, nil
+}
+
+// SavePolicy creates or replaces the resale policy of an event.
+func (r *ListingRepository) SavePolicy(ctx context.Context, policy *model.ResalePolicy) error {
+	policy.UpdatedAt = time.Now()
+	_, err := r.policies.ReplaceOne(ctx, bson.M{"_id": policy.EventID}, policy,
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save resale policy: %w", err)
+	}
+	return nil
+}
+
+func (r *ListingRepository) GetPolicy(ctx context.Context, eventID string) (*model.ResalePolicy, error) {
+	var policy model.ResalePolicy
+	err := r.policies.FindOne(ctx, bson.M{"_id": eventID}).Decode(&policy)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrResalePolicyNotFound
+		}
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// CreatePayout records what a seller is owed. There is one payout per
+// listing.
+func (r *ListingRepository) CreatePayout(ctx context.Context, payout *model.Payout) error {
+	if _, err := r.payouts.InsertOne(ctx, payout); err != nil {
+		return fmt.Errorf("failed to record payout: %w", err)
+	}
+	return nil
+}
+
+func (r *ListingRepository) findOneAndUpdate(ctx context.Context, filter, update bson.M, action string) (*model.Listing, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var listing model.Listing
+
+	err := r.listings.FindOneAndUpdate(ctx, filter, update, opts).Decode(&listing)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrListingUnavailable, action)
+		}
+		return nil, err
+	}
+	return &listing, nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// OffsetRepository remembers the last message sequence each consumer has
+// processed on a subject.
+type OffsetRepository struct {
+	collection *mongo.Collection
+}
+
+func NewOffsetRepository(db *mongo.Database) *OffsetRepository {
+	return &OffsetRepository{collection: db.Collection("consumer_offsets")}
+}
+
+type consumerOffset struct {
+	LastSequence int64 `bson:"last_sequence"`
+}
+
+func (r *OffsetRepository) GetOffset(ctx context.Context, consumer, subject string) (uint64, error) {
+	var offset consumerOffset
+	err := r.collection.FindOne(ctx, bson.M{"_id": consumer + "/" + subject}).Decode(&offset)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return uint64(offset.LastSequence), nil
+}
+
+// CommitOffset records sequence unless a later one is already stored.
+func (r *OffsetRepository) CommitOffset(ctx context.Context, consumer, subject string, sequence uint64) error {
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": consumer + "/" + subject},
+		bson.M{"$max": bson.M{"last_sequence": int64(sequence)}},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
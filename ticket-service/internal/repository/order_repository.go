@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrOrderNotFound = errors.New("order not found")
+
+// OrderFilter narrows List to orders matching every non-empty field.
+type OrderFilter struct {
+	UserID string
+	Status model.OrderStatus
+}
+
+type OrderRepository struct {
+	collection *mongo.Collection
+}
+
+func NewOrderRepository(db *mongo.Database) *OrderRepository {
+	collection := db.Collection("orders")
+
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "_id", Value: 1},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &OrderRepository{collection: collection}
+}
+
+func (r *OrderRepository) Create(ctx context.Context, order *model.Order) error {
+	_, err := r.collection.InsertOne(ctx, order)
+	return err
+}
+
+func (r *OrderRepository) GetByID(ctx context.Context, id string) (*model.Order, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid ID format")
+	}
+
+	var order model.Order
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&order)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+// List returns up to limit orders matching filter in _id order, starting
+// after the order with ID after. Pass primitive.NilObjectID to start from the
+// beginning. The second return value is the ID to resume from, or
+// primitive.NilObjectID when there are no more orders.
+func (r *OrderRepository) List(ctx context.Context, filter OrderFilter, after primitive.ObjectID, limit int64) ([]*model.Order, primitive.ObjectID, error) {
+	query := bson.M{}
+	if filter.UserID != "" {
+		query["user_id"] = filter.UserID
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if !after.IsZero() {
+		query["_id"] = bson.M{"$gt": after}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit + 1)
+
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+	defer cursor.Close(ctx)
+
+	var orders []*model.Order
+	if err := cursor.All(ctx, &orders); err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+
+	if int64(len(orders)) <= limit {
+		return orders, primitive.NilObjectID, nil
+	}
+
+	orders = orders[:limit]
+	return orders, orders[limit-1].ID, nil
+}
+
+// Confirm marks a PENDING order paid through paymentReference.
+func (r *OrderRepository) Confirm(ctx context.Context, id primitive.ObjectID, paymentReference string) error {
+	return r.setStatus(ctx, id, model.OrderStatusConfirmed, bson.M{"payment_reference": paymentReference})
+}
+
+// Fail marks a PENDING order as failed for reason.
+func (r *OrderRepository) Fail(ctx context.Context, id primitive.ObjectID, reason string) error {
+	return r.setStatus(ctx, id, model.OrderStatusFailed, bson.M{"failure_reason": reason})
+}
+
+func (r *OrderRepository) setStatus(ctx context.Context, id primitive.ObjectID, status model.OrderStatus, set bson.M) error {
+	set["status"] = status
+	set["updated_at"] = time.Now()
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{
+		"_id":    id,
+		"status": model.OrderStatusPending,
+	}, bson.M{"$set": set})
+	return err
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/pkg/bus"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type OutboxRepository struct {
+	collection *mongo.Collection
+}
+
+func NewOutboxRepository(db *mongo.Database) *OutboxRepository {
+	collection := db.Collection("outbox")
+
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "published_at", Value: 1},
+			{Key: "next_attempt_at", Value: 1},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &OutboxRepository{collection: collection}
+}
+
+// Add records event together with the trace context of ctx, so that the
+// published event joins the trace of the request that caused it. Call it with
+// a transaction context so the event is only stored if the state change it
+// describes is committed.
+func (r *OutboxRepository) Add(ctx context.Context, event *model.OutboxEvent) error {
+	if event.TraceContext == nil {
+		event.TraceContext = bus.TraceContext(ctx)
+	}
+	_, err := r.collection.InsertOne(ctx, event)
+	return err
+}
+
+// ClaimNext leases the oldest unpublished event that is due for an attempt,
+// hiding it from other relays until lease has passed. It returns nil when no
+// event is due.
+func (r *OutboxRepository) ClaimNext(ctx context.Context, now time.Time, lease time.Duration) (*model.OutboxEvent, error) {
+	filter := bson.M{
+		"published_at":    bson.M{"$exists": false},
+		"next_attempt_at": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{"next_attempt_at": now.Add(lease)},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var event model.OutboxEvent
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&event)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id primitive.ObjectID, now time.Time) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set":   bson.M{"published_at": now},
+		"$unset": bson.M{"last_error": ""},
+	})
+	return err
+}
+
+// MarkFailed schedules the next attempt for an event that could not be
+// published.
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, nextAttemptAt time.Time, publishErr error) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      publishErr.Error(),
+		},
+	})
+	return err
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrPaymentNotFound = errors.New("payment not found")
+
+type PaymentRepository struct {
+	collection *mongo.Collection
+}
+
+func NewPaymentRepository(db *mongo.Database) *PaymentRepository {
+	collection := db.Collection("payments")
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "order_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "authorization_id", Value: 1}},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &PaymentRepository{collection: collection}
+}
+
+// Save inserts or replaces a payment.
+func (r *PaymentRepository) Save(ctx context.Context, payment *model.Payment) error {
+	payment.UpdatedAt = time.Now()
+	_, err := r.collection.ReplaceOne(
+		ctx,
+		bson.M{"_id": payment.ID},
+		payment,
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+// GetByOrderID returns the payment of an order.
+func (r *PaymentRepository) GetByOrderID(ctx context.Context, orderID string) (*model.Payment, error) {
+	return r.findOne(ctx, bson.M{"order_id": orderID})
+}
+
+func (r *PaymentRepository) GetByAuthorizationID(ctx context.Context, authorizationID string) (*model.Payment, error) {
+	return r.findOne(ctx, bson.M{"authorization_id": authorizationID})
+}
+
+func (r *PaymentRepository) findOne(ctx context.Context, filter bson.M) (*model.Payment, error) {
+	var payment model.Payment
+	err := r.collection.FindOne(ctx, filter).Decode(&payment)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	return &payment, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrFeeScheduleNotFound = errors.New("fee schedule not found")
+	ErrTaxRateNotFound     = errors.New("tax rate not found")
+)
+
+// PricingRepository stores the fee schedules of events and the tax rates of
+// jurisdictions.
+type PricingRepository struct {
+	feeSchedules *mongo.Collection
+	taxRates     *mongo.Collection
+}
+
+func NewPricingRepository(db *mongo.Database) *PricingRepository {
+	return &PricingRepository{
+		feeSchedules: db.Collection("fee_schedules"),
+		taxRates:     db.Collection("tax_rates"),
+	}
+}
+
+// SaveFeeSchedule creates or replaces the fee schedule of an event.
+func (r *PricingRepository) SaveFeeSchedule(ctx context.Context, schedule *model.FeeSchedule) error {
+	schedule.UpdatedAt = time.Now()
+	_, err := r.feeSchedules.ReplaceOne(ctx, bson.M{"_id": schedule.EventID}, schedule,
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save fee schedule: %w", err)
+	}
+	return nil
+}
+
+func (r *PricingRepository) GetFeeSchedule(ctx context.Context, eventID string) (*model.FeeSchedule, error) {
+	var schedule model.FeeSchedule
+	err := r.feeSchedules.FindOne(ctx, bson.M{"_id": eventID}).Decode(&schedule)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrFeeScheduleNotFound
+		}
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// SaveTaxRate creates or replaces the tax rate of a jurisdiction.
+func (r *PricingRepository) SaveTaxRate(ctx context.Context, rate *model.TaxRate) error {
+	rate.UpdatedAt = time.Now()
+	_, err := r.taxRates.ReplaceOne(ctx, bson.M{"_id": rate.Jurisdiction}, rate,
+		options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to save tax rate: %w", err)
+	}
+	return nil
+}
+
+func (r *PricingRepository) GetTaxRate(ctx context.Context, jurisdiction string) (*model.TaxRate, error) {
+	var rate model.TaxRate
+	err := r.taxRates.FindOne(ctx, bson.M{"_id": jurisdiction}).Decode(&rate)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrTaxRateNotFound
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+func (r *PricingRepository) ListTaxRates(ctx context.Context) ([]*model.TaxRate, error) {
+	cursor, err := r.taxRates.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rates []*model.TaxRate
+	if err := cursor.All(ctx, &rates); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrPromoCodeNotFound  = errors.New("promo code not found")
+	ErrPromoCodeExists    = errors.New("promo code already exists")
+	ErrPromoCodeUsedUp    = errors.New("promo code has no redemptions left")
+	ErrPromoCodeUserLimit = errors.New("promo code has been used as often as one user may")
+)
+
+// PromoCodeRepository stores promo codes and counts their redemptions. The
+// total count lives on the code; a user's count lives in promo_usage so that
+// both caps can be checked and bumped atomically.
+type PromoCodeRepository struct {
+	codes       *mongo.Collection
+	usage       *mongo.Collection
+	redemptions *mongo.Collection
+}
+
+type promoUsage struct {
+	Count int64 `bson:"count"`
+}
+
+func NewPromoCodeRepository(db *mongo.Database) *PromoCodeRepository {
+	codes := db.Collection("promo_codes")
+	redemptions := db.Collection("promo_redemptions")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := codes.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "event_id", Value: 1}},
+	})
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	_, err = redemptions.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "order_id", Value: 1}},
+	})
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &PromoCodeRepository{
+		codes:       codes,
+		usage:       db.Collection("promo_usage"),
+		redemptions: redemptions,
+	}
+}
+
+func (r *PromoCodeRepository) Create(ctx context.Context, code *model.PromoCode) (*model.PromoCode, error) {
+	now := time.Now()
+	code.Redemptions = 0
+	code.CreatedAt = now
+	code.UpdatedAt = now
+
+	_, err := r.codes.InsertOne(ctx, code)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrPromoCodeExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create promo code: %w", err)
+	}
+	return code, nil
+}
+
+func (r *PromoCodeRepository) Get(ctx context.Context, code string) (*model.PromoCode, error) {
+	var promoCode model.PromoCode
+	err := r.codes.FindOne(ctx, bson.M{"_id": code}).Decode(&promoCode)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPromoCodeNotFound
+		}
+		return nil, err
+	}
+	return &promoCode, nil
+}
+
+// List returns up to limit codes in code order, starting after the code
+// after, optionally only those of one event.
+func (r *PromoCodeRepository) List(ctx context.Context, eventID, after string, limit int64) ([]*model.PromoCode, error) {
+	filter := bson.M{"_id": bson.M{"$gt": after}}
+	if eventID != "" {
+		filter["event_id"] = eventID
+	}
+
+	cursor, err := r.codes.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var codes []*model.PromoCode
+	if err := cursor.All(ctx, &codes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// Update replaces the settings of a code and keeps its redemption count.
+func (r *PromoCodeRepository) Update(ctx context.Context, code *model.PromoCode) (*model.PromoCode, error) {
+	update := bson.M{
+		"$set": bson.M{
+			"description":     code.Description,
+			"discount_type":   code.DiscountType,
+			"percent_off":     code.PercentOff,
+			"amount_off":      code.AmountOff,
+			"currency":        code.Currency,
+			"event_id":        code.EventID,
+			"ticket_type_ids": code.TicketTypeIDs,
+			"stackable":       code.Stackable,
+			"max_redemptions": code.MaxRedemptions,
+			"max_per_user":    code.MaxPerUser,
+			"expires_at":      code.ExpiresAt,
+			"updated_at":      time.Now(),
+		},
+	}
+
+	var updated model.PromoCode
+	err := r.codes.FindOneAndUpdate(ctx, bson.M{"_id": code.Code}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPromoCodeNotFound
+		}
+		return nil, fmt.Errorf("failed to update promo code: %w", err)
+	}
+	return &updated, nil
+}
+
+func (r *PromoCodeRepository) Delete(ctx context.Context, code string) error {
+	result, err := r.codes.DeleteOne(ctx, bson.M{"_id": code})
+	if err != nil {
+		return fmt.Errorf("failed to delete promo code: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrPromoCodeNotFound
+	}
+	return nil
+}
+
+// UserRedemptions returns how often userID has redeemed code.
+func (r *PromoCodeRepository) UserRedemptions(ctx context.Context, code, userID string) (int64, error) {
+	var usage promoUsage
+	err := r.usage.FindOne(ctx, bson.M{"_id": usageID(code, userID)}).Decode(&usage)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return usage.Count, nil
+}
+
+// Redeem counts a use of code against both of its caps and records the
+// redemption. It must run inside a transaction so that a cap that is reached
+// undoes the other count. A redemption already recorded is left alone.
+func (r *PromoCodeRepository) Redeem(ctx context.Context, code *model.PromoCode, redemption *model.PromoRedemption) error {
+	err := r.redemptions.FindOne(ctx, bson.M{"_id": redemption.ID}).Err()
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return fmt.Errorf("failed to get promo redemption: %w", err)
+	}
+
+	// The cap is compared with the stored one so a code edited meanwhile is
+	// still honoured.
+	result, err := r.codes.UpdateOne(ctx,
+		bson.M{
+			"_id": code.Code,
+			"$or": bson.A{
+				bson.M{"max_redemptions": 0},
+				bson.M{"$expr": bson.M{"$lt": bson.A{"$redemptions", "$max_redemptions"}}},
+			},
+		},
+		bson.M{"$inc": bson.M{"redemptions": 1}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to count promo redemption: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrPromoCodeUsedUp
+	}
+
+	// With a per-user cap the upsert only matches a count below it; a user at
+	// the cap makes it insert a duplicate instead.
+	filter := bson.M{"_id": usageID(code.Code, redemption.UserID)}
+	if code.MaxPerUser > 0 {
+		filter["count"] = bson.M{"$lt": code.MaxPerUser}
+	}
+	_, err = r.usage.UpdateOne(ctx, filter,
+		bson.M{"$inc": bson.M{"count": 1}},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		return ErrPromoCodeUserLimit
+	}
+	if err != nil {
+		return fmt.Errorf("failed to count promo redemption: %w", err)
+	}
+
+	if _, err := r.redemptions.InsertOne(ctx, redemption); err != nil {
+		return fmt.Errorf("failed to record promo redemption: %w", err)
+	}
+	return nil
+}
+
+// ListRedemptions returns the promo redemptions made for an order.
+func (r *PromoCodeRepository) ListRedemptions(ctx context.Context, orderID string) ([]*model.PromoRedemption, error) {
+	cursor, err := r.redemptions.Find(ctx, bson.M{"order_id": orderID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var redemptions []*model.PromoRedemption
+	if err := cursor.All(ctx, &redemptions); err != nil {
+		return nil, err
+	}
+	return redemptions, nil
+}
+
+// Unredeem takes back a redemption and gives its use back to both caps. It
+// must run inside a transaction, and does nothing if the redemption is gone.
+func (r *PromoCodeRepository) Unredeem(ctx context.Context, redemption *model.PromoRedemption) error {
+	result, err := r.redemptions.DeleteOne(ctx, bson.M{"_id": redemption.ID})
+	if err != nil {
+		return fmt.Errorf("failed to delete promo redemption: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return nil
+	}
+
+	if _, err := r.codes.UpdateOne(ctx,
+		bson.M{"_id": redemption.Code},
+		bson.M{"$inc": bson.M{"redemptions": -1}},
+	); err != nil {
+		return fmt.Errorf("failed to return promo redemption: %w", err)
+	}
+
+	if _, err := r.usage.UpdateOne(ctx,
+		bson.M{"_id": usageID(redemption.Code, redemption.UserID)},
+		bson.M{"$inc": bson.M{"count": -1}},
+	); err != nil {
+		return fmt.Errorf("failed to return promo redemption: %w", err)
+	}
+	return nil
+}
+
+func usageID(code, userID string) string {
+	return code + "/" + userID
+}
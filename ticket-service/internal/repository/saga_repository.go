@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrSagaLeaseLost is returned when saving a saga whose lease another run has
+// taken over since it was loaded.
+var ErrSagaLeaseLost = errors.New("purchase saga lease was taken over")
+
+type SagaRepository struct {
+	collection *mongo.Collection
+}
+
+func NewSagaRepository(db *mongo.Database) *SagaRepository {
+	collection := db.Collection("purchase_sagas")
+
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "status", Value: 1},
+			{Key: "lease_until", Value: 1},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &SagaRepository{collection: collection}
+}
+
+func (r *SagaRepository) Create(ctx context.Context, saga *model.PurchaseSaga) error {
+	_, err := r.collection.InsertOne(ctx, saga)
+	return err
+}
+
+// Save replaces a saga with its new progress, provided it is still leased to
+// the same run and nothing else saved it since it was loaded. Otherwise it
+// fails with ErrSagaLeaseLost and the saga is left as stored.
+func (r *SagaRepository) Save(ctx context.Context, saga *model.PurchaseSaga) error {
+	version := saga.Version
+	saga.Version++
+	saga.UpdatedAt = time.Now()
+
+	res, err := r.collection.ReplaceOne(ctx, bson.M{
+		"_id":         saga.ID,
+		"lease_owner": saga.LeaseOwner,
+		"version":     version,
+	}, saga)
+	if err == nil && res.MatchedCount == 0 {
+		err = ErrSagaLeaseLost
+	}
+	if err != nil {
+		saga.Version = version
+		return err
+	}
+	return nil
+}
+
+// ClaimStale leases an unfinished saga whose previous lease has run out,
+// which means the process driving it stopped or gave up. The lease goes to a
+// new owner, so the previous one can no longer save the saga. It returns nil
+// when there is none.
+func (r *SagaRepository) ClaimStale(ctx context.Context, now time.Time, lease time.Duration) (*model.PurchaseSaga, error) {
+	filter := bson.M{
+		"status": bson.M{"$in": []model.SagaStatus{
+			model.SagaStatusRunning,
+			model.SagaStatusCompensating,
+		}},
+		"lease_until": bson.M{"$lte": now},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"lease_until": now.Add(lease),
+			"lease_owner": primitive.NewObjectID().Hex(),
+		},
+		"$inc": bson.M{"version": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "lease_until", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var saga model.PurchaseSaga
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&saga)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &saga, nil
+}
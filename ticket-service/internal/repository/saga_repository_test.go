@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestSagaRepository_Save(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	tests := []struct {
+		name        string
+		matched     int
+		wantVersion int64
+		wantErr     error
+	}{
+		{name: "lease held", matched: 1, wantVersion: 4},
+		{name: "lease taken over", matched: 0, wantVersion: 3, wantErr: ErrSagaLeaseLost},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			repo := NewSagaRepository(mt.DB)
+			order := model.NewOrder("alice", []model.OrderItem{{EventID: "event1", Quantity: 1}}, nil)
+			saga := model.NewPurchaseSaga(order, "pm_card", time.Minute)
+			saga.Version = 3
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: tt.matched}, bson.E{Key: "nModified", Value: tt.matched}))
+
+			err := repo.Save(context.Background(), saga)
+			if !errors.Is(err, tt.wantErr) {
+				mt.Fatalf("Save() error = %v, want %v", err, tt.wantErr)
+			}
+			if saga.Version != tt.wantVersion {
+				mt.Errorf("Save() version = %d, want %d", saga.Version, tt.wantVersion)
+			}
+
+			// Only the saga as loaded by this run is replaced.
+			filter := startedCommand(mt, "update").Lookup("updates").Array().Index(0).Value().Document().Lookup("q").Document()
+			if filter.Lookup("lease_owner").StringValue() != saga.LeaseOwner || filter.Lookup("version").AsInt64() != 3 {
+				mt.Errorf("Save() filter = %v, want lease owner %s at version 3", filter, saga.LeaseOwner)
+			}
+		})
+	}
+}
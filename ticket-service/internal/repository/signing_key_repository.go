@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrSigningKeyNotFound = errors.New("signing key not found")
+	ErrSigningKeyActive   = errors.New("the active signing key cannot be revoked; rotate it first")
+)
+
+// SigningKeyRepository stores the keys ticket tokens are signed with. A
+// partial unique index allows at most one ACTIVE key.
+type SigningKeyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewSigningKeyRepository(db *mongo.Database) *SigningKeyRepository {
+	collection := db.Collection("signing_keys")
+
+	indexModel := mongo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}},
+		Options: options.Index().
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": model.SigningKeyActive}),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &SigningKeyRepository{collection: collection}
+}
+
+// Active returns the key new tokens are signed with.
+func (r *SigningKeyRepository) Active(ctx context.Context) (*model.SigningKey, error) {
+	return r.findOne(ctx, bson.M{"status": model.SigningKeyActive})
+}
+
+func (r *SigningKeyRepository) Get(ctx context.Context, id string) (*model.SigningKey, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// ListTrusted returns the active and retired keys, newest first.
+func (r *SigningKeyRepository) ListTrusted(ctx context.Context) ([]*model.SigningKey, error) {
+	cursor, err := r.collection.Find(ctx,
+		bson.M{"status": bson.M{"$in": []model.SigningKeyStatus{model.SigningKeyActive, model.SigningKeyRetired}}},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var keys []*model.SigningKey
+	if err := cursor.All(ctx, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Rotate retires the active key, if there is one, and makes key the active
+// one. It must run inside a transaction so that there is always exactly one
+// active key.
+func (r *SigningKeyRepository) Rotate(ctx context.Context, key *model.SigningKey) error {
+	now := time.Now()
+	if _, err := r.collection.UpdateMany(ctx,
+		bson.M{"status": model.SigningKeyActive},
+		bson.M{"$set": bson.M{"status": model.SigningKeyRetired, "retired_at": now}},
+	); err != nil {
+		return fmt.Errorf("failed to retire signing key: %w", err)
+	}
+
+	key.Status = model.SigningKeyActive
+	if _, err := r.collection.InsertOne(ctx, key); err != nil {
+		return fmt.Errorf("failed to add signing key: %w", err)
+	}
+	return nil
+}
+
+// Revoke stops a retired key from verifying tokens.
+func (r *SigningKeyRepository) Revoke(ctx context.Context, id string) (*model.SigningKey, error) {
+	var key model.SigningKey
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "status": bson.M{"$ne": model.SigningKeyActive}},
+		bson.M{"$set": bson.M{"status": model.SigningKeyRevoked}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&key)
+	if err == nil {
+		return &key, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("failed to revoke signing key: %w", err)
+	}
+
+	if _, err := r.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return nil, ErrSigningKeyActive
+}
+
+func (r *SigningKeyRepository) findOne(ctx context.Context, filter bson.M) (*model.SigningKey, error) {
+	var key model.SigningKey
+	err := r.collection.FindOne(ctx, filter).Decode(&key)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrSigningKeyNotFound
+		}
+		return nil, err
+	}
+	return &key, nil
+}
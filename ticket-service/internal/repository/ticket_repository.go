@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,14 +14,27 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+var (
+	ErrTicketNotFound = errors.New("ticket not found")
+	ErrHoldExpired    = errors.New("ticket hold has expired")
+	ErrInvalidStatus  = errors.New("ticket is not in a valid status for this operation")
+)
+
 type CollectionInterface interface {
 	InsertOne(ctx context.Context, document interface{}) (*mongo.InsertOneResult, error)
 	FindOne(ctx context.Context, filter interface{}) *mongo.SingleResult
-	Find(ctx context.Context, filter interface{}) (*mongo.Cursor, error)
+	Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*mongo.Cursor, error)
 	FindOneAndUpdate(ctx context.Context, filter interface{}, update interface{}, opts ...*options.FindOneAndUpdateOptions) *mongo.SingleResult
 	Indexes() mongo.IndexView
 }
 
+// TicketFilter narrows List to tickets matching every non-empty field.
+type TicketFilter struct {
+	UserID  string
+	EventID string
+	Status  model.TicketStatus
+}
+
 type collectionWrapper struct {
 	*mongo.Collection
 }
@@ -38,24 +52,42 @@ func (w *collectionWrapper) FindOne(ctx context.Context, filter interface{}) *mo
 	return w.Collection.FindOne(ctx, filter)
 }
 
-func (w *collectionWrapper) Find(ctx context.Context, filter interface{}) (*mongo.Cursor, error) {
-	return w.Collection.Find(ctx, filter)
+func (w *collectionWrapper) Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*mongo.Cursor, error) {
+	return w.Collection.Find(ctx, filter, opts...)
 }
 
 func NewTicketRepository(db *mongo.Database) *TicketRepository {
 	collection := db.Collection("tickets")
 
-	indexModel := mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "user_id", Value: 1},
-			{Key: "event_id", Value: 1},
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "event_id", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "expires_at", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "event_id", Value: 1},
+				{Key: "status", Value: 1},
+			},
+		},
+		{
+			Keys:    bson.D{{Key: "order_id", Value: 1}},
+			Options: options.Index().SetSparse(true),
 		},
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	_, err := collection.Indexes().CreateMany(ctx, indexModels)
 	if err != nil {
 		log.Printf("Error creating index: %v", err)
 	}
@@ -89,7 +121,7 @@ func (r *TicketRepository) GetByID(ctx context.Context, id string) (*model.Ticke
 	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&ticket)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, errors.New("ticket not found")
+			return nil, ErrTicketNotFound
 		}
 		return nil, err
 	}
@@ -117,17 +149,149 @@ func (r *TicketRepository) GetByUserID(ctx context.Context, userID string) ([]*m
 	return tickets, nil
 }
 
+// GetByOrderID returns the tickets of an order in _id order.
+func (r *TicketRepository) GetByOrderID(ctx context.Context, orderID string) ([]*model.Ticket, error) {
+	return r.find(ctx, bson.M{"order_id": orderID}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+}
+
+// HeldQuantity counts a user's tickets for an event that count against the
+// event's per-user limit, leaving out the tickets of the order with ID
+// excludeOrder.
+func (r *TicketRepository) HeldQuantity(ctx context.Context, userID, eventID, excludeOrder string) (int32, error) {
+	statuses := make([]string, 0, len(model.HeldStatuses))
+	for _, status := range model.HeldStatuses {
+		statuses = append(statuses, string(status))
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"user_id":  userID,
+			"event_id": eventID,
+			"order_id": bson.M{"$ne": excludeOrder},
+			"status":   bson.M{"$in": statuses},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      nil,
+			"quantity": bson.M{"$sum": 1},
+		}}},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum held tickets: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var result []struct {
+		Quantity int32 `bson:"quantity"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return 0, fmt.Errorf("failed to sum held tickets: %w", err)
+	}
+	if len(result) == 0 {
+		return 0, nil
+	}
+	return result[0].Quantity, nil
+}
+
+// CheckUserLimit returns a *model.LimitError if userID may not get quantity
+// more tickets of an event that allows maxPerUser per user. Inside a
+// transaction it locks the user's purchases of the event first, so that it
+// cannot race a purchase or another handover.
+func (r *TicketRepository) CheckUserLimit(ctx context.Context, userID, eventID string, maxPerUser, quantity int32) error {
+	if maxPerUser == 0 {
+		return nil
+	}
+
+	if err := r.LockPurchases(ctx, userID, eventID); err != nil {
+		return err
+	}
+	held, err := r.HeldQuantity(ctx, userID, eventID, "")
+	if err != nil {
+		return err
+	}
+	return model.PurchaseLimits{MaxPerUser: maxPerUser}.Check(quantity, held)
+}
+
+// LockPurchases writes to a document shared by every purchase a user makes
+// for an event. Inside a transaction this makes concurrent purchases by the
+// same user conflict, so one of them retries and sees the other's ticket.
+func (r *TicketRepository) LockPurchases(ctx context.Context, userID, eventID string) error {
+	_, err := r.db.Collection("purchase_locks").UpdateOne(ctx,
+		bson.M{"_id": userID + "/" + eventID},
+		bson.M{"$inc": bson.M{"version": 1}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to lock purchases: %w", err)
+	}
+	return nil
+}
+
+// List returns up to limit tickets matching filter in _id order, starting
+// after the ticket with ID after. Pass primitive.NilObjectID to start from the
+// beginning. The second return value is the ID to resume from, or
+// primitive.NilObjectID when there are no more tickets.
+func (r *TicketRepository) List(ctx context.Context, filter TicketFilter, after primitive.ObjectID, limit int64) ([]*model.Ticket, primitive.ObjectID, error) {
+	query := bson.M{}
+	if filter.UserID != "" {
+		query["user_id"] = filter.UserID
+	}
+	if filter.EventID != "" {
+		query["event_id"] = filter.EventID
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if !after.IsZero() {
+		query["_id"] = bson.M{"$gt": after}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit + 1)
+
+	tickets, err := r.find(ctx, query, opts)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+
+	if int64(len(tickets)) <= limit {
+		return tickets, primitive.NilObjectID, nil
+	}
+
+	tickets = tickets[:limit]
+	return tickets, tickets[limit-1].ID, nil
+}
+
+// UpdateStatus moves a ticket to status, rejecting transitions the ticket
+// state machine does not allow. The current status is part of the update
+// filter, so concurrent transitions cannot both succeed. Tickets moving into a
+// status that returns stock are flagged for release.
 func (r *TicketRepository) UpdateStatus(ctx context.Context, id string, status model.TicketStatus) (*model.Ticket, error) {
+	return r.updateStatus(ctx, id, status, status.ReturnsStock())
+}
+
+// UpdateStatusWithoutStock is UpdateStatus for tickets whose stock must not go
+// back on sale, such as those of a cancelled event.
+func (r *TicketRepository) UpdateStatusWithoutStock(ctx context.Context, id string, status model.TicketStatus) (*model.Ticket, error) {
+	return r.updateStatus(ctx, id, status, false)
+}
+
+func (r *TicketRepository) updateStatus(ctx context.Context, id string, status model.TicketStatus, releaseStock bool) (*model.Ticket, error) {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return nil, errors.New("invalid ID format")
 	}
 
+	set := bson.M{
+		"status":     status,
+		"updated_at": time.Now(),
+	}
+	if releaseStock {
+		set["stock_released"] = false
+	}
 	update := bson.M{
-		"$set": bson.M{
-			"status":     status,
-			"updated_at": time.Now(),
-		},
+		"$set":   set,
+		"$unset": bson.M{"expires_at": ""},
 	}
 
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
@@ -135,14 +299,21 @@ func (r *TicketRepository) UpdateStatus(ctx context.Context, id string, status m
 
 	err = r.collection.FindOneAndUpdate(
 		ctx,
-		bson.M{"_id": objectID},
+		bson.M{
+			"_id":    objectID,
+			"status": bson.M{"$in": model.StatusesTransitioningTo(status)},
+		},
 		update,
 		opts,
 	).Decode(&ticket)
 
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, errors.New("ticket not found")
+			current, err := r.GetByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%w: cannot move from %s to %s", ErrInvalidStatus, current.Status, status)
 		}
 		return nil, err
 	}
@@ -150,17 +321,230 @@ func (r *TicketRepository) UpdateStatus(ctx context.Context, id string, status m
 	return &ticket, nil
 }
 
-func (r *TicketRepository) GetActiveTicketsForEvent(ctx context.Context, eventID string) ([]*model.Ticket, error) {
-	cursor, err := r.collection.Find(ctx, bson.M{
+// GetActiveTicketsForEvent returns up to limit RESERVED or CONFIRMED tickets
+// of an event in _id order, starting after the ticket with ID after. Pass
+// primitive.NilObjectID to start from the beginning.
+func (r *TicketRepository) GetActiveTicketsForEvent(ctx context.Context, eventID string, after primitive.ObjectID, limit int64) ([]*model.Ticket, error) {
+	return r.find(ctx, bson.M{
 		"event_id": eventID,
+		"_id":      bson.M{"$gt": after},
 		"status": bson.M{
 			"$in": []string{
 				string(model.TicketStatusReserved),
 				string(model.TicketStatusConfirmed),
 			},
 		},
+	}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit))
+}
+
+// ConfirmHold moves a RESERVED ticket whose hold has not yet expired to
+// CONFIRMED. The status and expiry are checked in the update filter so a
+// confirmation can never race the expiry sweeper.
+func (r *TicketRepository) ConfirmHold(ctx context.Context, id string, now time.Time) (*model.Ticket, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid ID format")
+	}
+
+	filter := bson.M{
+		"_id":    objectID,
+		"status": model.TicketStatusReserved,
+		"$or": []bson.M{
+			{"expires_at": bson.M{"$gt": now}},
+			{"expires_at": bson.M{"$exists": false}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":     model.TicketStatusConfirmed,
+			"updated_at": now,
+		},
+		"$unset": bson.M{"expires_at": ""},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var ticket model.Ticket
+
+	err = r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&ticket)
+	if err == nil {
+		return &ticket, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	current, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current.HoldExpired(now) {
+		return nil, ErrHoldExpired
+	}
+	return nil, fmt.Errorf("%w: cannot move from %s to %s", ErrInvalidStatus, current.Status, model.TicketStatusConfirmed)
+}
+
+// Transfer hands a CONFIRMED ticket from its holder to the recipient of
+// transfer, bumps its code version and appends record to its history. The
+// holder and status are part of the update filter, so a ticket that was used,
+// cancelled or given away in the meantime fails with ErrInvalidStatus.
+func (r *TicketRepository) Transfer(ctx context.Context, id primitive.ObjectID, transfer *model.Transfer, record model.TransferRecord) (*model.Ticket, error) {
+	filter := bson.M{
+		"_id":     id,
+		"user_id": transfer.FromUserID,
+		"status":  model.TicketStatusConfirmed,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"user_id":    transfer.ToUserID,
+			"updated_at": record.TransferredAt,
+		},
+		"$inc":  bson.M{"code_version": 1},
+		"$push": bson.M{"transfers": record},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var ticket model.Ticket
+
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&ticket)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: ticket is no longer a confirmed ticket of %s", ErrInvalidStatus, transfer.FromUserID)
+		}
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// Resell reissues a CONFIRMED ticket of sellerID to the buyer of order, which
+// it then belongs to at the price the buyer paid, bumps its code version and
+// appends record to its history. Like Transfer, a ticket that changed in the
+// meantime fails with ErrInvalidStatus.
+func (r *TicketRepository) Resell(ctx context.Context, id primitive.ObjectID, sellerID string, order *model.Order, record model.TransferRecord) (*model.Ticket, error) {
+	filter := bson.M{
+		"_id":     id,
+		"user_id": sellerID,
+		"status":  model.TicketStatusConfirmed,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"user_id":     order.UserID,
+			"order_id":    order.ID.Hex(),
+			"total_price": order.TotalPrice,
+			"discount":    0,
+			"breakdown":   order.Breakdown,
+			"updated_at":  record.TransferredAt,
+		},
+		"$unset": bson.M{"promo_codes": ""},
+		"$inc":   bson.M{"code_version": 1},
+		"$push":  bson.M{"transfers": record},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var ticket model.Ticket
+
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&ticket)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: ticket is no longer a confirmed ticket of %s", ErrInvalidStatus, sellerID)
+		}
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// RecordEntry lets a ticket's holder in at gateID at time at. A CONFIRMED
+// ticket becomes USED with its first entry; a USED ticket, which must have
+// been let in entries times, counts another. The expected status and entry
+// count are part of the update filter, so two scans of one ticket cannot both
+// be let in on the same entry.
+func (r *TicketRepository) RecordEntry(ctx context.Context, id primitive.ObjectID, entries int32, gateID string, at time.Time) (*model.Ticket, error) {
+	filter := bson.M{"_id": id}
+	var update bson.M
+	if entries == 0 {
+		filter["status"] = model.TicketStatusConfirmed
+		update = bson.M{
+			"$set": bson.M{
+				"status":        model.TicketStatusUsed,
+				"checked_in_at": at,
+				"gate_id":       gateID,
+				"entries":       1,
+				"last_entry_at": at,
+				"updated_at":    time.Now(),
+			},
+		}
+	} else {
+		filter["status"] = model.TicketStatusUsed
+		filter["entries"] = entries
+		update = bson.M{
+			"$inc": bson.M{"entries": 1},
+			"$set": bson.M{
+				"last_entry_at": at,
+				"updated_at":    time.Now(),
+			},
+		}
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var ticket model.Ticket
+
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&ticket)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: ticket changed while being checked in", ErrInvalidStatus)
+		}
+		return nil, err
+	}
+	return &ticket, nil
+}
+
+// ListExpiredHolds returns up to limit RESERVED tickets whose hold expired at
+// or before now.
+func (r *TicketRepository) ListExpiredHolds(ctx context.Context, now time.Time, limit int64) ([]*model.Ticket, error) {
+	return r.find(ctx, bson.M{
+		"status":     model.TicketStatusReserved,
+		"expires_at": bson.M{"$lte": now},
+	}, options.Find().SetLimit(limit))
+}
+
+// ExpireHold cancels a RESERVED ticket if its hold is still expired at now and
+// flags its stock for release. It reports whether the ticket was cancelled.
+func (r *TicketRepository) ExpireHold(ctx context.Context, id primitive.ObjectID, now time.Time) (bool, error) {
+	result, err := r.collection.UpdateOne(ctx, bson.M{
+		"_id":        id,
+		"status":     model.TicketStatusReserved,
+		"expires_at": bson.M{"$lte": now},
+	}, bson.M{
+		"$set": bson.M{
+			"status":         model.TicketStatusCancelled,
+			"stock_released": false,
+			"updated_at":     now,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+}
+
+// ListPendingStockReleases returns up to limit tickets that were cancelled but
+// whose stock has not yet been returned to the event service.
+func (r *TicketRepository) ListPendingStockReleases(ctx context.Context, limit int64) ([]*model.Ticket, error) {
+	return r.find(ctx, bson.M{"stock_released": false}, options.Find().SetLimit(limit))
+}
+
+func (r *TicketRepository) MarkStockReleased(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{
+			"stock_released": true,
+			"updated_at":     time.Now(),
+		},
 	})
+	return err
+}
 
+func (r *TicketRepository) find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) ([]*model.Ticket, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func ticketDocument(mt *mtest.T, ticket *model.Ticket) bson.D {
+	raw, err := bson.Marshal(ticket)
+	if err != nil {
+		mt.Fatalf("failed to marshal ticket: %v", err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		mt.Fatalf("failed to unmarshal ticket: %v", err)
+	}
+	return doc
+}
+
+// startedCommand returns the first command sent that is named name.
+func startedCommand(mt *mtest.T, name string) bson.Raw {
+	for _, event := range mt.GetAllStartedEvents() {
+		if event.CommandName == name {
+			return event.Command
+		}
+	}
+	mt.Fatalf("no %s command was sent", name)
+	return nil
+}
+
+func TestTicketRepository_UpdateStatus(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	tests := []struct {
+		name         string
+		to           model.TicketStatus
+		current      *model.Ticket
+		updated      bool
+		releaseStock bool
+		wantErr      error
+	}{
+		{name: "cancel", to: model.TicketStatusCancelled, updated: true, releaseStock: true},
+		{name: "use", to: model.TicketStatusUsed, updated: true},
+		{name: "refund a used ticket", to: model.TicketStatusRefunded, current: &model.Ticket{Status: model.TicketStatusUsed}, releaseStock: true, wantErr: ErrInvalidStatus},
+		{name: "missing ticket", to: model.TicketStatusCancelled, releaseStock: true, wantErr: ErrTicketNotFound},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			repo := NewTicketRepository(mt.DB)
+			id := primitive.NewObjectID()
+			switch {
+			case tt.updated:
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: ticketDocument(mt, &model.Ticket{ID: id, Status: tt.to})}))
+			case tt.current != nil:
+				tt.current.ID = id
+				mt.AddMockResponses(
+					mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}),
+					mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch, ticketDocument(mt, tt.current)),
+				)
+			default:
+				mt.AddMockResponses(
+					mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}),
+					mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch),
+				)
+			}
+
+			ticket, err := repo.UpdateStatus(context.Background(), id.Hex(), tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				mt.Fatalf("UpdateStatus() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.updated && ticket.Status != tt.to {
+				mt.Errorf("UpdateStatus() status = %s, want %s", ticket.Status, tt.to)
+			}
+
+			// Only tickets in a status the state machine lets move to tt.to
+			// are matched.
+			command := startedCommand(mt, "findAndModify")
+			values, err := command.Lookup("query", "status", "$in").Array().Values()
+			if err != nil {
+				mt.Fatalf("UpdateStatus() filter has no status list: %v", err)
+			}
+			from := model.StatusesTransitioningTo(tt.to)
+			if len(values) != len(from) {
+				mt.Errorf("UpdateStatus() matches %v, want %v", values, from)
+			}
+			for _, value := range values {
+				if !model.TicketStatus(value.StringValue()).CanTransitionTo(tt.to) {
+					mt.Errorf("UpdateStatus() matches tickets in %s", value.StringValue())
+				}
+			}
+
+			_, err = command.LookupErr("update", "$set", "stock_released")
+			if released := err == nil; released != tt.releaseStock {
+				mt.Errorf("UpdateStatus() flags stock for release = %v, want %v", released, tt.releaseStock)
+			}
+		})
+	}
+}
+
+func TestTicketRepository_List(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	page := func(mt *mtest.T, n int) ([]primitive.ObjectID, []bson.D) {
+		ids := make([]primitive.ObjectID, n)
+		docs := make([]bson.D, n)
+		for i := range ids {
+			ids[i] = primitive.NewObjectID()
+			docs[i] = ticketDocument(mt, &model.Ticket{ID: ids[i], UserID: "alice", EventID: "event1"})
+		}
+		return ids, docs
+	}
+
+	mt.Run("more tickets", func(mt *mtest.T) {
+		repo := NewTicketRepository(mt.DB)
+		after := primitive.NewObjectID()
+		ids, docs := page(mt, 3)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch, docs...))
+
+		filter := TicketFilter{UserID: "alice", Status: model.TicketStatusConfirmed}
+		tickets, next, err := repo.List(context.Background(), filter, after, 2)
+		if err != nil {
+			mt.Fatalf("List() error = %v", err)
+		}
+		if len(tickets) != 2 || next != ids[1] {
+			mt.Fatalf("List() = %d tickets, next %v, want 2 tickets, next %v", len(tickets), next, ids[1])
+		}
+
+		command := startedCommand(mt, "find")
+		query := command.Lookup("filter").Document()
+		if query.Lookup("user_id").StringValue() != "alice" || query.Lookup("status").StringValue() != string(model.TicketStatusConfirmed) {
+			mt.Errorf("List() filter = %v, want alice's CONFIRMED tickets", query)
+		}
+		if _, err := query.LookupErr("event_id"); err == nil {
+			mt.Errorf("List() filter = %v, want no event filter", query)
+		}
+		if query.Lookup("_id", "$gt").ObjectID() != after {
+			mt.Errorf("List() filter = %v, want tickets after %v", query, after)
+		}
+		if limit := command.Lookup("limit").AsInt64(); limit != 3 {
+			mt.Errorf("List() asked for %d tickets, want one more than the page", limit)
+		}
+	})
+
+	mt.Run("last page", func(mt *mtest.T) {
+		repo := NewTicketRepository(mt.DB)
+		_, docs := page(mt, 2)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch, docs...))
+
+		tickets, next, err := repo.List(context.Background(), TicketFilter{EventID: "event1"}, primitive.NilObjectID, 2)
+		if err != nil {
+			mt.Fatalf("List() error = %v", err)
+		}
+		if len(tickets) != 2 || !next.IsZero() {
+			mt.Fatalf("List() = %d tickets, next %v, want 2 tickets and no next page", len(tickets), next)
+		}
+		if _, err := startedCommand(mt, "find").LookupErr("filter", "_id"); err == nil {
+			mt.Errorf("List() from the start filtered on _id")
+		}
+	})
+}
+
+func TestTicketRepository_ConfirmHold(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		current model.Ticket
+		updated bool
+		wantErr error
+	}{
+		{
+			name:    "hold still valid",
+			current: model.Ticket{Status: model.TicketStatusConfirmed},
+			updated: true,
+		},
+		{
+			name:    "hold expired",
+			current: model.Ticket{Status: model.TicketStatusReserved, ExpiresAt: now.Add(-time.Second)},
+			wantErr: ErrHoldExpired,
+		},
+		{
+			name:    "expires now",
+			current: model.Ticket{Status: model.TicketStatusReserved, ExpiresAt: now},
+			wantErr: ErrHoldExpired,
+		},
+		{
+			name:    "already cancelled",
+			current: model.Ticket{Status: model.TicketStatusCancelled},
+			wantErr: ErrInvalidStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			repo := NewTicketRepository(mt.DB)
+			current := tt.current
+			current.ID = primitive.NewObjectID()
+			if tt.updated {
+				mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: ticketDocument(mt, &current)}))
+			} else {
+				mt.AddMockResponses(
+					mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}),
+					mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch, ticketDocument(mt, &current)),
+				)
+			}
+
+			ticket, err := repo.ConfirmHold(context.Background(), current.ID.Hex(), now)
+			if !errors.Is(err, tt.wantErr) {
+				mt.Fatalf("ConfirmHold() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.updated && ticket.Status != model.TicketStatusConfirmed {
+				mt.Errorf("ConfirmHold() status = %s, want CONFIRMED", ticket.Status)
+			}
+
+			// The expiry is checked by the update itself, so a hold cannot be
+			// confirmed after the sweeper has seen it expire.
+			filter := startedCommand(mt, "findAndModify").Lookup("query").Document()
+			if filter.Lookup("status").StringValue() != string(model.TicketStatusReserved) {
+				mt.Errorf("ConfirmHold() filter = %v, want RESERVED tickets only", filter)
+			}
+			unexpired := filter.Lookup("$or").Array().Index(0).Value().Document().Lookup("expires_at", "$gt")
+			if !unexpired.Time().Equal(now.Truncate(time.Millisecond)) {
+				mt.Errorf("ConfirmHold() filter = %v, want holds expiring after %v", filter, now)
+			}
+		})
+	}
+}
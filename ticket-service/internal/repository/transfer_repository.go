@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrTransferNotFound   = errors.New("transfer not found")
+	ErrTransferNotPending = errors.New("transfer is no longer pending")
+)
+
+// TransferFilter narrows List to transfers matching every non-empty field.
+// UserID matches both the sender and the recipient.
+type TransferFilter struct {
+	UserID string
+	Status model.TransferStatus
+}
+
+// TransferRepository stores ticket transfers. A partial unique index allows
+// one PENDING transfer per ticket.
+type TransferRepository struct {
+	collection *mongo.Collection
+}
+
+func NewTransferRepository(db *mongo.Database) *TransferRepository {
+	collection := db.Collection("transfers")
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "ticket_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": model.TransferStatusPending}),
+		},
+		{Keys: bson.D{{Key: "from_user_id", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "to_user_id", Value: 1}, {Key: "_id", Value: 1}}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := collection.Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &TransferRepository{collection: collection}
+}
+
+// Create stores a transfer. Offering a ticket that already has a pending
+// transfer fails with a duplicate key error.
+func (r *TransferRepository) Create(ctx context.Context, transfer *model.Transfer) error {
+	_, err := r.collection.InsertOne(ctx, transfer)
+	return err
+}
+
+func (r *TransferRepository) GetByID(ctx context.Context, id string) (*model.Transfer, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid ID format")
+	}
+
+	var transfer model.Transfer
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&transfer)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// List returns up to limit transfers matching filter in _id order, starting
+// after the transfer with ID after. The second return value is the ID to
+// resume from, or primitive.NilObjectID when there are no more transfers.
+func (r *TransferRepository) List(ctx context.Context, filter TransferFilter, after primitive.ObjectID, limit int64) ([]*model.Transfer, primitive.ObjectID, error) {
+	query := bson.M{}
+	if filter.UserID != "" {
+		query["$or"] = []bson.M{
+			{"from_user_id": filter.UserID},
+			{"to_user_id": filter.UserID},
+		}
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if !after.IsZero() {
+		query["_id"] = bson.M{"$gt": after}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit + 1)
+
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+	defer cursor.Close(ctx)
+
+	var transfers []*model.Transfer
+	if err := cursor.All(ctx, &transfers); err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+
+	if int64(len(transfers)) <= limit {
+		return transfers, primitive.NilObjectID, nil
+	}
+
+	transfers = transfers[:limit]
+	return transfers, transfers[limit-1].ID, nil
+}
+
+// SetStatus moves a PENDING transfer to status and returns it. A transfer
+// that is no longer pending fails with ErrTransferNotPending.
+func (r *TransferRepository) SetStatus(ctx context.Context, id primitive.ObjectID, status model.TransferStatus) (*model.Transfer, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var transfer model.Transfer
+
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"_id": id, "status": model.TransferStatusPending},
+		bson.M{"$set": bson.M{"status": status, "updated_at": time.Now()}},
+		opts,
+	).Decode(&transfer)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: cannot move to %s", ErrTransferNotPending, status)
+		}
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+// CancelPending cancels the pending transfer of a ticket and returns it, or
+// returns nil if the ticket has none.
+func (r *TransferRepository) CancelPending(ctx context.Context, ticketID string) (*model.Transfer, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var transfer model.Transfer
+
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"ticket_id": ticketID, "status": model.TransferStatusPending},
+		bson.M{"$set": bson.M{"status": model.TransferStatusCancelled, "updated_at": time.Now()}},
+		opts,
+	).Decode(&transfer)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &transfer, nil
+}
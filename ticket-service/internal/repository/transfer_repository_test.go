@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+func TestTransferRepository_CancelPending(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	ticketID := primitive.NewObjectID().Hex()
+
+	mt.Run("pending transfer", func(mt *mtest.T) {
+		repo := NewTransferRepository(mt.DB)
+		cancelled := &model.Transfer{ID: primitive.NewObjectID(), TicketID: ticketID, FromUserID: "alice", ToUserID: "bob", Status: model.TransferStatusCancelled}
+		raw, err := bson.Marshal(cancelled)
+		if err != nil {
+			mt.Fatalf("failed to marshal transfer: %v", err)
+		}
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.Raw(raw)}))
+
+		transfer, err := repo.CancelPending(context.Background(), ticketID)
+		if err != nil {
+			mt.Fatalf("CancelPending() error = %v", err)
+		}
+		if transfer == nil || transfer.ID != cancelled.ID {
+			mt.Fatalf("CancelPending() = %v, want transfer %s", transfer, cancelled.ID.Hex())
+		}
+
+		command := startedCommand(mt, "findAndModify")
+		filter := command.Lookup("query").Document()
+		if filter.Lookup("ticket_id").StringValue() != ticketID || filter.Lookup("status").StringValue() != string(model.TransferStatusPending) {
+			mt.Errorf("CancelPending() filter = %v, want the ticket's PENDING transfer", filter)
+		}
+		if status := command.Lookup("update", "$set", "status").StringValue(); status != string(model.TransferStatusCancelled) {
+			mt.Errorf("CancelPending() set status %s, want CANCELLED", status)
+		}
+	})
+
+	mt.Run("no pending transfer", func(mt *mtest.T) {
+		repo := NewTransferRepository(mt.DB)
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}))
+
+		transfer, err := repo.CancelPending(context.Background(), ticketID)
+		if err != nil || transfer != nil {
+			mt.Fatalf("CancelPending() = %v, %v, want nil, nil", transfer, err)
+		}
+	})
+}
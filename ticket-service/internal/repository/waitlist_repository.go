@@ -0,0 +1,364 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+	ErrNoWaitlistOffer       = errors.New("waitlist entry has no open offer")
+	ErrWaitlistEntryClosed   = errors.New("waitlist entry is closed")
+)
+
+// WaitlistFilter narrows List to entries matching every non-empty field.
+type WaitlistFilter struct {
+	EventID string
+	UserID  string
+	Status  model.WaitlistStatus
+}
+
+// WaitlistQueue identifies a line of entries served in order: those for the
+// same ticket type of an event.
+type WaitlistQueue struct {
+	EventID      string `bson:"event_id"`
+	TicketTypeID string `bson:"ticket_type_id"`
+}
+
+// WaitlistRepository stores waitlist entries. A partial unique index allows
+// one open entry per user and event.
+type WaitlistRepository struct {
+	collection *mongo.Collection
+}
+
+func NewWaitlistRepository(db *mongo.Database) *WaitlistRepository {
+	collection := db.Collection("waitlist")
+
+	indexModels := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "event_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"open": true}),
+		},
+		{Keys: bson.D{{Key: "event_id", Value: 1}, {Key: "ticket_type_id", Value: 1}, {Key: "status", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "offer_expires_at", Value: 1}}},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := collection.Indexes().CreateMany(ctx, indexModels); err != nil {
+		log.Printf("Error creating index: %v", err)
+	}
+
+	return &WaitlistRepository{collection: collection}
+}
+
+// Create stores an entry. Joining the waitlist of an event the user already
+// has an open entry for fails with a duplicate key error.
+func (r *WaitlistRepository) Create(ctx context.Context, entry *model.WaitlistEntry) error {
+	_, err := r.collection.InsertOne(ctx, entry)
+	return err
+}
+
+func (r *WaitlistRepository) GetByID(ctx context.Context, id string) (*model.WaitlistEntry, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.New("invalid ID format")
+	}
+
+	var entry model.WaitlistEntry
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&entry)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrWaitlistEntryNotFound
+		}
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// List returns up to limit entries matching filter in _id order, starting
+// after the entry with ID after. The second return value is the ID to resume
+// from, or primitive.NilObjectID when there are no more entries.
+func (r *WaitlistRepository) List(ctx context.Context, filter WaitlistFilter, after primitive.ObjectID, limit int64) ([]*model.WaitlistEntry, primitive.ObjectID, error) {
+	query := bson.M{}
+	if filter.EventID != "" {
+		query["event_id"] = filter.EventID
+	}
+	if filter.UserID != "" {
+		query["user_id"] = filter.UserID
+	}
+	if filter.Status != "" {
+		query["status"] = filter.Status
+	}
+	if !after.IsZero() {
+		query["_id"] = bson.M{"$gt": after}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit + 1)
+
+	cursor, err := r.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []*model.WaitlistEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+
+	if int64(len(entries)) <= limit {
+		return entries, primitive.NilObjectID, nil
+	}
+
+	entries = entries[:limit]
+	return entries, entries[limit-1].ID, nil
+}
+
+// Position returns the place in line of a WAITING entry, counting from 1.
+func (r *WaitlistRepository) Position(ctx context.Context, entry *model.WaitlistEntry) (int32, error) {
+	ahead, err := r.collection.CountDocuments(ctx, queueFilter(entry.EventID, entry.TicketTypeID, bson.M{
+		"status": model.WaitlistStatusWaiting,
+		"_id":    bson.M{"$lt": entry.ID},
+	}))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count entries ahead: %w", err)
+	}
+	return int32(ahead) + 1, nil
+}
+
+// Queues returns every queue with at least one WAITING entry.
+func (r *WaitlistRepository) Queues(ctx context.Context) ([]WaitlistQueue, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"status": model.WaitlistStatusWaiting}}},
+		{{Key: "$group", Value: bson.M{"_id": bson.M{
+			"event_id":       "$event_id",
+			"ticket_type_id": "$ticket_type_id",
+		}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		Queue WaitlistQueue `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+
+	queues := make([]WaitlistQueue, 0, len(results))
+	for _, result := range results {
+		queues = append(queues, result.Queue)
+	}
+	return queues, nil
+}
+
+// NextWaiting returns the WAITING entry at the head of queue, or nil if the
+// queue is empty.
+func (r *WaitlistRepository) NextWaiting(ctx context.Context, queue WaitlistQueue) (*model.WaitlistEntry, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	var entry model.WaitlistEntry
+	err := r.collection.FindOne(ctx, queueFilter(queue.EventID, queue.TicketTypeID, bson.M{
+		"status": model.WaitlistStatusWaiting,
+	}), opts).Decode(&entry)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// Offer moves a WAITING entry to OFFERED, open until expiresAt. An entry
+// that has been left fails with ErrWaitlistEntryClosed.
+func (r *WaitlistRepository) Offer(ctx context.Context, id primitive.ObjectID, offeredAt, expiresAt time.Time) (*model.WaitlistEntry, error) {
+	filter := bson.M{"_id": id, "status": model.WaitlistStatusWaiting}
+	update := bson.M{"$set": bson.M{
+		"status":           model.WaitlistStatusOffered,
+		"offered_at":       offeredAt,
+		"offer_expires_at": expiresAt,
+		"updated_at":       offeredAt,
+	}}
+	return r.findOneAndUpdate(ctx, filter, update, ErrWaitlistEntryClosed)
+}
+
+// Claim takes up the open offer of userID's entry for orderID, as long as it
+// has not expired at now. The entry is closed as CLAIMED, and the stock it
+// holds passes to the order.
+func (r *WaitlistRepository) Claim(ctx context.Context, id primitive.ObjectID, userID, orderID string, now time.Time) (*model.WaitlistEntry, error) {
+	filter := bson.M{
+		"_id":              id,
+		"user_id":          userID,
+		"status":           model.WaitlistStatusOffered,
+		"offer_expires_at": bson.M{"$gt": now},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":     model.WaitlistStatusClaimed,
+			"order_id":   orderID,
+			"updated_at": now,
+		},
+		"$unset": bson.M{"open": ""},
+	}
+	return r.findOneAndUpdate(ctx, filter, update, ErrNoWaitlistOffer)
+}
+
+// ListExpiredOffers returns up to limit OFFERED entries whose offer ran out
+// at or before now.
+func (r *WaitlistRepository) ListExpiredOffers(ctx context.Context, now time.Time, limit int64) ([]*model.WaitlistEntry, error) {
+	return r.find(ctx, bson.M{
+		"status":           model.WaitlistStatusOffered,
+		"offer_expires_at": bson.M{"$lte": now},
+	}, limit)
+}
+
+// Expire closes an OFFERED entry whose offer ran out at or before now. It
+// reports false if the offer was claimed or left in the meantime.
+func (r *WaitlistRepository) Expire(ctx context.Context, id primitive.ObjectID, now time.Time) (bool, error) {
+	res, err := r.collection.UpdateOne(ctx, bson.M{
+		"_id":              id,
+		"status":           model.WaitlistStatusOffered,
+		"offer_expires_at": bson.M{"$lte": now},
+	}, bson.M{
+		"$set": bson.M{
+			"status":     model.WaitlistStatusExpired,
+			"reason":     "offer expired",
+			"updated_at": now,
+		},
+		"$unset": bson.M{"open": ""},
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+// Leave takes userID's entry, WAITING or OFFERED, off the waitlist. An entry
+// that is already closed fails with ErrWaitlistEntryClosed.
+func (r *WaitlistRepository) Leave(ctx context.Context, id primitive.ObjectID, userID string) (*model.WaitlistEntry, error) {
+	filter := bson.M{"_id": id, "user_id": userID, "open": true}
+	return r.findOneAndUpdate(ctx, filter, closeEntry("left the waitlist"), ErrWaitlistEntryClosed)
+}
+
+// Close cancels a WAITING entry that cannot be served, for reason.
+func (r *WaitlistRepository) Close(ctx context.Context, id primitive.ObjectID, reason string) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{
+		"_id":    id,
+		"status": model.WaitlistStatusWaiting,
+	}, closeEntry(reason))
+	if err != nil {
+		return fmt.Errorf("failed to close waitlist entry: %w", err)
+	}
+	return nil
+}
+
+// CancelEvent cancels every open entry of an event for reason and returns how
+// many there were.
+func (r *WaitlistRepository) CancelEvent(ctx context.Context, eventID, reason string) (int64, error) {
+	res, err := r.collection.UpdateMany(ctx, bson.M{
+		"event_id": eventID,
+		"open":     true,
+	}, closeEntry(reason))
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel waitlist: %w", err)
+	}
+	return res.ModifiedCount, nil
+}
+
+func closeEntry(reason string) bson.M {
+	return bson.M{
+		"$set": bson.M{
+			"status":     model.WaitlistStatusCancelled,
+			"reason":     reason,
+			"updated_at": time.Now(),
+		},
+		"$unset": bson.M{"open": ""},
+	}
+}
+
+// ListPendingStockReleases returns up to limit entries that were closed
+// without being claimed and may still hold stock.
+func (r *WaitlistRepository) ListPendingStockReleases(ctx context.Context, limit int64) ([]*model.WaitlistEntry, error) {
+	return r.find(ctx, bson.M{
+		"status": bson.M{"$in": []model.WaitlistStatus{
+			model.WaitlistStatusExpired,
+			model.WaitlistStatusCancelled,
+		}},
+		"stock_released": bson.M{"$ne": true},
+	}, limit)
+}
+
+// MarkStockReleased records that the stock held for an entry has been
+// returned.
+func (r *WaitlistRepository) MarkStockReleased(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{"stock_released": true, "updated_at": time.Now()},
+	})
+	return err
+}
+
+func (r *WaitlistRepository) find(ctx context.Context, filter bson.M, limit int64) ([]*model.WaitlistEntry, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []*model.WaitlistEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *WaitlistRepository) findOneAndUpdate(ctx context.Context, filter, update bson.M, notMatched error) (*model.WaitlistEntry, error) {
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var entry model.WaitlistEntry
+
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&entry)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, notMatched
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// queueFilter adds the queue of eventID and ticketTypeID to filter. Entries
+// without a ticket type have no ticket_type_id field.
+func queueFilter(eventID, ticketTypeID string, filter bson.M) bson.M {
+	filter["event_id"] = eventID
+	if ticketTypeID != "" {
+		filter["ticket_type_id"] = ticketTypeID
+	} else {
+		filter["ticket_type_id"] = bson.M{"$exists": false}
+	}
+	return filter
+}
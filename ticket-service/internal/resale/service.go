@@ -0,0 +1,306 @@
+// Package resale runs the marketplace on which ticket holders sell confirmed
+// tickets to other users. Buyers pay through an order of their own, and once
+// the payment is captured the listing is sold, the ticket reissued to the
+// buyer and the seller's payout recorded in one transaction, which also
+// cancels any transfer the seller still had pending for the ticket. A
+// purchase that cannot finish is failed and paid back, and the listing put
+// back on sale.
+package resale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/payment"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const batchSize = 100
+
+var (
+	ErrPriceAboveCap = errors.New("price is above the event's resale cap")
+	ErrOwnListing    = errors.New("sellers cannot buy their own listing")
+	ErrHoldExpired   = errors.New("listing hold expired before the purchase completed")
+)
+
+type Service struct {
+	tickets    *repository.TicketRepository
+	listings   *repository.ListingRepository
+	orders     *repository.OrderRepository
+	transfers  *repository.TransferRepository
+	outbox     *repository.OutboxRepository
+	transactor *repository.Transactor
+	payments   *payment.Service
+	holdTTL    time.Duration
+	interval   time.Duration
+	stopCh     chan struct{}
+}
+
+func NewService(
+	tickets *repository.TicketRepository,
+	listings *repository.ListingRepository,
+	orders *repository.OrderRepository,
+	transfers *repository.TransferRepository,
+	outbox *repository.OutboxRepository,
+	transactor *repository.Transactor,
+	payments *payment.Service,
+	holdTTL time.Duration,
+	interval time.Duration,
+) *Service {
+	return &Service{
+		tickets:    tickets,
+		listings:   listings,
+		orders:     orders,
+		transfers:  transfers,
+		outbox:     outbox,
+		transactor: transactor,
+		payments:   payments,
+		holdTTL:    holdTTL,
+		interval:   interval,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// SetPolicy creates or replaces the resale policy of an event. Listings
+// already on sale keep their price.
+func (s *Service) SetPolicy(ctx context.Context, policy *model.ResalePolicy) error {
+	return s.listings.SavePolicy(ctx, policy)
+}
+
+// Policy returns the resale policy of an event, which allows resale at up to
+// face value without a fee if none was set.
+func (s *Service) Policy(ctx context.Context, eventID string) (*model.ResalePolicy, error) {
+	policy, err := s.listings.GetPolicy(ctx, eventID)
+	if errors.Is(err, repository.ErrResalePolicyNotFound) {
+		return model.DefaultResalePolicy(eventID), nil
+	}
+	return policy, err
+}
+
+// Create puts a confirmed ticket on sale at price, which must not exceed the
+// cap of its event's resale policy. Listing a ticket that is already on sale
+// fails with a duplicate key error.
+func (s *Service) Create(ctx context.Context, ticket *model.Ticket, price int64) (*model.Listing, error) {
+	policy, err := s.Policy(ctx, ticket.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if limit := policy.PriceCap(ticket.UnitPrice); price > limit {
+		return nil, fmt.Errorf("%w: at most %d %s", ErrPriceAboveCap, limit, ticket.Currency)
+	}
+
+	listing := model.NewListing(ticket, price)
+	if err := s.listings.Create(ctx, listing); err != nil {
+		return nil, err
+	}
+	return listing, nil
+}
+
+// Buy sells a listing to buyerID, who pays with paymentMethod, and returns
+// the sold listing, the buyer's order and the reissued ticket. The listing is
+// held for the buyer while they pay; if the purchase fails the payment is
+// returned and the listing goes back on sale. The ticket counts against the
+// buyer's limit of maxPerUser tickets to the event, 0 meaning no limit.
+func (s *Service) Buy(ctx context.Context, id, buyerID, paymentMethod string, maxPerUser int32) (*model.Listing, *model.Order, *model.Ticket, error) {
+	listing, err := s.listings.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if listing.Status != model.ListingStatusActive {
+		return nil, nil, nil, fmt.Errorf("%w: listing is %s", repository.ErrListingUnavailable, listing.Status)
+	}
+	if listing.SellerID == buyerID {
+		return nil, nil, nil, ErrOwnListing
+	}
+
+	policy, err := s.Policy(ctx, listing.EventID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	order, err := listing.Order(buyerID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// A client that gives up must not leave the purchase half done.
+	ctx = context.WithoutCancel(ctx)
+
+	// The limit is checked before the buyer is charged, and again once they
+	// have paid in case a concurrent purchase got there first.
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.tickets.CheckUserLimit(ctx, buyerID, listing.EventID, maxPerUser, 1); err != nil {
+			return err
+		}
+		if err := s.orders.Create(ctx, order); err != nil {
+			return fmt.Errorf("failed to create order: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	listing, err = s.listings.Hold(ctx, listing.ID, buyerID, order.ID.Hex(), time.Now().Add(s.holdTTL))
+	if err != nil {
+		if failErr := s.orders.Fail(ctx, order.ID, err.Error()); failErr != nil {
+			log.Printf("Failed to fail order %s: %v", order.ID.Hex(), failErr)
+		}
+		return nil, nil, nil, err
+	}
+
+	ticket, err := s.complete(ctx, listing, order, policy, paymentMethod, maxPerUser)
+	if err != nil {
+		if abandonErr := s.abandon(ctx, listing, err.Error()); abandonErr != nil {
+			log.Printf("Failed to abandon purchase of listing %s, will retry: %v", listing.ID.Hex(), abandonErr)
+		}
+		return nil, nil, nil, err
+	}
+	return listing, order, ticket, nil
+}
+
+// complete takes the buyer's payment, then sells the listing and reissues the
+// ticket. The order and the buyer's limit are checked in the same
+// transaction, so a purchase whose hold was given up by the recovery loop, or
+// that a concurrent purchase has put over the limit, cannot complete.
+func (s *Service) complete(ctx context.Context, listing *model.Listing, order *model.Order, policy *model.ResalePolicy, paymentMethod string, maxPerUser int32) (*model.Ticket, error) {
+	orderID := order.ID.Hex()
+	if _, err := s.payments.Authorize(ctx, order, paymentMethod); err != nil {
+		return nil, err
+	}
+	paid, err := s.payments.Capture(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticketID, err := primitive.ObjectIDFromHex(listing.TicketID)
+	if err != nil {
+		return nil, fmt.Errorf("listing has an invalid ticket ID: %w", err)
+	}
+	payout := model.NewPayout(listing, orderID, policy)
+
+	var (
+		sold   *model.Listing
+		ticket *model.Ticket
+	)
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		current, err := s.orders.GetByID(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if current.Status != model.OrderStatusPending {
+			return ErrHoldExpired
+		}
+		if err := s.tickets.CheckUserLimit(ctx, order.UserID, listing.EventID, maxPerUser, 1); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		sold, err = s.listings.Sell(ctx, listing.ID, orderID, payout.Amount, now)
+		if err != nil {
+			return err
+		}
+		ticket, err = s.tickets.Resell(ctx, ticketID, listing.SellerID, order, sold.Record(order.UserID, now))
+		if err != nil {
+			return err
+		}
+		// A transfer offered by the seller would otherwise block the buyer
+		// from transferring the ticket, and could still be accepted.
+		transfer, err := s.transfers.CancelPending(ctx, listing.TicketID)
+		if err != nil {
+			return err
+		}
+		if transfer != nil {
+			if err := s.outbox.Add(ctx, model.NewTransferEvent(model.EventTicketTransferCancelled, ticket, transfer)); err != nil {
+				return err
+			}
+		}
+		if err := s.orders.Confirm(ctx, order.ID, paid.AuthorizationID); err != nil {
+			return err
+		}
+		if err := s.listings.CreatePayout(ctx, payout); err != nil {
+			return err
+		}
+		return s.outbox.Add(ctx, model.NewResaleEvent(ticket, sold))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	*listing = *sold
+	order.Status = model.OrderStatusConfirmed
+	order.PaymentReference = paid.AuthorizationID
+	return ticket, nil
+}
+
+// abandon fails the purchase holding listing, returns the buyer's payment and
+// puts the listing back on sale. It is safe to repeat, and does nothing to a
+// purchase that completed. If the payment cannot be returned the hold is
+// kept, so the recovery loop tries again.
+func (s *Service) abandon(ctx context.Context, listing *model.Listing, reason string) error {
+	orderID, err := primitive.ObjectIDFromHex(listing.OrderID)
+	if err != nil {
+		return fmt.Errorf("listing holds an invalid order ID: %w", err)
+	}
+
+	if err := s.orders.Fail(ctx, orderID, reason); err != nil {
+		return fmt.Errorf("failed to fail order: %w", err)
+	}
+	order, err := s.orders.GetByID(ctx, listing.OrderID)
+	if err != nil {
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+	if order.Status == model.OrderStatusConfirmed {
+		return nil
+	}
+
+	if err := s.payments.Reverse(ctx, listing.OrderID); err != nil {
+		return fmt.Errorf("failed to return payment: %w", err)
+	}
+	return s.listings.Release(ctx, listing.ID, listing.OrderID)
+}
+
+func (s *Service) Start() {
+	log.Printf("Starting resale hold recovery, running every %v", s.interval)
+	go s.loop()
+}
+
+func (s *Service) Stop() {
+	close(s.stopCh)
+}
+
+func (s *Service) loop() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.ReleaseStaleHolds(context.Background())
+		case <-s.stopCh:
+			log.Println("Resale hold recovery stopped")
+			return
+		}
+	}
+}
+
+// ReleaseStaleHolds abandons purchases whose hold on a listing ran out,
+// because the buyer's process went away or returning their payment failed.
+func (s *Service) ReleaseStaleHolds(ctx context.Context) {
+	listings, err := s.listings.ListStaleHolds(ctx, time.Now(), batchSize)
+	if err != nil {
+		log.Printf("Failed to list stale resale holds: %v", err)
+		return
+	}
+
+	for _, listing := range listings {
+		if err := s.abandon(ctx, listing, "listing hold expired"); err != nil {
+			log.Printf("Failed to release hold on listing %s: %v", listing.ID.Hex(), err)
+			continue
+		}
+		log.Printf("Released stale hold on listing %s", listing.ID.Hex())
+	}
+}
@@ -0,0 +1,294 @@
+package resale
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/payment"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// recordingProvider is the fake provider, remembering what it refunded.
+type recordingProvider struct {
+	*payment.FakeProvider
+	refunded []string
+}
+
+func (p *recordingProvider) Refund(ctx context.Context, authorizationID string, amount int64) error {
+	p.refunded = append(p.refunded, authorizationID)
+	return p.FakeProvider.Refund(ctx, authorizationID, amount)
+}
+
+func newService(mt *mtest.T, provider payment.Provider) *Service {
+	return NewService(
+		repository.NewTicketRepository(mt.DB),
+		repository.NewListingRepository(mt.DB),
+		repository.NewOrderRepository(mt.DB),
+		repository.NewTransferRepository(mt.DB),
+		repository.NewOutboxRepository(mt.DB),
+		repository.NewTransactor(mt.Client),
+		payment.NewService(provider, repository.NewPaymentRepository(mt.DB)),
+		time.Minute,
+		time.Minute,
+	)
+}
+
+func document(mt *mtest.T, v interface{}) bson.D {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		mt.Fatalf("failed to marshal %T: %v", v, err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		mt.Fatalf("failed to unmarshal %T: %v", v, err)
+	}
+	return doc
+}
+
+func cursor(docs ...bson.D) bson.D {
+	return mtest.CreateCursorResponse(0, "test.coll", mtest.FirstBatch, docs...)
+}
+
+// sentTo returns how many name commands were sent to collection.
+func sentTo(mt *mtest.T, name, collection string) int {
+	count := 0
+	for _, event := range mt.GetAllStartedEvents() {
+		if event.CommandName == name && event.Command.Lookup(name).StringValue() == collection {
+			count++
+		}
+	}
+	return count
+}
+
+func newListing() *model.Listing {
+	ticket := &model.Ticket{ID: primitive.NewObjectID(), EventID: "event1", UserID: "alice", UnitPrice: 1000, Currency: "USD"}
+	return model.NewListing(ticket, 1000)
+}
+
+// heldListing is a listing bob holds while paying through a new order, whose
+// payment has been captured.
+func heldListing() (*model.Listing, *model.Payment) {
+	listing := newListing()
+	order, _ := listing.Order("bob")
+	listing.BuyerID = "bob"
+	listing.OrderID = order.ID.Hex()
+	listing.HeldUntil = time.Now().Add(-time.Second)
+
+	paid := model.NewPayment(order, "fake", "pm_card")
+	paid.Status = model.PaymentStatusCaptured
+	paid.AuthorizationID = "fake_auth_" + order.ID.Hex()
+	return listing, paid
+}
+
+func TestService_Create(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	ticket := &model.Ticket{ID: primitive.NewObjectID(), EventID: "event1", UserID: "alice", UnitPrice: 1000, Currency: "USD"}
+	policy := &model.ResalePolicy{EventID: "event1", MaxMarkupBps: 1000}
+
+	mt.Run("above the cap", func(mt *mtest.T) {
+		s := newService(mt, payment.NewFakeProvider("secret"))
+		mt.AddMockResponses(cursor(document(mt, policy)))
+
+		_, err := s.Create(context.Background(), ticket, 1101)
+		if !errors.Is(err, ErrPriceAboveCap) {
+			mt.Fatalf("Create() error = %v, want %v", err, ErrPriceAboveCap)
+		}
+		if sentTo(mt, "insert", "listings") != 0 {
+			mt.Errorf("Create() stored a listing above the cap")
+		}
+	})
+
+	mt.Run("at the cap", func(mt *mtest.T) {
+		s := newService(mt, payment.NewFakeProvider("secret"))
+		mt.AddMockResponses(cursor(document(mt, policy)), mtest.CreateSuccessResponse())
+
+		listing, err := s.Create(context.Background(), ticket, 1100)
+		if err != nil {
+			mt.Fatalf("Create() error = %v", err)
+		}
+		if listing.Price != 1100 || listing.FaceValue != 1000 || listing.SellerID != "alice" {
+			mt.Errorf("Create() = %+v, want alice's ticket at 1100", listing)
+		}
+	})
+
+	mt.Run("no policy", func(mt *mtest.T) {
+		s := newService(mt, payment.NewFakeProvider("secret"))
+		mt.AddMockResponses(cursor())
+
+		_, err := s.Create(context.Background(), ticket, 1001)
+		if !errors.Is(err, ErrPriceAboveCap) {
+			mt.Fatalf("Create() error = %v, want resale capped at face value", err)
+		}
+	})
+}
+
+func TestService_Buy(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("own listing", func(mt *mtest.T) {
+		s := newService(mt, payment.NewFakeProvider("secret"))
+		listing := newListing()
+		mt.AddMockResponses(cursor(document(mt, listing)))
+
+		_, _, _, err := s.Buy(context.Background(), listing.ID.Hex(), "alice", "pm_card", 0)
+		if !errors.Is(err, ErrOwnListing) {
+			mt.Fatalf("Buy() error = %v, want %v", err, ErrOwnListing)
+		}
+		if sentTo(mt, "insert", "orders") != 0 {
+			mt.Errorf("Buy() created an order for the seller")
+		}
+	})
+
+	mt.Run("over the limit", func(mt *mtest.T) {
+		s := newService(mt, payment.NewFakeProvider("secret"))
+		listing := newListing()
+		mt.AddMockResponses(
+			cursor(document(mt, listing)),
+			cursor(),
+			// The limit is checked while holding the buyer's purchase lock.
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			cursor(bson.D{{Key: "_id", Value: nil}, {Key: "quantity", Value: int32(2)}}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		_, _, _, err := s.Buy(context.Background(), listing.ID.Hex(), "bob", "pm_card", 2)
+		var limitErr *model.LimitError
+		if !errors.As(err, &limitErr) {
+			mt.Fatalf("Buy() error = %v, want a limit error", err)
+		}
+		for _, event := range mt.GetAllStartedEvents() {
+			if event.CommandName != "update" || event.Command.Lookup("update").StringValue() != "purchase_locks" {
+				continue
+			}
+			if start, err := event.Command.LookupErr("startTransaction"); err != nil || !start.Boolean() {
+				mt.Errorf("Buy() locked bob's purchases outside a transaction")
+			}
+		}
+		if sentTo(mt, "update", "purchase_locks") != 1 {
+			mt.Errorf("Buy() checked the limit without locking bob's purchases")
+		}
+		if sentTo(mt, "insert", "orders") != 0 || sentTo(mt, "update", "payments") != 0 {
+			mt.Errorf("Buy() created an order or took a payment over the limit")
+		}
+	})
+
+	mt.Run("hold expired", func(mt *mtest.T) {
+		provider := &recordingProvider{FakeProvider: payment.NewFakeProvider("secret")}
+		s := newService(mt, provider)
+		listing, paid := heldListing()
+		failed := &model.Order{ID: primitive.NewObjectID(), UserID: "bob", Status: model.OrderStatusFailed}
+		ok := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1})
+
+		active := *listing
+		active.BuyerID, active.OrderID, active.HeldUntil = "", "", time.Time{}
+		authorized := *paid
+		authorized.Status = model.PaymentStatusAuthorized
+
+		mt.AddMockResponses(
+			cursor(document(mt, &active)),
+			cursor(),
+			// The order is created.
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+			// The listing is held, and the payment authorized and captured.
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: document(mt, listing)}),
+			cursor(),
+			ok,
+			ok,
+			cursor(document(mt, &authorized)),
+			ok,
+			// The recovery loop gave up on the purchase meanwhile.
+			cursor(document(mt, failed)),
+			mtest.CreateSuccessResponse(),
+			// The purchase is abandoned: the payment refunded and the
+			// listing put back on sale.
+			ok,
+			cursor(document(mt, failed)),
+			cursor(document(mt, paid)),
+			ok,
+			ok,
+		)
+
+		_, _, _, err := s.Buy(context.Background(), listing.ID.Hex(), "bob", "pm_card", 0)
+		if !errors.Is(err, ErrHoldExpired) {
+			mt.Fatalf("Buy() error = %v, want %v", err, ErrHoldExpired)
+		}
+		if sentTo(mt, "findAndModify", "listings") != 1 {
+			mt.Errorf("Buy() sold a listing whose hold expired")
+		}
+		if len(provider.refunded) != 1 {
+			mt.Errorf("Buy() refunded %v, want the buyer's payment", provider.refunded)
+		}
+		if sentTo(mt, "update", "listings") != 1 {
+			mt.Errorf("Buy() did not put the listing back on sale")
+		}
+	})
+}
+
+func TestService_ReleaseStaleHolds(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	ok := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1})
+
+	mt.Run("abandoned purchase", func(mt *mtest.T) {
+		provider := &recordingProvider{FakeProvider: payment.NewFakeProvider("secret")}
+		s := newService(mt, provider)
+		listing, paid := heldListing()
+		failed := &model.Order{ID: primitive.NewObjectID(), UserID: "bob", Status: model.OrderStatusFailed}
+
+		mt.AddMockResponses(
+			cursor(document(mt, listing)),
+			ok,
+			cursor(document(mt, failed)),
+			cursor(document(mt, paid)),
+			ok,
+			ok,
+		)
+
+		s.ReleaseStaleHolds(context.Background())
+
+		if len(provider.refunded) != 1 || provider.refunded[0] != paid.AuthorizationID {
+			mt.Errorf("refunded %v, want %s", provider.refunded, paid.AuthorizationID)
+		}
+		var release bson.Raw
+		for _, event := range mt.GetAllStartedEvents() {
+			if event.CommandName == "update" && event.Command.Lookup("update").StringValue() == "listings" {
+				release = event.Command.Lookup("updates").Array().Index(0).Value().Document()
+			}
+		}
+		if release == nil || release.Lookup("q", "order_id").StringValue() != listing.OrderID {
+			mt.Fatalf("released %v, want the hold of order %s", release, listing.OrderID)
+		}
+		if _, err := release.LookupErr("u", "$unset", "order_id"); err != nil {
+			mt.Errorf("released with %v, want the hold lifted", release)
+		}
+	})
+
+	mt.Run("purchase completed meanwhile", func(mt *mtest.T) {
+		provider := &recordingProvider{FakeProvider: payment.NewFakeProvider("secret")}
+		s := newService(mt, provider)
+		listing, _ := heldListing()
+		confirmed := &model.Order{ID: primitive.NewObjectID(), UserID: "bob", Status: model.OrderStatusConfirmed}
+
+		mt.AddMockResponses(
+			cursor(document(mt, listing)),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}),
+			cursor(document(mt, confirmed)),
+		)
+
+		s.ReleaseStaleHolds(context.Background())
+
+		if len(provider.refunded) != 0 {
+			mt.Errorf("refunded %v for a purchase that completed", provider.refunded)
+		}
+		if sentTo(mt, "update", "listings") != 0 {
+			mt.Errorf("put a sold listing back on sale")
+		}
+	})
+}
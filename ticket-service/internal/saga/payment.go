@@ -0,0 +1,27 @@
+package saga
+
+import (
+	"context"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+)
+
+// Payments moves the buyer's money for an order. Every method is called
+// again when a saga resumes, so each must be idempotent per order.
+type Payments interface {
+	Authorize(ctx context.Context, order *model.Order, paymentMethod string) (*model.Payment, error)
+	Capture(ctx context.Context, orderID string) (*model.Payment, error)
+	Reverse(ctx context.Context, orderID string) error
+}
+
+// Pricing adds fees and tax to the price of an order.
+type Pricing interface {
+	PriceOrder(ctx context.Context, order *model.Order) error
+}
+
+// Promos redeems the promo codes of an order. Both methods are called again
+// when a saga resumes, so each must be idempotent per order.
+type Promos interface {
+	Redeem(ctx context.Context, order *model.Order) error
+	Release(ctx context.Context, orderID string) error
+}
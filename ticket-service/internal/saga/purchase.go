@@ -0,0 +1,487 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// lease is how long a saga stays owned by the process driving it. It must
+	// comfortably exceed the time a single step may take.
+	lease       = time.Minute
+	resumeBatch = 100
+)
+
+// StepError reports the step at which a purchase failed. By the time it is
+// returned every completed step, and the failed step itself, has been
+// compensated.
+type StepError struct {
+	Step model.SagaStep
+	Err  error
+}
+
+func (e *StepError) Error() string {
+	return fmt.Sprintf("%s failed: %v", e.Step, e.Err)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// Orchestrator drives orders through reserving stock for each ticket,
+// redeeming promo codes, adding fees and tax, creating the order and its
+// tickets, authorizing and capturing payment, confirming the tickets and
+// notifying the buyer. Progress is persisted after every step; a failure
+// before the order is confirmed undoes the failed step and the completed
+// steps before it in reverse order. Sagas abandoned by a crash or restart are
+// picked up again by a background loop.
+type Orchestrator struct {
+	sagaRepo    *repository.SagaRepository
+	orderRepo   *repository.OrderRepository
+	ticketRepo  *repository.TicketRepository
+	outboxRepo  *repository.OutboxRepository
+	jobRepo     *repository.CancellationJobRepository
+	transactor  *repository.Transactor
+	eventClient eventpb.EventServiceClient
+	payments    Payments
+	promos      Promos
+	pricing     Pricing
+	stepTimeout time.Duration
+	interval    time.Duration
+	stopCh      chan struct{}
+}
+
+func NewOrchestrator(
+	sagaRepo *repository.SagaRepository,
+	orderRepo *repository.OrderRepository,
+	ticketRepo *repository.TicketRepository,
+	outboxRepo *repository.OutboxRepository,
+	jobRepo *repository.CancellationJobRepository,
+	transactor *repository.Transactor,
+	eventConn *grpc.ClientConn,
+	payments Payments,
+	promos Promos,
+	pricing Pricing,
+	stepTimeout time.Duration,
+	interval time.Duration,
+) *Orchestrator {
+	return &Orchestrator{
+		sagaRepo:    sagaRepo,
+		orderRepo:   orderRepo,
+		ticketRepo:  ticketRepo,
+		outboxRepo:  outboxRepo,
+		jobRepo:     jobRepo,
+		transactor:  transactor,
+		eventClient: eventpb.NewEventServiceClient(eventConn),
+		payments:    payments,
+		promos:      promos,
+		pricing:     pricing,
+		stepTimeout: stepTimeout,
+		interval:    interval,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+// Purchase runs a new purchase saga for order, paid with paymentMethod, and
+// returns the confirmed order. Its tickets are held for holdTTL while it is
+// paid for, and are only created if they keep the buyer within the limits of
+// each event. On failure the returned error is a *StepError.
+func (o *Orchestrator) Purchase(ctx context.Context, order *model.Order, paymentMethod string, limits map[string]model.PurchaseLimits, holdTTL time.Duration) (*model.Order, error) {
+	saga := model.NewPurchaseSaga(order, paymentMethod, holdTTL)
+	saga.Limits = limits
+	saga.LeaseUntil = time.Now().Add(lease)
+
+	if err := o.sagaRepo.Create(ctx, saga); err != nil {
+		return nil, fmt.Errorf("failed to start purchase: %w", err)
+	}
+
+	// A client that gives up must not leave the purchase half done.
+	ctx = context.WithoutCancel(ctx)
+
+	if err := o.run(ctx, saga); err != nil {
+		return nil, err
+	}
+	return &saga.Order, nil
+}
+
+func (o *Orchestrator) Start() {
+	log.Printf("Starting purchase saga recovery, running every %v", o.interval)
+	go o.loop()
+}
+
+func (o *Orchestrator) Stop() {
+	close(o.stopCh)
+}
+
+func (o *Orchestrator) loop() {
+	o.ResumeStale(context.Background())
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			o.ResumeStale(context.Background())
+		case <-o.stopCh:
+			log.Println("Purchase saga recovery stopped")
+			return
+		}
+	}
+}
+
+// ResumeStale finishes sagas whose driving process has gone away: running
+// sagas continue from their next step and compensating ones finish their
+// rollback.
+func (o *Orchestrator) ResumeStale(ctx context.Context) {
+	for i := 0; i < resumeBatch; i++ {
+		saga, err := o.sagaRepo.ClaimStale(ctx, time.Now(), lease)
+		if err != nil {
+			log.Printf("Failed to claim stale purchase saga: %v", err)
+			return
+		}
+		if saga == nil {
+			return
+		}
+
+		log.Printf("Resuming %s purchase saga %s", saga.Status, saga.ID.Hex())
+
+		if saga.Status == model.SagaStatusCompensating {
+			if err := o.compensate(ctx, saga); err != nil {
+				log.Printf("Failed to compensate purchase saga %s: %v", saga.ID.Hex(), err)
+			}
+			continue
+		}
+
+		if err := o.run(ctx, saga); err != nil {
+			log.Printf("Resumed purchase saga %s failed: %v", saga.ID.Hex(), err)
+		}
+	}
+}
+
+func (o *Orchestrator) run(ctx context.Context, saga *model.PurchaseSaga) error {
+	for {
+		step, ok := saga.NextStep()
+		if !ok {
+			saga.Status = model.SagaStatusCompleted
+			saga.Error = ""
+			return o.save(ctx, saga)
+		}
+
+		err := o.execute(ctx, saga, step)
+		if err == nil {
+			saga.Complete(step)
+			saga.LeaseUntil = time.Now().Add(lease)
+			err = o.save(ctx, saga)
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, repository.ErrSagaLeaseLost) {
+			// Another run owns the saga now and carries on from here.
+			return err
+		}
+
+		if saga.Final() {
+			// The buyer has their tickets; leave the rest to recovery.
+			log.Printf("Purchase saga %s failed at %s, will retry: %v", saga.ID.Hex(), step, err)
+			saga.Error = err.Error()
+			saga.LeaseUntil = time.Now()
+			if err := o.save(ctx, saga); err != nil {
+				log.Printf("Failed to save purchase saga %s: %v", saga.ID.Hex(), err)
+			}
+			return nil
+		}
+
+		saga.Fail(step)
+		saga.Status = model.SagaStatusCompensating
+		saga.Error = fmt.Sprintf("%s failed: %v", step, err)
+		if err := o.compensate(ctx, saga); err != nil {
+			log.Printf("Failed to compensate purchase saga %s, will retry: %v", saga.ID.Hex(), err)
+		}
+		return &StepError{Step: step, Err: err}
+	}
+}
+
+func (o *Orchestrator) execute(ctx context.Context, saga *model.PurchaseSaga, step model.SagaStep) error {
+	ctx, cancel := context.WithTimeout(ctx, o.stepTimeout)
+	defer cancel()
+
+	order := &saga.Order
+	orderID := order.ID.Hex()
+
+	switch step {
+	case model.SagaStepReserveStock:
+		return o.reserveStock(ctx, order)
+
+	case model.SagaStepRedeemPromoCodes:
+		return o.promos.Redeem(ctx, order)
+
+	case model.SagaStepPriceOrder:
+		return o.pricing.PriceOrder(ctx, order)
+
+	case model.SagaStepCreateOrder:
+		err := o.createOrder(ctx, saga)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		return err
+
+	case model.SagaStepAuthorizePayment:
+		payment, err := o.payments.Authorize(ctx, order, saga.PaymentMethod)
+		if err != nil {
+			return err
+		}
+		saga.AuthorizationID = payment.AuthorizationID
+		return nil
+
+	case model.SagaStepCapturePayment:
+		_, err := o.payments.Capture(ctx, orderID)
+		return err
+
+	case model.SagaStepConfirmOrder:
+		for _, ticket := range saga.Tickets() {
+			if err := o.confirmTicket(ctx, &ticket); err != nil {
+				return err
+			}
+		}
+
+		if err := o.orderRepo.Confirm(ctx, order.ID, saga.AuthorizationID); err != nil {
+			return err
+		}
+		order.Status = model.OrderStatusConfirmed
+		order.PaymentReference = saga.AuthorizationID
+		return nil
+
+	case model.SagaStepNotify:
+		for _, ticket := range saga.Tickets() {
+			ticket.Status = model.TicketStatusConfirmed
+			event := model.NewTicketEvent(model.EventTicketPurchased, &ticket, "")
+			event.Payload.OrderID = orderID
+			// Keyed by the ticket so a retried step cannot notify twice.
+			event.ID = ticket.ID
+			err := o.outboxRepo.Add(ctx, event)
+			if err != nil && !mongo.IsDuplicateKeyError(err) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown purchase step %s", step)
+}
+
+// reserveStock takes one unit of stock per ticket of the order, reserved
+// under the ticket's ID. The reservations fix the price, so the buyer pays
+// what each tier cost when their stock was taken. The tickets of an item share
+// a single use of its presale code. If a ticket cannot be reserved, the
+// tickets reserved before it are released.
+func (o *Orchestrator) reserveStock(ctx context.Context, order *model.Order) error {
+	var reserved []string
+	for i := range order.Items {
+		item := &order.Items[i]
+		for j, id := range item.TicketIDs {
+			req := &eventpb.ReserveStockRequest{
+				EventId:       item.EventID,
+				ReservationId: id.Hex(),
+				Quantity:      1,
+				TicketTypeId:  item.TicketTypeID,
+				AccessCode:    item.AccessCode,
+			}
+			if item.AccessCode != "" {
+				req.AccessGroup = item.TicketIDs[0].Hex()
+			}
+			if len(item.SeatIDs) > 0 {
+				req.SeatIds = []string{item.SeatIDs[j]}
+			}
+
+			res, err := o.eventClient.ReserveStock(ctx, req)
+			if err != nil {
+				o.releaseStock(ctx, reserved)
+				return err
+			}
+			reserved = append(reserved, req.ReservationId)
+			item.SetPrice(res.Reservation.UnitPrice, res.Reservation.Currency)
+		}
+	}
+	return nil
+}
+
+// releaseStock gives back the given reservations. It runs on its own timeout,
+// as the step it cleans up after may have used all of its own.
+func (o *Orchestrator) releaseStock(ctx context.Context, reservationIDs []string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), o.stepTimeout)
+	defer cancel()
+
+	for _, id := range reservationIDs {
+		_, err := o.eventClient.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{
+			ReservationId: id,
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			log.Printf("Failed to release stock reservation %s: %v", id, err)
+		}
+	}
+}
+
+// createOrder stores the saga's order and its tickets in one transaction. For
+// each event that limits how many tickets a user may buy, the user's other
+// tickets are counted in the same transaction, serialized against their
+// concurrent purchases, so that parallel orders cannot together exceed the
+// limit.
+func (o *Orchestrator) createOrder(ctx context.Context, saga *model.PurchaseSaga) error {
+	order := &saga.Order
+	tickets := saga.Tickets()
+
+	return o.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		for eventID, quantity := range order.EventQuantities() {
+			// Tickets of an event cancelled since its stock was reserved
+			// would be missed by the cancellation job.
+			if err := o.jobRepo.CheckNotCancelled(ctx, eventID); err != nil {
+				return err
+			}
+
+			limits := saga.Limits[eventID]
+			if limits.MaxPerUser == 0 {
+				continue
+			}
+
+			if err := o.ticketRepo.LockPurchases(ctx, order.UserID, eventID); err != nil {
+				return err
+			}
+			held, err := o.ticketRepo.HeldQuantity(ctx, order.UserID, eventID, order.ID.Hex())
+			if err != nil {
+				return err
+			}
+			if err := limits.Check(quantity, held); err != nil {
+				return err
+			}
+		}
+
+		if err := o.orderRepo.Create(ctx, order); err != nil {
+			return err
+		}
+		for i := range tickets {
+			if _, err := o.ticketRepo.Create(ctx, &tickets[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// confirmTicket moves a held ticket to CONFIRMED and commits its stock. A
+// ticket confirmed by an earlier attempt is left as it is.
+func (o *Orchestrator) confirmTicket(ctx context.Context, ticket *model.Ticket) error {
+	_, err := o.ticketRepo.ConfirmHold(ctx, ticket.ID.Hex(), time.Now())
+	if errors.Is(err, repository.ErrInvalidStatus) {
+		var current *model.Ticket
+		current, err = o.ticketRepo.GetByID(ctx, ticket.ID.Hex())
+		if err == nil && current.Status != model.TicketStatusConfirmed {
+			err = fmt.Errorf("%w: ticket is %s", repository.ErrInvalidStatus, current.Status)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = o.eventClient.CommitStock(ctx, &eventpb.CommitStockRequest{
+		ReservationId: ticket.ReservationID,
+	})
+	return err
+}
+
+// compensate undoes completed steps, most recent first. It stops at the first
+// failure and leaves the saga compensating so recovery can retry it. The saga
+// is saved before anything is undone, so a run whose lease was taken over
+// stops there instead of undoing steps a second time.
+func (o *Orchestrator) compensate(ctx context.Context, saga *model.PurchaseSaga) error {
+	saga.Status = model.SagaStatusCompensating
+	if err := o.save(ctx, saga); err != nil {
+		return err
+	}
+
+	for {
+		step, ok := saga.LastCompleted()
+		if !ok {
+			break
+		}
+
+		if err := o.undo(ctx, saga, step); err != nil {
+			saga.LeaseUntil = time.Now()
+			if saveErr := o.save(ctx, saga); saveErr != nil {
+				log.Printf("Failed to save purchase saga %s: %v", saga.ID.Hex(), saveErr)
+			}
+			return fmt.Errorf("failed to undo %s: %w", step, err)
+		}
+
+		saga.Undo()
+		if err := o.save(ctx, saga); err != nil {
+			return err
+		}
+	}
+
+	saga.Status = model.SagaStatusAborted
+	return o.save(ctx, saga)
+}
+
+func (o *Orchestrator) undo(ctx context.Context, saga *model.PurchaseSaga, step model.SagaStep) error {
+	ctx, cancel := context.WithTimeout(ctx, o.stepTimeout)
+	defer cancel()
+
+	order := &saga.Order
+	orderID := order.ID.Hex()
+
+	switch step {
+	case model.SagaStepReserveStock:
+		for _, ticket := range saga.Tickets() {
+			_, err := o.eventClient.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{
+				ReservationId: ticket.ReservationID,
+			})
+			if err != nil && status.Code(err) != codes.NotFound {
+				return err
+			}
+			if err := o.ticketRepo.MarkStockReleased(ctx, ticket.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case model.SagaStepRedeemPromoCodes:
+		return o.promos.Release(ctx, orderID)
+
+	case model.SagaStepPriceOrder:
+		return nil
+
+	case model.SagaStepCreateOrder:
+		for _, ticket := range saga.Tickets() {
+			_, err := o.ticketRepo.UpdateStatus(ctx, ticket.ID.Hex(), model.TicketStatusCancelled)
+			if err != nil && !errors.Is(err, repository.ErrTicketNotFound) && !errors.Is(err, repository.ErrInvalidStatus) {
+				return err
+			}
+		}
+		return o.orderRepo.Fail(ctx, order.ID, saga.Error)
+
+	case model.SagaStepAuthorizePayment, model.SagaStepCapturePayment:
+		return o.payments.Reverse(ctx, orderID)
+	}
+
+	return fmt.Errorf("%s cannot be undone", step)
+}
+
+func (o *Orchestrator) save(ctx context.Context, saga *model.PurchaseSaga) error {
+	if err := o.sagaRepo.Save(ctx, saga); err != nil {
+		return fmt.Errorf("failed to save purchase saga: %w", err)
+	}
+	return nil
+}
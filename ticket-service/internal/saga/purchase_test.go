@@ -0,0 +1,280 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeEventClient reserves stock in memory. The reservation numbered failAt,
+// counting from 1, fails with failErr; with late set it is still made, as a
+// reservation whose answer timed out would be.
+type fakeEventClient struct {
+	eventpb.EventServiceClient
+
+	failAt   int
+	failErr  error
+	late     bool
+	calls    int
+	reserved map[string]*eventpb.ReserveStockRequest
+	released []string
+}
+
+func newFakeEventClient() *fakeEventClient {
+	return &fakeEventClient{reserved: make(map[string]*eventpb.ReserveStockRequest)}
+}
+
+func (c *fakeEventClient) ReserveStock(ctx context.Context, req *eventpb.ReserveStockRequest, opts ...grpc.CallOption) (*eventpb.ReserveStockResponse, error) {
+	c.calls++
+	if c.calls == c.failAt {
+		if c.late {
+			c.reserved[req.ReservationId] = req
+		}
+		return nil, c.failErr
+	}
+
+	c.reserved[req.ReservationId] = req
+	return &eventpb.ReserveStockResponse{
+		Reservation: &eventpb.StockReservation{
+			ReservationId: req.ReservationId,
+			UnitPrice:     1000,
+			Currency:      "USD",
+		},
+	}, nil
+}
+
+func (c *fakeEventClient) ReleaseStock(ctx context.Context, req *eventpb.ReleaseStockRequest, opts ...grpc.CallOption) (*eventpb.ReleaseStockResponse, error) {
+	if _, ok := c.reserved[req.ReservationId]; !ok {
+		return nil, status.Error(codes.NotFound, "reservation not found")
+	}
+
+	delete(c.reserved, req.ReservationId)
+	c.released = append(c.released, req.ReservationId)
+	return &eventpb.ReleaseStockResponse{}, nil
+}
+
+// fakePayments authorizes payments in memory. Authorizations made with
+// timeout set are kept but reported as timed out.
+type fakePayments struct {
+	timeout    bool
+	authorized map[string]bool
+	reversed   []string
+}
+
+func (p *fakePayments) Authorize(ctx context.Context, order *model.Order, paymentMethod string) (*model.Payment, error) {
+	orderID := order.ID.Hex()
+	p.authorized[orderID] = true
+	if p.timeout {
+		return nil, context.DeadlineExceeded
+	}
+	return &model.Payment{OrderID: orderID, AuthorizationID: "auth_" + orderID}, nil
+}
+
+func (p *fakePayments) Capture(ctx context.Context, orderID string) (*model.Payment, error) {
+	return &model.Payment{OrderID: orderID}, nil
+}
+
+func (p *fakePayments) Reverse(ctx context.Context, orderID string) error {
+	delete(p.authorized, orderID)
+	p.reversed = append(p.reversed, orderID)
+	return nil
+}
+
+type fakePromos struct {
+	released []string
+}
+
+func (p *fakePromos) Redeem(ctx context.Context, order *model.Order) error {
+	return nil
+}
+
+func (p *fakePromos) Release(ctx context.Context, orderID string) error {
+	p.released = append(p.released, orderID)
+	return nil
+}
+
+func newTestOrchestrator(mt *mtest.T, events *fakeEventClient, payments Payments, promos Promos) *Orchestrator {
+	return &Orchestrator{
+		sagaRepo:    repository.NewSagaRepository(mt.DB),
+		orderRepo:   repository.NewOrderRepository(mt.DB),
+		ticketRepo:  repository.NewTicketRepository(mt.DB),
+		jobRepo:     repository.NewCancellationJobRepository(mt.DB),
+		eventClient: events,
+		payments:    payments,
+		promos:      promos,
+		stepTimeout: time.Second,
+	}
+}
+
+func successResponses(n int) []primitive.D {
+	responses := make([]primitive.D, n)
+	for i := range responses {
+		responses[i] = mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1})
+	}
+	return responses
+}
+
+func TestOrchestrator_ReserveStock(t *testing.T) {
+	events := newFakeEventClient()
+	o := &Orchestrator{eventClient: events, stepTimeout: time.Second}
+
+	order := model.NewOrder("alice", []model.OrderItem{
+		{EventID: "event1", TicketTypeID: "vip", Quantity: 2, AccessCode: "PRESALE"},
+		{EventID: "event2", Quantity: 1},
+	}, nil)
+
+	if err := o.reserveStock(context.Background(), order); err != nil {
+		t.Fatalf("reserveStock() error = %v", err)
+	}
+	if len(events.reserved) != 3 {
+		t.Fatalf("reserveStock() reserved %d tickets, want 3", len(events.reserved))
+	}
+
+	// The tickets of an item share one use of its presale code.
+	group := order.Items[0].TicketIDs[0].Hex()
+	for _, id := range order.Items[0].TicketIDs {
+		if got := events.reserved[id.Hex()].AccessGroup; got != group {
+			t.Errorf("reservation %s access group = %q, want %q", id.Hex(), got, group)
+		}
+	}
+	if got := events.reserved[order.Items[1].TicketIDs[0].Hex()].AccessGroup; got != "" {
+		t.Errorf("reservation without access code has access group %q", got)
+	}
+}
+
+func TestOrchestrator_ReserveStock_ReleasesOnFailure(t *testing.T) {
+	events := newFakeEventClient()
+	events.failAt = 2
+	events.failErr = status.Error(codes.ResourceExhausted, "not enough tickets")
+	o := &Orchestrator{eventClient: events, stepTimeout: time.Second}
+
+	order := model.NewOrder("alice", []model.OrderItem{
+		{EventID: "event1", Quantity: 3},
+	}, nil)
+
+	err := o.reserveStock(context.Background(), order)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("reserveStock() error = %v, want ResourceExhausted", err)
+	}
+
+	if len(events.reserved) != 0 {
+		t.Errorf("reserveStock() left %d reservations behind", len(events.reserved))
+	}
+	first := order.Items[0].TicketIDs[0].Hex()
+	if len(events.released) != 1 || events.released[0] != first {
+		t.Errorf("reserveStock() released %v, want [%s]", events.released, first)
+	}
+	if events.calls != 2 {
+		t.Errorf("reserveStock() made %d reservations, want it to stop at the failure", events.calls)
+	}
+}
+
+func TestOrchestrator_Run_UndoesTimedOutStep(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("reserve stock", func(mt *mtest.T) {
+		events := newFakeEventClient()
+		events.failAt = 2
+		events.failErr = status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+		events.late = true
+		o := newTestOrchestrator(mt, events, nil, nil)
+
+		order := model.NewOrder("alice", []model.OrderItem{{EventID: "event1", Quantity: 2}}, nil)
+		saga := model.NewPurchaseSaga(order, "pm_card", time.Minute)
+
+		// The saga is saved as compensating, stock is marked released on
+		// both tickets, and the saga is saved after the undo and once more
+		// when aborted.
+		mt.AddMockResponses(successResponses(5)...)
+
+		var stepErr *StepError
+		err := o.run(context.Background(), saga)
+		if !errors.As(err, &stepErr) || stepErr.Step != model.SagaStepReserveStock {
+			mt.Fatalf("run() error = %v, want a RESERVE_STOCK step error", err)
+		}
+		if len(events.reserved) != 0 {
+			mt.Errorf("run() left %d reservations behind", len(events.reserved))
+		}
+		if saga.Status != model.SagaStatusAborted || len(saga.Completed) != 0 {
+			mt.Errorf("saga is %s with %v completed, want ABORTED with none", saga.Status, saga.Completed)
+		}
+	})
+
+	mt.Run("authorize payment", func(mt *mtest.T) {
+		events := newFakeEventClient()
+		payments := &fakePayments{timeout: true, authorized: make(map[string]bool)}
+		promos := &fakePromos{}
+		o := newTestOrchestrator(mt, events, payments, promos)
+
+		order := model.NewOrder("alice", []model.OrderItem{{EventID: "event1", Quantity: 1}}, nil)
+		saga := model.NewPurchaseSaga(order, "pm_card", time.Minute)
+		saga.Complete(model.SagaStepReserveStock)
+		saga.Complete(model.SagaStepRedeemPromoCodes)
+		saga.Complete(model.SagaStepPriceOrder)
+		saga.Complete(model.SagaStepCreateOrder)
+
+		mt.AddMockResponses(
+			// Saved as compensating, and after the payment is reversed.
+			successResponses(1)[0],
+			successResponses(1)[0],
+			// The ticket was never created, so cancelling it finds nothing.
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}),
+			mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch),
+		)
+		// The order is failed, the rest is undone and the saga is saved
+		// after each step and once more when aborted.
+		mt.AddMockResponses(successResponses(7)...)
+
+		var stepErr *StepError
+		err := o.run(context.Background(), saga)
+		if !errors.As(err, &stepErr) || stepErr.Step != model.SagaStepAuthorizePayment {
+			mt.Fatalf("run() error = %v, want an AUTHORIZE_PAYMENT step error", err)
+		}
+		if len(payments.authorized) != 0 {
+			mt.Errorf("run() left the timed out authorization behind")
+		}
+		if len(promos.released) != 1 {
+			mt.Errorf("run() released promo codes %d times, want once", len(promos.released))
+		}
+		if saga.Status != model.SagaStatusAborted || len(saga.Completed) != 0 {
+			mt.Errorf("saga is %s with %v completed, want ABORTED with none", saga.Status, saga.Completed)
+		}
+	})
+}
+
+func TestOrchestrator_Compensate_LeaseLost(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("taken over", func(mt *mtest.T) {
+		events := newFakeEventClient()
+		promos := &fakePromos{}
+		o := newTestOrchestrator(mt, events, nil, promos)
+
+		order := model.NewOrder("alice", []model.OrderItem{{EventID: "event1", Quantity: 1}}, nil)
+		saga := model.NewPurchaseSaga(order, "pm_card", time.Minute)
+		saga.Complete(model.SagaStepReserveStock)
+		saga.Complete(model.SagaStepRedeemPromoCodes)
+
+		// Another run claimed the saga, so this one's save matches nothing.
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+
+		err := o.compensate(context.Background(), saga)
+		if !errors.Is(err, repository.ErrSagaLeaseLost) {
+			mt.Fatalf("compensate() error = %v, want %v", err, repository.ErrSagaLeaseLost)
+		}
+		if len(promos.released) != 0 || len(events.released) != 0 {
+			mt.Errorf("compensate() undid steps of a saga another run owns")
+		}
+	})
+}
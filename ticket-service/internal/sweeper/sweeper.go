@@ -0,0 +1,127 @@
+package sweeper
+
+import (
+	"context"
+	"log"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/waitlist"
+	"google.golang.org/grpc"
+)
+
+const batchSize = 100
+
+// Sweeper periodically cancels RESERVED tickets whose hold has expired and
+// returns their quantity to the event's stock, for the waitlist to offer.
+type Sweeper struct {
+	repo        *repository.TicketRepository
+	outboxRepo  *repository.OutboxRepository
+	transactor  *repository.Transactor
+	eventClient eventpb.EventServiceClient
+	waitlist    *waitlist.Service
+	interval    time.Duration
+	stopCh      chan struct{}
+}
+
+func NewSweeper(
+	repo *repository.TicketRepository,
+	outboxRepo *repository.OutboxRepository,
+	transactor *repository.Transactor,
+	eventConn *grpc.ClientConn,
+	waitlist *waitlist.Service,
+	interval time.Duration,
+) *Sweeper {
+	return &Sweeper{
+		repo:        repo,
+		outboxRepo:  outboxRepo,
+		transactor:  transactor,
+		eventClient: eventpb.NewEventServiceClient(eventConn),
+		waitlist:    waitlist,
+		interval:    interval,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+func (s *Sweeper) Start() {
+	log.Printf("Starting hold sweeper, running every %v", s.interval)
+	go s.run()
+}
+
+func (s *Sweeper) Stop() {
+	close(s.stopCh)
+}
+
+func (s *Sweeper) run() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), s.interval)
+			s.Sweep(ctx)
+			cancel()
+		case <-s.stopCh:
+			log.Println("Hold sweeper stopped")
+			return
+		}
+	}
+}
+
+// Sweep runs a single pass: expired holds are cancelled first, then stock is
+// released for every cancelled ticket still waiting on it. Releases that fail
+// are picked up again by the next pass.
+func (s *Sweeper) Sweep(ctx context.Context) {
+	now := time.Now()
+
+	expired, err := s.repo.ListExpiredHolds(ctx, now, batchSize)
+	if err != nil {
+		log.Printf("Failed to list expired holds: %v", err)
+		return
+	}
+
+	for _, ticket := range expired {
+		err := s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+			cancelled, err := s.repo.ExpireHold(ctx, ticket.ID, now)
+			if err != nil || !cancelled {
+				return err
+			}
+			ticket.Status = model.TicketStatusCancelled
+			return s.outboxRepo.Add(ctx, model.NewTicketEvent(model.EventTicketCancelled, ticket, "your hold expired"))
+		})
+		if err != nil {
+			log.Printf("Failed to expire hold for ticket %s: %v", ticket.ID.Hex(), err)
+		}
+	}
+
+	pending, err := s.repo.ListPendingStockReleases(ctx, batchSize)
+	if err != nil {
+		log.Printf("Failed to list pending stock releases: %v", err)
+		return
+	}
+
+	released := false
+	for _, ticket := range pending {
+		if _, err := s.eventClient.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{
+			ReservationId: ticket.ReservationID,
+		}); err != nil {
+			log.Printf("Failed to release stock for ticket %s: %v", ticket.ID.Hex(), err)
+			continue
+		}
+
+		if err := s.repo.MarkStockReleased(ctx, ticket.ID); err != nil {
+			log.Printf("Failed to mark stock released for ticket %s: %v", ticket.ID.Hex(), err)
+			continue
+		}
+
+		log.Printf("Released stock held by ticket %s for event %s", ticket.ID.Hex(), ticket.EventID)
+		released = true
+	}
+
+	if released {
+		s.waitlist.Wake()
+	}
+}
@@ -0,0 +1,177 @@
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/waitlist"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"google.golang.org/grpc"
+)
+
+// stubEventClient records the reservations it is asked to release and fails
+// every release while failing is set.
+type stubEventClient struct {
+	eventpb.EventServiceClient
+	failing  bool
+	released []string
+}
+
+func (c *stubEventClient) ReleaseStock(ctx context.Context, req *eventpb.ReleaseStockRequest, opts ...grpc.CallOption) (*eventpb.ReleaseStockResponse, error) {
+	if c.failing {
+		return nil, errors.New("event service unavailable")
+	}
+	c.released = append(c.released, req.ReservationId)
+	return &eventpb.ReleaseStockResponse{}, nil
+}
+
+func ticketDocument(mt *mtest.T, ticket *model.Ticket) bson.D {
+	raw, err := bson.Marshal(ticket)
+	if err != nil {
+		mt.Fatalf("failed to marshal ticket: %v", err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		mt.Fatalf("failed to unmarshal ticket: %v", err)
+	}
+	return doc
+}
+
+// sentUpdates returns the filters of every update command sent, in order.
+func sentUpdates(mt *mtest.T) []bson.Raw {
+	var filters []bson.Raw
+	for _, event := range mt.GetAllStartedEvents() {
+		if event.CommandName == "update" {
+			filters = append(filters, event.Command.Lookup("updates").Array().Index(0).Value().Document().Lookup("q").Document())
+		}
+	}
+	return filters
+}
+
+func countCommands(mt *mtest.T, name string) int {
+	count := 0
+	for _, event := range mt.GetAllStartedEvents() {
+		if event.CommandName == name {
+			count++
+		}
+	}
+	return count
+}
+
+func TestSweeper_Sweep(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	updated := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1})
+	notUpdated := mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0})
+
+	newSweeper := func(mt *mtest.T, client *stubEventClient) *Sweeper {
+		return &Sweeper{
+			repo:        repository.NewTicketRepository(mt.DB),
+			outboxRepo:  repository.NewOutboxRepository(mt.DB),
+			transactor:  repository.NewTransactor(mt.Client),
+			eventClient: client,
+			waitlist:    &waitlist.Service{},
+			interval:    time.Minute,
+		}
+	}
+	held := func() *model.Ticket {
+		return &model.Ticket{
+			ID:            primitive.NewObjectID(),
+			EventID:       "event1",
+			UserID:        "alice",
+			Status:        model.TicketStatusReserved,
+			ReservationID: "reservation1",
+			ExpiresAt:     time.Now().Add(-time.Minute),
+		}
+	}
+
+	mt.Run("expired hold", func(mt *mtest.T) {
+		client := &stubEventClient{}
+		s := newSweeper(mt, client)
+		ticket := held()
+		cancelled := *ticket
+		cancelled.Status = model.TicketStatusCancelled
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch, ticketDocument(mt, ticket)),
+			// The hold is cancelled and its TicketCancelled event stored in
+			// one transaction.
+			updated,
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch, ticketDocument(mt, &cancelled)),
+			updated,
+		)
+
+		s.Sweep(context.Background())
+
+		filters := sentUpdates(mt)
+		if len(filters) != 2 {
+			mt.Fatalf("sent %d updates, want the hold expired and its stock marked released", len(filters))
+		}
+		if _, err := filters[0].LookupErr("expires_at"); err != nil || filters[0].Lookup("status").StringValue() != string(model.TicketStatusReserved) {
+			mt.Errorf("expired the hold with filter %v, want it to recheck status and expiry", filters[0])
+		}
+		if countCommands(mt, "insert") != 1 || countCommands(mt, "commitTransaction") != 1 {
+			mt.Errorf("did not commit a TicketCancelled event with the expiry")
+		}
+		if len(client.released) != 1 || client.released[0] != "reservation1" {
+			mt.Errorf("released %v, want reservation1", client.released)
+		}
+	})
+
+	mt.Run("hold confirmed meanwhile", func(mt *mtest.T) {
+		client := &stubEventClient{}
+		s := newSweeper(mt, client)
+
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch, ticketDocument(mt, held())),
+			notUpdated,
+			mtest.CreateSuccessResponse(),
+			mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch),
+		)
+
+		s.Sweep(context.Background())
+
+		if countCommands(mt, "insert") != 0 {
+			mt.Errorf("stored a TicketCancelled event for a hold that was not cancelled")
+		}
+		if len(client.released) != 0 {
+			mt.Errorf("released %v for a hold that was not cancelled", client.released)
+		}
+	})
+
+	mt.Run("release fails", func(mt *mtest.T) {
+		client := &stubEventClient{failing: true}
+		s := newSweeper(mt, client)
+		cancelled := held()
+		cancelled.Status = model.TicketStatusCancelled
+		pending := mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch, ticketDocument(mt, cancelled))
+
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch), pending)
+		s.Sweep(context.Background())
+		if len(sentUpdates(mt)) != 0 {
+			mt.Fatalf("marked the stock released although the event service failed")
+		}
+
+		// The next pass picks the ticket up again.
+		client.failing = false
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "test.tickets", mtest.FirstBatch), pending, updated)
+		s.Sweep(context.Background())
+
+		filters := sentUpdates(mt)
+		if len(filters) != 1 || filters[0].Lookup("_id").ObjectID() != cancelled.ID {
+			mt.Fatalf("sent updates %v, want the stock of %s marked released", filters, cancelled.ID.Hex())
+		}
+		if len(client.released) != 1 || client.released[0] != "reservation1" {
+			mt.Errorf("released %v, want reservation1", client.released)
+		}
+	})
+}
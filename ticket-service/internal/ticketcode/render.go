@@ -0,0 +1,65 @@
+package ticketcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"rsc.io/qr"
+)
+
+type Format string
+
+const (
+	FormatPNG Format = "PNG"
+	FormatSVG Format = "SVG"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported ticket code format")
+
+// quietZone is the blank margin around a QR code, in modules, that scanners
+// need to find it.
+const quietZone = 4
+
+// Render draws token as a QR code about size pixels wide and returns the
+// image with its content type. Modules are scaled by a whole number of
+// pixels, so the image is never larger than size unless size is too small to
+// show the code at all.
+func Render(token string, format Format, size int) ([]byte, string, error) {
+	code, err := qr.Encode(token, qr.M)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to encode ticket code: %w", err)
+	}
+	code.Scale = max(size/(code.Size+2*quietZone), 1)
+
+	switch format {
+	case FormatPNG:
+		return code.PNG(), "image/png", nil
+	case FormatSVG:
+		return svg(code), "image/svg+xml", nil
+	}
+	return nil, "", fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+}
+
+// svg draws code as one path of unit squares in a viewBox of modules.
+func svg(code *qr.Code) []byte {
+	modules := code.Size + 2*quietZone
+	pixels := modules * code.Scale
+
+	var path strings.Builder
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			if code.Black(x, y) {
+				fmt.Fprintf(&path, "M%d %dh1v1h-1z", x+quietZone, y+quietZone)
+			}
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		pixels, pixels, modules, modules)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/>`, modules, modules)
+	fmt.Fprintf(&b, `<path d="%s" fill="#000"/>`, path.String())
+	b.WriteString(`</svg>`)
+	return []byte(b.String())
+}
@@ -0,0 +1,136 @@
+package ticketcode
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Service signs ticket tokens with the active signing key and rotates keys.
+type Service struct {
+	repo       *repository.SigningKeyRepository
+	transactor *repository.Transactor
+}
+
+func NewService(repo *repository.SigningKeyRepository, transactor *repository.Transactor) *Service {
+	return &Service{
+		repo:       repo,
+		transactor: transactor,
+	}
+}
+
+// EnsureKey creates a signing key if there is no active one yet.
+func (s *Service) EnsureKey(ctx context.Context) error {
+	_, err := s.repo.Active(ctx)
+	if !errors.Is(err, repository.ErrSigningKeyNotFound) {
+		return err
+	}
+
+	key, err := s.Rotate(ctx)
+	if mongo.IsDuplicateKeyError(err) {
+		// Another instance got there first.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Created ticket signing key %s", key.ID)
+	return nil
+}
+
+// Rotate makes a new key the active one. The key it replaces is retired:
+// tokens it signed stay valid, but new ones are signed with the new key.
+func (s *Service) Rotate(ctx context.Context) (*model.SigningKey, error) {
+	key, err := newSigningKey()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.repo.Rotate(ctx, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Revoke stops a retired key from verifying tokens, for when it may have
+// leaked. Tickets signed with it need new codes.
+func (s *Service) Revoke(ctx context.Context, id string) (*model.SigningKey, error) {
+	return s.repo.Revoke(ctx, id)
+}
+
+// TrustedKeys returns the keys scanners should accept tokens from.
+func (s *Service) TrustedKeys(ctx context.Context) ([]*model.SigningKey, error) {
+	return s.repo.ListTrusted(ctx)
+}
+
+// PublicKeys returns the trusted keys by ID, for Verify.
+func (s *Service) PublicKeys(ctx context.Context) (map[string]ed25519.PublicKey, error) {
+	keys, err := s.repo.ListTrusted(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list signing keys: %w", err)
+	}
+
+	publicKeys := make(map[string]ed25519.PublicKey, len(keys))
+	for _, key := range keys {
+		publicKeys[key.ID] = ed25519.PublicKey(key.PublicKey)
+	}
+	return publicKeys, nil
+}
+
+// Issue signs a token for ticket that is valid until validUntil.
+func (s *Service) Issue(ctx context.Context, ticket *model.Ticket, validUntil time.Time) (string, *Claims, error) {
+	key, err := s.repo.Active(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get signing key: %w", err)
+	}
+
+	claims := Claims{
+		KeyID:        key.ID,
+		TicketID:     ticket.ID.Hex(),
+		EventID:      ticket.EventID,
+		TicketTypeID: ticket.TicketTypeID,
+		Seats:        ticket.SeatIDs,
+		Admits:       1,
+		IssuedAt:     time.Now().Unix(),
+		ExpiresAt:    validUntil.Unix(),
+		Version:      ticket.CodeVersion,
+	}
+
+	token, err := Sign(claims, key.PrivateKey())
+	if err != nil {
+		return "", nil, err
+	}
+	return token, &claims, nil
+}
+
+func newSigningKey() (*model.SigningKey, error) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate signing key ID: %w", err)
+	}
+
+	return &model.SigningKey{
+		ID:        hex.EncodeToString(id),
+		Seed:      private.Seed(),
+		PublicKey: public,
+		CreatedAt: time.Now(),
+	}, nil
+}
@@ -0,0 +1,101 @@
+package ticketcode
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	now := time.Now()
+	public, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPublic, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := Claims{
+		KeyID:     "k1",
+		TicketID:  "ticket1",
+		EventID:   "event1",
+		Seats:     []string{"A-1"},
+		Admits:    1,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	}
+	token, err := Sign(claims, private)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	forged := claims
+	forged.Admits = 4
+	forgedToken, err := Sign(forged, private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, _, _ := strings.Cut(token, ".")
+	_, forgedSignature, _ := strings.Cut(forgedToken, ".")
+	tampered := payload + "." + forgedSignature
+
+	tests := []struct {
+		name    string
+		token   string
+		keys    map[string]ed25519.PublicKey
+		now     time.Time
+		wantErr error
+	}{
+		{name: "valid", token: token, keys: map[string]ed25519.PublicKey{"k1": public}, now: now},
+		{name: "unknown key", token: token, keys: map[string]ed25519.PublicKey{"k2": public}, now: now, wantErr: ErrUnknownKey},
+		{name: "wrong key", token: token, keys: map[string]ed25519.PublicKey{"k1": otherPublic}, now: now, wantErr: ErrBadSignature},
+		{name: "tampered", token: tampered, keys: map[string]ed25519.PublicKey{"k1": public}, now: now, wantErr: ErrBadSignature},
+		{name: "no signature", token: payload, keys: map[string]ed25519.PublicKey{"k1": public}, now: now, wantErr: ErrMalformed},
+		{name: "expired", token: token, keys: map[string]ed25519.PublicKey{"k1": public}, now: now.Add(2 * time.Hour), wantErr: ErrExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(tt.token, tt.keys, tt.now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if got.TicketID != claims.TicketID || got.EventID != claims.EventID || got.Seats[0] != "A-1" {
+				t.Errorf("Verify() = %+v, want %+v", got, claims)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	png, contentType, err := Render("token", FormatPNG, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "image/png" || !bytes.HasPrefix(png, []byte("\x89PNG")) {
+		t.Errorf("Render(PNG) = %q, not a PNG", contentType)
+	}
+
+	svg, contentType, err := Render("token", FormatSVG, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "image/svg+xml" || !bytes.HasPrefix(svg, []byte("<svg")) {
+		t.Errorf("Render(SVG) = %q, %.20s, not an SVG", contentType, svg)
+	}
+
+	if _, _, err := Render("token", Format("GIF"), 256); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("Render(GIF) error = %v, want %v", err, ErrUnsupportedFormat)
+	}
+}
@@ -0,0 +1,256 @@
+// Package waitlist serves the waitlists of sold-out events. Each ticket type
+// of an event has its own line, served strictly in the order users joined.
+// Stock given back to an event, by a cancellation, an expired hold or a
+// capacity increase, is reserved for the entry at the head of its line until
+// there is enough for its whole quantity. While anyone is waiting in a line,
+// its tickets are not sold directly, so a buyer cannot take returned stock
+// before the next pass reserves it. The entry is then offered the tickets for
+// a limited time; an offer that runs out gives its stock back and the next
+// entry in line is served.
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const batchSize = 100
+
+type Service struct {
+	entries     *repository.WaitlistRepository
+	outboxRepo  *repository.OutboxRepository
+	transactor  *repository.Transactor
+	eventClient eventpb.EventServiceClient
+	offerTTL    time.Duration
+	interval    time.Duration
+	wakeCh      chan struct{}
+	stopCh      chan struct{}
+}
+
+func NewService(
+	entries *repository.WaitlistRepository,
+	outboxRepo *repository.OutboxRepository,
+	transactor *repository.Transactor,
+	eventConn *grpc.ClientConn,
+	offerTTL time.Duration,
+	interval time.Duration,
+) *Service {
+	return &Service{
+		entries:     entries,
+		outboxRepo:  outboxRepo,
+		transactor:  transactor,
+		eventClient: eventpb.NewEventServiceClient(eventConn),
+		offerTTL:    offerTTL,
+		interval:    interval,
+		wakeCh:      make(chan struct{}, 1),
+		stopCh:      make(chan struct{}),
+	}
+}
+
+// Wake asks for a pass to run now, because stock may have been given back or
+// an entry closed. It never blocks.
+func (s *Service) Wake() {
+	select {
+	case s.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Service) Start() {
+	log.Printf("Starting waitlist offers, running every %v", s.interval)
+	go s.loop()
+}
+
+func (s *Service) Stop() {
+	close(s.stopCh)
+}
+
+func (s *Service) loop() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.Run(context.Background())
+
+		select {
+		case <-ticker.C:
+		case <-s.wakeCh:
+		case <-s.stopCh:
+			log.Println("Waitlist offers stopped")
+			return
+		}
+	}
+}
+
+// Run makes a single pass: offers that ran out are expired first, then the
+// stock of closed entries is given back, then the head of every line is
+// offered whatever stock is available. Anything that fails is picked up again
+// by the next pass.
+func (s *Service) Run(ctx context.Context) {
+	s.expireOffers(ctx)
+	s.releaseStock(ctx)
+
+	queues, err := s.entries.Queues(ctx)
+	if err != nil {
+		log.Printf("Failed to list waitlists: %v", err)
+		return
+	}
+	for _, queue := range queues {
+		s.serve(ctx, queue)
+	}
+}
+
+func (s *Service) expireOffers(ctx context.Context) {
+	now := time.Now()
+
+	expired, err := s.entries.ListExpiredOffers(ctx, now, batchSize)
+	if err != nil {
+		log.Printf("Failed to list expired waitlist offers: %v", err)
+		return
+	}
+
+	for _, entry := range expired {
+		err := s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+			closed, err := s.entries.Expire(ctx, entry.ID, now)
+			if err != nil || !closed {
+				return err
+			}
+			return s.outboxRepo.Add(ctx, model.NewWaitlistEvent(model.EventWaitlistOfferExpired, entry))
+		})
+		if err != nil {
+			log.Printf("Failed to expire waitlist offer %s: %v", entry.ID.Hex(), err)
+		}
+	}
+}
+
+// releaseStock gives back the stock reserved for entries that were closed
+// without being claimed. Stock that was never reserved is not found, which is
+// fine.
+func (s *Service) releaseStock(ctx context.Context) {
+	pending, err := s.entries.ListPendingStockReleases(ctx, batchSize)
+	if err != nil {
+		log.Printf("Failed to list waitlist stock releases: %v", err)
+		return
+	}
+
+	for _, entry := range pending {
+		if err := s.release(ctx, entry); err != nil {
+			log.Printf("Failed to release stock for waitlist entry %s: %v", entry.ID.Hex(), err)
+			continue
+		}
+
+		if err := s.entries.MarkStockReleased(ctx, entry.ID); err != nil {
+			log.Printf("Failed to mark stock released for waitlist entry %s: %v", entry.ID.Hex(), err)
+		}
+	}
+}
+
+// Release gives back the stock held for a claimed entry whose purchase
+// failed, so that it can be offered to the next entry in line.
+func (s *Service) Release(ctx context.Context, entry *model.WaitlistEntry) {
+	if err := s.release(ctx, entry); err != nil {
+		log.Printf("Failed to release stock for waitlist entry %s: %v", entry.ID.Hex(), err)
+	}
+	s.Wake()
+}
+
+func (s *Service) release(ctx context.Context, entry *model.WaitlistEntry) error {
+	for _, id := range entry.TicketIDs {
+		_, err := s.eventClient.ReleaseStock(ctx, &eventpb.ReleaseStockRequest{
+			ReservationId: id.Hex(),
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+// serve makes offers to the line of queue, head first, until it is empty or
+// there is not enough stock for the entry at its head.
+func (s *Service) serve(ctx context.Context, queue repository.WaitlistQueue) {
+	for i := 0; i < batchSize; i++ {
+		entry, err := s.entries.NextWaiting(ctx, queue)
+		if err != nil {
+			log.Printf("Failed to get next waitlist entry for event %s: %v", queue.EventID, err)
+			return
+		}
+		if entry == nil {
+			return
+		}
+
+		if !s.offer(ctx, entry) {
+			return
+		}
+	}
+}
+
+// offer reserves the tickets of entry and offers them to its user. It
+// reports whether the next entry in line can be served.
+func (s *Service) offer(ctx context.Context, entry *model.WaitlistEntry) bool {
+	if err := s.reserve(ctx, entry); err != nil {
+		switch status.Code(err) {
+		case codes.ResourceExhausted:
+			// Whatever was reserved stays held for this entry, which is
+			// first in line for the rest.
+			return false
+		case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.PermissionDenied:
+			log.Printf("Closing waitlist entry %s, which cannot be served: %v", entry.ID.Hex(), err)
+			if err := s.entries.Close(ctx, entry.ID, status.Convert(err).Message()); err != nil {
+				log.Printf("Failed to close waitlist entry %s: %v", entry.ID.Hex(), err)
+				return false
+			}
+			return true
+		}
+		log.Printf("Failed to reserve stock for waitlist entry %s: %v", entry.ID.Hex(), err)
+		return false
+	}
+
+	err := s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		offered, err := s.entries.Offer(ctx, entry.ID, now, now.Add(s.offerTTL))
+		if err != nil {
+			return err
+		}
+		return s.outboxRepo.Add(ctx, model.NewWaitlistEvent(model.EventWaitlistOffered, offered))
+	})
+	if errors.Is(err, repository.ErrWaitlistEntryClosed) {
+		// The entry was left while its stock was reserved; the next pass
+		// gives the stock back.
+		return true
+	}
+	if err != nil {
+		log.Printf("Failed to offer tickets to waitlist entry %s: %v", entry.ID.Hex(), err)
+		return false
+	}
+
+	log.Printf("Offered %d tickets for event %s to waitlist entry %s", entry.Quantity, entry.EventID, entry.ID.Hex())
+	return true
+}
+
+// reserve takes one unit of stock per ticket of entry, reserved under the
+// ticket's ID. Reserving a ticket again returns its existing reservation, so
+// units reserved on an earlier pass are kept.
+func (s *Service) reserve(ctx context.Context, entry *model.WaitlistEntry) error {
+	for _, id := range entry.TicketIDs {
+		_, err := s.eventClient.ReserveStock(ctx, &eventpb.ReserveStockRequest{
+			EventId:       entry.EventID,
+			ReservationId: id.Hex(),
+			Quantity:      1,
+			TicketTypeId:  entry.TicketTypeID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
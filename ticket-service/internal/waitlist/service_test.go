@@ -0,0 +1,150 @@
+package waitlist
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eventpb "github.com/doniiel/event-ticketing-platform/proto/event"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/model"
+	"github.com/doniiel/event-ticketing-platform/ticket-service/internal/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeEventClient reserves stock in memory. The reservation numbered failAt,
+// counting from 1, fails with failErr.
+type fakeEventClient struct {
+	eventpb.EventServiceClient
+
+	failAt   int
+	failErr  error
+	calls    int
+	reserved map[string]bool
+	released []string
+}
+
+func newFakeEventClient() *fakeEventClient {
+	return &fakeEventClient{reserved: make(map[string]bool)}
+}
+
+func (c *fakeEventClient) ReserveStock(ctx context.Context, req *eventpb.ReserveStockRequest, opts ...grpc.CallOption) (*eventpb.ReserveStockResponse, error) {
+	c.calls++
+	if c.calls == c.failAt {
+		return nil, c.failErr
+	}
+
+	c.reserved[req.ReservationId] = true
+	return &eventpb.ReserveStockResponse{
+		Reservation: &eventpb.StockReservation{ReservationId: req.ReservationId},
+	}, nil
+}
+
+func (c *fakeEventClient) ReleaseStock(ctx context.Context, req *eventpb.ReleaseStockRequest, opts ...grpc.CallOption) (*eventpb.ReleaseStockResponse, error) {
+	delete(c.reserved, req.ReservationId)
+	c.released = append(c.released, req.ReservationId)
+	return &eventpb.ReleaseStockResponse{}, nil
+}
+
+func newService(mt *mtest.T, events *fakeEventClient) *Service {
+	return &Service{
+		entries:     repository.NewWaitlistRepository(mt.DB),
+		outboxRepo:  repository.NewOutboxRepository(mt.DB),
+		transactor:  repository.NewTransactor(mt.Client),
+		eventClient: events,
+		offerTTL:    time.Minute,
+		interval:    time.Minute,
+		wakeCh:      make(chan struct{}, 1),
+		stopCh:      make(chan struct{}),
+	}
+}
+
+func document(mt *mtest.T, v interface{}) bson.D {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		mt.Fatalf("failed to marshal %T: %v", v, err)
+	}
+	var doc bson.D
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		mt.Fatalf("failed to unmarshal %T: %v", v, err)
+	}
+	return doc
+}
+
+// sentTo returns how many name commands were sent to collection.
+func sentTo(mt *mtest.T, name, collection string) int {
+	count := 0
+	for _, event := range mt.GetAllStartedEvents() {
+		if event.CommandName == name && event.Command.Lookup(name).StringValue() == collection {
+			count++
+		}
+	}
+	return count
+}
+
+func TestService_Offer(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("enough stock", func(mt *mtest.T) {
+		events := newFakeEventClient()
+		s := newService(mt, events)
+		entry := model.NewWaitlistEntry("event1", "", "alice", 2)
+		offered := *entry
+		offered.Status = model.WaitlistStatusOffered
+
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: document(mt, &offered)}),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+		)
+
+		if !s.offer(context.Background(), entry) {
+			mt.Errorf("offer() = false, want the next entry served")
+		}
+		if len(events.reserved) != 2 {
+			mt.Errorf("offer() reserved %d tickets, want 2", len(events.reserved))
+		}
+		if sentTo(mt, "findAndModify", "waitlist") != 1 || sentTo(mt, "insert", "outbox") != 1 {
+			mt.Errorf("offer() did not offer the tickets and notify the user")
+		}
+	})
+
+	mt.Run("not enough stock", func(mt *mtest.T) {
+		events := newFakeEventClient()
+		events.failAt = 2
+		events.failErr = status.Error(codes.ResourceExhausted, "not enough tickets")
+		s := newService(mt, events)
+		entry := model.NewWaitlistEntry("event1", "", "alice", 2)
+
+		if s.offer(context.Background(), entry) {
+			mt.Errorf("offer() = true, want the line to wait for its head")
+		}
+		// The ticket reserved stays held for the head of the line.
+		if len(events.reserved) != 1 || len(events.released) != 0 {
+			mt.Errorf("offer() left %d tickets reserved and released %v, want 1 kept", len(events.reserved), events.released)
+		}
+		if sentTo(mt, "findAndModify", "waitlist") != 0 || sentTo(mt, "update", "waitlist") != 0 {
+			mt.Errorf("offer() changed an entry there is no stock for")
+		}
+	})
+
+	mt.Run("cannot be served", func(mt *mtest.T) {
+		events := newFakeEventClient()
+		events.failAt = 1
+		events.failErr = status.Error(codes.FailedPrecondition, "event is not on sale")
+		s := newService(mt, events)
+		entry := model.NewWaitlistEntry("event1", "", "alice", 1)
+
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+
+		if !s.offer(context.Background(), entry) {
+			mt.Errorf("offer() = false, want the next entry served")
+		}
+		if sentTo(mt, "update", "waitlist") != 1 {
+			mt.Errorf("offer() did not close an entry that cannot be served")
+		}
+	})
+}